@@ -9,6 +9,7 @@ package extapi
 import (
 	context "context"
 	common "github.com/brocaar/chirpstack-api/go/v3/common"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -45,6 +46,10 @@ type GatewayProfile struct {
 	// Extra channels added to the channel-configuration (in case the LoRaWAN
 	// region supports adding custom channels).
 	ExtraChannels []*GatewayProfileExtraChannel `protobuf:"bytes,5,rep,name=extra_channels,json=extraChannels,proto3" json:"extra_channels,omitempty"`
+	// Interval between the pings sent by the gateways using this profile.
+	// When not set, the gateway discovery interval of the network-server is
+	// used.
+	PingInterval *duration.Duration `protobuf:"bytes,6,opt,name=ping_interval,json=pingInterval,proto3" json:"ping_interval,omitempty"`
 }
 
 func (x *GatewayProfile) Reset() {
@@ -114,6 +119,13 @@ func (x *GatewayProfile) GetExtraChannels() []*GatewayProfileExtraChannel {
 	return nil
 }
 
+func (x *GatewayProfile) GetPingInterval() *duration.Duration {
+	if x != nil {
+		return x.PingInterval
+	}
+	return nil
+}
+
 type GatewayProfileListItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -727,11 +739,13 @@ var file_gatewayProfile_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
 	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d,
 	0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xc7, 0x01, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x87, 0x02, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69,
 	0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
@@ -743,7 +757,11 @@ var file_gatewayProfile_proto_rawDesc = []byte{
 	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x78, 0x74, 0x61, 0x70, 0x69, 0x2e,
 	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78,
 	0x74, 0x72, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0d, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x8e, 0x02, 0x0a, 0x16, 0x47, 0x61,
+	0x61, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x8e, 0x02, 0x0a, 0x16, 0x47, 0x61,
 	0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
 	0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
@@ -893,36 +911,38 @@ var file_gatewayProfile_proto_goTypes = []interface{}{
 	(*DeleteGatewayProfileRequest)(nil),  // 8: extapi.DeleteGatewayProfileRequest
 	(*ListGatewayProfilesRequest)(nil),   // 9: extapi.ListGatewayProfilesRequest
 	(*ListGatewayProfilesResponse)(nil),  // 10: extapi.ListGatewayProfilesResponse
-	(*timestamp.Timestamp)(nil),          // 11: google.protobuf.Timestamp
-	(common.Modulation)(0),               // 12: common.Modulation
-	(*empty.Empty)(nil),                  // 13: google.protobuf.Empty
+	(*duration.Duration)(nil),            // 11: google.protobuf.Duration
+	(*timestamp.Timestamp)(nil),          // 12: google.protobuf.Timestamp
+	(common.Modulation)(0),               // 13: common.Modulation
+	(*empty.Empty)(nil),                  // 14: google.protobuf.Empty
 }
 var file_gatewayProfile_proto_depIdxs = []int32{
 	2,  // 0: extapi.GatewayProfile.extra_channels:type_name -> extapi.GatewayProfileExtraChannel
-	11, // 1: extapi.GatewayProfileListItem.created_at:type_name -> google.protobuf.Timestamp
-	11, // 2: extapi.GatewayProfileListItem.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 3: extapi.GatewayProfileExtraChannel.modulation:type_name -> common.Modulation
-	0,  // 4: extapi.CreateGatewayProfileRequest.gateway_profile:type_name -> extapi.GatewayProfile
-	0,  // 5: extapi.GetGatewayProfileResponse.gateway_profile:type_name -> extapi.GatewayProfile
-	11, // 6: extapi.GetGatewayProfileResponse.created_at:type_name -> google.protobuf.Timestamp
-	11, // 7: extapi.GetGatewayProfileResponse.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 8: extapi.UpdateGatewayProfileRequest.gateway_profile:type_name -> extapi.GatewayProfile
-	1,  // 9: extapi.ListGatewayProfilesResponse.result:type_name -> extapi.GatewayProfileListItem
-	3,  // 10: extapi.GatewayProfileService.Create:input_type -> extapi.CreateGatewayProfileRequest
-	5,  // 11: extapi.GatewayProfileService.Get:input_type -> extapi.GetGatewayProfileRequest
-	7,  // 12: extapi.GatewayProfileService.Update:input_type -> extapi.UpdateGatewayProfileRequest
-	8,  // 13: extapi.GatewayProfileService.Delete:input_type -> extapi.DeleteGatewayProfileRequest
-	9,  // 14: extapi.GatewayProfileService.List:input_type -> extapi.ListGatewayProfilesRequest
-	4,  // 15: extapi.GatewayProfileService.Create:output_type -> extapi.CreateGatewayProfileResponse
-	6,  // 16: extapi.GatewayProfileService.Get:output_type -> extapi.GetGatewayProfileResponse
-	13, // 17: extapi.GatewayProfileService.Update:output_type -> google.protobuf.Empty
-	13, // 18: extapi.GatewayProfileService.Delete:output_type -> google.protobuf.Empty
-	10, // 19: extapi.GatewayProfileService.List:output_type -> extapi.ListGatewayProfilesResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	11, // 1: extapi.GatewayProfile.ping_interval:type_name -> google.protobuf.Duration
+	12, // 2: extapi.GatewayProfileListItem.created_at:type_name -> google.protobuf.Timestamp
+	12, // 3: extapi.GatewayProfileListItem.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 4: extapi.GatewayProfileExtraChannel.modulation:type_name -> common.Modulation
+	0,  // 5: extapi.CreateGatewayProfileRequest.gateway_profile:type_name -> extapi.GatewayProfile
+	0,  // 6: extapi.GetGatewayProfileResponse.gateway_profile:type_name -> extapi.GatewayProfile
+	12, // 7: extapi.GetGatewayProfileResponse.created_at:type_name -> google.protobuf.Timestamp
+	12, // 8: extapi.GetGatewayProfileResponse.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: extapi.UpdateGatewayProfileRequest.gateway_profile:type_name -> extapi.GatewayProfile
+	1,  // 10: extapi.ListGatewayProfilesResponse.result:type_name -> extapi.GatewayProfileListItem
+	3,  // 11: extapi.GatewayProfileService.Create:input_type -> extapi.CreateGatewayProfileRequest
+	5,  // 12: extapi.GatewayProfileService.Get:input_type -> extapi.GetGatewayProfileRequest
+	7,  // 13: extapi.GatewayProfileService.Update:input_type -> extapi.UpdateGatewayProfileRequest
+	8,  // 14: extapi.GatewayProfileService.Delete:input_type -> extapi.DeleteGatewayProfileRequest
+	9,  // 15: extapi.GatewayProfileService.List:input_type -> extapi.ListGatewayProfilesRequest
+	4,  // 16: extapi.GatewayProfileService.Create:output_type -> extapi.CreateGatewayProfileResponse
+	6,  // 17: extapi.GatewayProfileService.Get:output_type -> extapi.GetGatewayProfileResponse
+	14, // 18: extapi.GatewayProfileService.Update:output_type -> google.protobuf.Empty
+	14, // 19: extapi.GatewayProfileService.Delete:output_type -> google.protobuf.Empty
+	10, // 20: extapi.GatewayProfileService.List:output_type -> extapi.ListGatewayProfilesResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_gatewayProfile_proto_init() }
@@ -32,7 +32,15 @@ protoc -I. -I${GRPC_GW_PATH} --go_out=paths=source_relative,plugins=grpc:. \
   dfi_service.proto \
   report.proto \
   mosquitto_auth.proto \
-  deviceProvisioning.proto
+  deviceProvisioning.proto \
+  airdrop.proto \
+  statement.proto \
+  devicedata.proto \
+  audit.proto \
+  invitation.proto \
+  email_outbox.proto \
+  organization_role.proto \
+  data_retention.proto
 
 # generate the JSON interface code
 protoc -I. -I${GRPC_GW_PATH} --grpc-gateway_out=paths=source_relative,logtostderr=true:. \
@@ -63,7 +71,15 @@ protoc -I. -I${GRPC_GW_PATH} --grpc-gateway_out=paths=source_relative,logtostder
   dfi_service.proto \
   report.proto \
   mosquitto_auth.proto \
-  deviceProvisioning.proto
+  deviceProvisioning.proto \
+  airdrop.proto \
+  statement.proto \
+  devicedata.proto \
+  audit.proto \
+  invitation.proto \
+  email_outbox.proto \
+  organization_role.proto \
+  data_retention.proto
 
 # generate the swagger definitions
 protoc -I. -I${GRPC_GW_PATH} --swagger_out=json_names_for_fields=true,simple_operation_ids=true:./swagger \
@@ -94,7 +110,15 @@ protoc -I. -I${GRPC_GW_PATH} --swagger_out=json_names_for_fields=true,simple_ope
   dfi_service.proto \
   report.proto \
   mosquitto_auth.proto \
-  deviceProvisioning.proto
+  deviceProvisioning.proto \
+  airdrop.proto \
+  statement.proto \
+  devicedata.proto \
+  audit.proto \
+  invitation.proto \
+  email_outbox.proto \
+  organization_role.proto \
+  data_retention.proto
 
 # merge the swagger code into one file
 #go run swagger/main.go swagger > ../static/swagger/api.swagger.json
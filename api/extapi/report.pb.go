@@ -172,7 +172,8 @@ type MiningReportRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	// accept string array for example: ETH_MXC or ETH_MXC\nDHX or DHX
+	// accept string array for example: ETH_MXC or ETH_MXC\nDHX or DHX, the
+	// supported currencies are ETH_MXC, DHX and BTC
 	Currency     []string             `protobuf:"bytes,2,rep,name=currency,proto3" json:"currency,omitempty"`
 	FiatCurrency string               `protobuf:"bytes,3,opt,name=fiat_currency,json=fiatCurrency,proto3" json:"fiat_currency,omitempty"`
 	Start        *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
@@ -349,7 +350,7 @@ var file_report_proto_rawDesc = []byte{
 	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
 	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
 	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x32, 0x8d, 0x03, 0x0a, 0x0d, 0x52,
+	0x28, 0x08, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x32, 0x86, 0x04, 0x0a, 0x0d, 0x52,
 	0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a,
 	0x13, 0x47, 0x65, 0x74, 0x46, 0x69, 0x61, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
 	0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x65, 0x78, 0x74, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
@@ -374,12 +375,19 @@ var file_report_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
 	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x69, 0x6e,
-	0x63, 0x6f, 0x6d, 0x65, 0x2f, 0x70, 0x64, 0x66, 0x30, 0x01, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x78, 0x63, 0x2d, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6c, 0x70, 0x77, 0x61, 0x6e, 0x2d, 0x61, 0x70,
-	0x70, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x78, 0x74,
-	0x61, 0x70, 0x69, 0x3b, 0x65, 0x78, 0x74, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x63, 0x6f, 0x6d, 0x65, 0x2f, 0x70, 0x64, 0x66, 0x30, 0x01, 0x12, 0x77, 0x0a, 0x10, 0x4d, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x58, 0x4c, 0x53, 0x58, 0x12, 0x1b,
+	0x2e, 0x65, 0x78, 0x74, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x78,
+	0x74, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x6d,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x2f, 0x78, 0x6c, 0x73,
+	0x78, 0x30, 0x01, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6d, 0x78, 0x63, 0x2d, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6c, 0x70, 0x77, 0x61, 0x6e, 0x2d, 0x61, 0x70, 0x70, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x78, 0x74, 0x61, 0x70, 0x69, 0x3b, 0x65, 0x78, 0x74,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -410,11 +418,13 @@ var file_report_proto_depIdxs = []int32{
 	0, // 3: extapi.ReportService.GetFiatCurrencyList:input_type -> extapi.GetFiatCurrencyListRequest
 	3, // 4: extapi.ReportService.MiningReportCSV:input_type -> extapi.MiningReportRequest
 	3, // 5: extapi.ReportService.MiningReportPDF:input_type -> extapi.MiningReportRequest
-	2, // 6: extapi.ReportService.GetFiatCurrencyList:output_type -> extapi.GetFiatCurrencyListResponse
-	4, // 7: extapi.ReportService.MiningReportCSV:output_type -> extapi.MiningReportResponse
-	4, // 8: extapi.ReportService.MiningReportPDF:output_type -> extapi.MiningReportResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
+	3, // 6: extapi.ReportService.MiningReportXLSX:input_type -> extapi.MiningReportRequest
+	2, // 7: extapi.ReportService.GetFiatCurrencyList:output_type -> extapi.GetFiatCurrencyListResponse
+	4, // 8: extapi.ReportService.MiningReportCSV:output_type -> extapi.MiningReportResponse
+	4, // 9: extapi.ReportService.MiningReportPDF:output_type -> extapi.MiningReportResponse
+	4, // 10: extapi.ReportService.MiningReportXLSX:output_type -> extapi.MiningReportResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
 	3, // [3:3] is the sub-list for extension extendee
 	0, // [0:3] is the sub-list for field type_name
@@ -525,6 +535,9 @@ type ReportServiceClient interface {
 	MiningReportCSV(ctx context.Context, in *MiningReportRequest, opts ...grpc.CallOption) (ReportService_MiningReportCSVClient, error)
 	// Request to download miningReport in pdf filtered by date
 	MiningReportPDF(ctx context.Context, in *MiningReportRequest, opts ...grpc.CallOption) (ReportService_MiningReportPDFClient, error)
+	// Request to download miningReport in xlsx format filtered by date, every
+	// currency is put on a separate sheet
+	MiningReportXLSX(ctx context.Context, in *MiningReportRequest, opts ...grpc.CallOption) (ReportService_MiningReportXLSXClient, error)
 }
 
 type reportServiceClient struct {
@@ -608,6 +621,38 @@ func (x *reportServiceMiningReportPDFClient) Recv() (*MiningReportResponse, erro
 	return m, nil
 }
 
+func (c *reportServiceClient) MiningReportXLSX(ctx context.Context, in *MiningReportRequest, opts ...grpc.CallOption) (ReportService_MiningReportXLSXClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ReportService_serviceDesc.Streams[2], "/extapi.ReportService/MiningReportXLSX", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &reportServiceMiningReportXLSXClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ReportService_MiningReportXLSXClient interface {
+	Recv() (*MiningReportResponse, error)
+	grpc.ClientStream
+}
+
+type reportServiceMiningReportXLSXClient struct {
+	grpc.ClientStream
+}
+
+func (x *reportServiceMiningReportXLSXClient) Recv() (*MiningReportResponse, error) {
+	m := new(MiningReportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ReportServiceServer is the server API for ReportService service.
 type ReportServiceServer interface {
 	// Get support fiat currency list
@@ -616,6 +661,9 @@ type ReportServiceServer interface {
 	MiningReportCSV(*MiningReportRequest, ReportService_MiningReportCSVServer) error
 	// Request to download miningReport in pdf filtered by date
 	MiningReportPDF(*MiningReportRequest, ReportService_MiningReportPDFServer) error
+	// Request to download miningReport in xlsx format filtered by date, every
+	// currency is put on a separate sheet
+	MiningReportXLSX(*MiningReportRequest, ReportService_MiningReportXLSXServer) error
 }
 
 // UnimplementedReportServiceServer can be embedded to have forward compatible implementations.
@@ -631,6 +679,9 @@ func (*UnimplementedReportServiceServer) MiningReportCSV(*MiningReportRequest, R
 func (*UnimplementedReportServiceServer) MiningReportPDF(*MiningReportRequest, ReportService_MiningReportPDFServer) error {
 	return status.Errorf(codes.Unimplemented, "method MiningReportPDF not implemented")
 }
+func (*UnimplementedReportServiceServer) MiningReportXLSX(*MiningReportRequest, ReportService_MiningReportXLSXServer) error {
+	return status.Errorf(codes.Unimplemented, "method MiningReportXLSX not implemented")
+}
 
 func RegisterReportServiceServer(s *grpc.Server, srv ReportServiceServer) {
 	s.RegisterService(&_ReportService_serviceDesc, srv)
@@ -696,6 +747,27 @@ func (x *reportServiceMiningReportPDFServer) Send(m *MiningReportResponse) error
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ReportService_MiningReportXLSX_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MiningReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReportServiceServer).MiningReportXLSX(m, &reportServiceMiningReportXLSXServer{stream})
+}
+
+type ReportService_MiningReportXLSXServer interface {
+	Send(*MiningReportResponse) error
+	grpc.ServerStream
+}
+
+type reportServiceMiningReportXLSXServer struct {
+	grpc.ServerStream
+}
+
+func (x *reportServiceMiningReportXLSXServer) Send(m *MiningReportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _ReportService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "extapi.ReportService",
 	HandlerType: (*ReportServiceServer)(nil),
@@ -716,6 +788,11 @@ var _ReportService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ReportService_MiningReportPDF_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "MiningReportXLSX",
+			Handler:       _ReportService_MiningReportXLSX_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "report.proto",
 }
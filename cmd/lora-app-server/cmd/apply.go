@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/ns"
+	"github.com/mxc-foundation/lpwan-app-server/internal/apply"
+	"github.com/mxc-foundation/lpwan-app-server/internal/config"
+	"github.com/mxc-foundation/lpwan-app-server/internal/grpccli"
+	"github.com/mxc-foundation/lpwan-app-server/internal/nscli"
+	"github.com/mxc-foundation/lpwan-app-server/internal/storage/pgstore"
+	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
+)
+
+var applyFile string
+var applyDryRun bool
+
+var applyCmd = &cobra.Command{
+	Use:   "apply -f <MANIFEST>",
+	Short: "create or update organizations, network servers, profiles, applications, integrations and default gateway configs from YAML or TOML manifest",
+	RunE:  runApply,
+}
+
+func init() {
+	applyCmd.Flags().StringVarP(&applyFile, "file", "f", "", "path to manifest file (.yaml or .toml)")
+	applyCmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "print the plan without making any changes")
+	_ = applyCmd.MarkFlagRequired("file")
+}
+
+func runApply(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	cmd.SilenceUsage = true
+
+	m, err := apply.Load(applyFile)
+	if err != nil {
+		return err
+	}
+	asID, err := uuid.FromString(config.C.ApplicationServer.ID)
+	if err != nil {
+		return fmt.Errorf("invalid application server id: %v", err)
+	}
+	if _, err := pgstore.Setup(config.C.PostgreSQL); err != nil {
+		return err
+	}
+	st := store.NewStore()
+
+	nsList, err := st.GetNetworkServers(ctx, ns.NetworkServerFilters{Limit: 999, Offset: 0})
+	if err != nil {
+		return err
+	}
+	var nscfg []nscli.NetworkServerConfig
+	for _, v := range nsList {
+		nscfg = append(nscfg, nscli.NetworkServerConfig{
+			NetworkServerID: v.ID,
+			ConnOptions: grpccli.ConnectionOpts{
+				Server:  v.Server,
+				CACert:  v.CACert,
+				TLSCert: v.TLSCert,
+				TLSKey:  v.TLSKey,
+			},
+		})
+	}
+	nsCli := &nscli.Client{}
+	if err := nsCli.Connect(nscfg); err != nil {
+		return err
+	}
+
+	a := apply.New(st, nsCli, asID, config.C.ApplicationServer.API.PublicHost)
+	changes, err := a.Apply(ctx, m, applyDryRun)
+	counts := make(map[apply.Action]int)
+	for _, c := range changes {
+		fmt.Println(c)
+		counts[c.Action]++
+	}
+	if err != nil {
+		return err
+	}
+
+	verb := "applied"
+	if applyDryRun {
+		verb = "planned"
+	}
+	fmt.Printf("\n%s: %d to create, %d to update, %d unchanged\n",
+		verb, counts[apply.Create], counts[apply.Update], counts[apply.Unchanged])
+	return nil
+}
@@ -395,6 +395,26 @@ id="{{ .ApplicationServer.ID }}"
   max_idle_connections={{ .ApplicationServer.Integration.PostgreSQL.MaxIdleConnections }}
 
 
+  # InfluxDB application integrations.
+  #
+  # These settings apply to the InfluxDB integrations configured for the
+  # applications. The points are buffered and written in batches.
+  [application_server.integration.influxdb]
+  # Max. number of points written with one request.
+  #
+  # When set to 0 or 1, every event is written with a separate request.
+  batch_size={{ .ApplicationServer.Integration.InfluxDB.BatchSize }}
+
+  # Interval at which the buffered points are written.
+  flush_interval="{{ .ApplicationServer.Integration.InfluxDB.FlushInterval }}"
+
+  # Max. number of points buffered per InfluxDB target.
+  #
+  # When the writes fail, the points are kept in the buffer and retried. When
+  # the buffer is full, the oldest points are dropped.
+  max_buffer_size={{ .ApplicationServer.Integration.InfluxDB.MaxBufferSize }}
+
+
   # Settings for the "internal api"
   #
   # This is the API used by ChirpStack Network Server to communicate with ChirpStack Application Server
@@ -446,6 +466,14 @@ id="{{ .ApplicationServer.ID }}"
   # When left blank (default), CORS will not be used.
   cors_allow_origin="{{ .ApplicationServer.ExternalAPI.CORSAllowOrigin }}"
 
+  # Number of the reverse proxies in front of the server.
+  #
+  # The proxies append the address of their client to the X-Forwarded-For
+  # header. The address recorded in the audit log as the source of the
+  # action is taken from the header after skipping this many addresses
+  # appended by the proxies.
+  trusted_proxies={{ .ApplicationServer.ExternalAPI.TrustedProxies }}
+
 
   # Settings for the remote multicast setup.
   [application_server.remote_multicast_setup]
@@ -470,6 +498,129 @@ id="{{ .ApplicationServer.ID }}"
   # Synchronization batch-size.
   sync_batch_size={{ .ApplicationServer.FragmentationSession.SyncBatchSize }}
 
+
+  # Gateway ping history settings.
+  [application_server.gateway_ping]
+  # Pings older than this are deleted.
+  #
+  # When set to 0, the ping history is kept forever.
+  history_retention="{{ .ApplicationServer.GatewayPing.HistoryRetention }}"
+
+
+  # Leader election for the background jobs.
+  #
+  # When running more than one replica of the application server, the
+  # background jobs (e.g. firmware rollouts, mining, airdrops) must run on a
+  # single replica only. The replicas elect the leader for each job.
+  [application_server.leader_election]
+  # Backend used for the election, either "redis" or "postgres".
+  #
+  # When left blank, every replica runs every job, which is only safe when a
+  # single replica is running.
+  backend="{{ .ApplicationServer.LeaderElection.Backend }}"
+
+  # Time for which the leadership is kept when the leader dies without
+  # releasing it (30s when set to 0).
+  #
+  # Only used by the redis backend, the postgres backend releases the
+  # leadership as soon as the connection of the leader is closed.
+  ttl="{{ .ApplicationServer.LeaderElection.TTL }}"
+
+
+  # Mining and staking statements.
+  #
+  # Organization admins can subscribe to weekly or monthly statements of the
+  # mining and staking income of the organization, which are sent by email.
+  [application_server.statement]
+  # Enable sending of the statements.
+  enabled={{ .ApplicationServer.Statement.Enabled }}
+
+  # Fiat currency in which the value of the mined MXC is shown (usd when left
+  # blank).
+  fiat_currency="{{ .ApplicationServer.Statement.FiatCurrency }}"
+
+
+  # Prices of the crypto currencies in fiat currencies.
+  [application_server.price]
+  # Price providers in the order in which they are queried.
+  #
+  # When a provider fails, the next one is queried. Available providers are
+  # "coingecko" and "cryptocompare" (coingecko when left empty).
+  providers=[{{ if .ApplicationServer.Price.Providers|len }}"{{ end }}{{ range $index, $elm := .ApplicationServer.Price.Providers }}{{ if $index }}", "{{ end }}{{ $elm }}{{ end }}{{ if .ApplicationServer.Price.Providers|len }}"{{ end }}]
+
+  # CryptoCompare API key (optional).
+  cryptocompare_api_key="{{ .ApplicationServer.Price.CryptoCompareAPIKey }}"
+
+  # Currency pairs for which the daily close prices are recorded, e.g.
+  # "mxc/usd".
+  #
+  # The recorded prices are used to value the mining income in the reports
+  # and statements. Close prices of the other pairs are recorded when they
+  # are requested for the first time.
+  history_pairs=[{{ if .ApplicationServer.Price.HistoryPairs|len }}"{{ end }}{{ range $index, $elm := .ApplicationServer.Price.HistoryPairs }}{{ if $index }}", "{{ end }}{{ $elm }}{{ end }}{{ if .ApplicationServer.Price.HistoryPairs|len }}"{{ end }}]
+
+  # Max. age of the recorded close price that is returned as the current
+  # price when none of the providers is available (48h when set to 0).
+  fallback_max_age="{{ .ApplicationServer.Price.FallbackMaxAge }}"
+
+
+  # Device data stored by the PostgreSQL integration.
+  [application_server.device_data]
+  # Time after which the stored device events are removed.
+  #
+  # When set to 0, the events are kept forever.
+  ttl="{{ .ApplicationServer.DeviceData.TTL }}"
+
+
+  # Provisioning of the devices over the gateways.
+  [application_server.device_provisioning]
+  # Backend keeping the provisioning sessions.
+  #
+  # Valid options are:
+  #  * memory (default): can be used only when running a single instance
+  #  * redis: the sessions are shared by all the instances
+  session_store="{{ .ApplicationServer.DeviceProvisioning.SessionStore }}"
+
+  # Hex encoded AES key (16, 24 or 32 bytes) used to encrypt the sessions.
+  #
+  # It is required when the sessions are stored in redis.
+  session_encryption_key="{{ .ApplicationServer.DeviceProvisioning.SessionEncryptionKey }}"
+
+  # Max. number of the active sessions (5000 when set to 0).
+  max_sessions={{ .ApplicationServer.DeviceProvisioning.MaxSessions }}
+
+  # Time after which the session expires (5m when set to 0).
+  session_lifetime="{{ .ApplicationServer.DeviceProvisioning.SessionLifetime }}"
+
+
+  # Outbox queueing the emails sent by the application server.
+  [application_server.email_outbox]
+  # Queue the emails in the outbox.
+  #
+  # When disabled, the emails are sent immediately by the API handlers and
+  # are lost when the SMTP server is not available.
+  enabled={{ .ApplicationServer.EmailOutbox.Enabled }}
+
+  # Number of attempts after which the email is marked as failed (10 when
+  # set to 0).
+  max_attempts={{ .ApplicationServer.EmailOutbox.MaxAttempts }}
+
+  # Delay before the first retry, it doubles with every next attempt up to
+  # max_backoff (1m and 6h when set to 0).
+  initial_backoff="{{ .ApplicationServer.EmailOutbox.InitialBackoff }}"
+  max_backoff="{{ .ApplicationServer.EmailOutbox.MaxBackoff }}"
+
+  # Max. number of the emails sent to the same recipient per hour (20 when
+  # set to 0).
+  recipient_rate_limit={{ .ApplicationServer.EmailOutbox.RecipientRateLimit }}
+
+  # How often the outbox is checked for the emails to be sent (5s when set
+  # to 0).
+  poll_interval="{{ .ApplicationServer.EmailOutbox.PollInterval }}"
+
+  # Time for which the sent and failed emails are kept (720h when set to 0).
+  retention="{{ .ApplicationServer.EmailOutbox.Retention }}"
+
 {{ if ne .ApplicationServer.Branding.Footer  "" }}
   # Branding configuration.
   [application_server.branding]
@@ -397,14 +397,7 @@ func (a *ApplicationAPI) CreateInfluxDBIntegration(ctx context.Context, in *pb.C
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	conf := influxdb.Config{
-		Endpoint:            in.Integration.Endpoint,
-		DB:                  in.Integration.Db,
-		Username:            in.Integration.Username,
-		Password:            in.Integration.Password,
-		RetentionPolicyName: in.Integration.RetentionPolicyName,
-		Precision:           strings.ToLower(in.Integration.Precision.String()),
-	}
+	conf := influxDBConfig(in.Integration)
 	if err := conf.Validate(); err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
@@ -443,6 +436,10 @@ func (a *ApplicationAPI) GetInfluxDBIntegration(ctx context.Context, in *pb.GetI
 	}
 
 	prec, _ := pb.InfluxDBPrecision_value[strings.ToUpper(conf.Precision)]
+	version := pb.InfluxDBVersion_INFLUXDB_1
+	if conf.Version == influxdb.Version2 {
+		version = pb.InfluxDBVersion_INFLUXDB_2
+	}
 
 	return &pb.GetInfluxDBIntegrationResponse{
 		Integration: &pb.InfluxDBIntegration{
@@ -453,10 +450,34 @@ func (a *ApplicationAPI) GetInfluxDBIntegration(ctx context.Context, in *pb.GetI
 			Password:            conf.Password,
 			RetentionPolicyName: conf.RetentionPolicyName,
 			Precision:           pb.InfluxDBPrecision(prec),
+			Version:             version,
+			Token:               conf.Token,
+			Organization:        conf.Organization,
+			Bucket:              conf.Bucket,
 		},
 	}, nil
 }
 
+// influxDBConfig returns the configuration of the InfluxDB integration
+func influxDBConfig(in *pb.InfluxDBIntegration) influxdb.Config {
+	conf := influxdb.Config{
+		Version:             influxdb.Version1,
+		Endpoint:            in.Endpoint,
+		DB:                  in.Db,
+		Username:            in.Username,
+		Password:            in.Password,
+		RetentionPolicyName: in.RetentionPolicyName,
+		Precision:           strings.ToLower(in.Precision.String()),
+	}
+	if in.Version == pb.InfluxDBVersion_INFLUXDB_2 {
+		conf.Version = influxdb.Version2
+		conf.Token = in.Token
+		conf.Organization = in.Organization
+		conf.Bucket = in.Bucket
+	}
+	return conf
+}
+
 // UpdateInfluxDBIntegration updates the InfluxDB application-integration.
 func (a *ApplicationAPI) UpdateInfluxDBIntegration(ctx context.Context, in *pb.UpdateInfluxDBIntegrationRequest) (*empty.Empty, error) {
 	if in.Integration == nil {
@@ -472,14 +493,7 @@ func (a *ApplicationAPI) UpdateInfluxDBIntegration(ctx context.Context, in *pb.U
 		return nil, helpers.ErrToRPCError(err)
 	}
 
-	conf := influxdb.Config{
-		Endpoint:            in.Integration.Endpoint,
-		DB:                  in.Integration.Db,
-		Username:            in.Integration.Username,
-		Password:            in.Integration.Password,
-		RetentionPolicyName: in.Integration.RetentionPolicyName,
-		Precision:           strings.ToLower(in.Integration.Precision.String()),
-	}
+	conf := influxDBConfig(in.Integration)
 	if err := conf.Validate(); err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
@@ -758,6 +772,13 @@ func (a *ApplicationAPI) CreateLoRaCloudIntegration(ctx context.Context, in *pb.
 		DAS:                         in.GetIntegration().Das,
 		DASToken:                    in.GetIntegration().DasToken,
 		DASModemPort:                uint8(in.GetIntegration().DasModemPort),
+		DASGNSSPort:                 uint8(in.GetIntegration().DasGnssPort),
+		DASStreamPort:               uint8(in.GetIntegration().DasStreamPort),
+		GeolocationURI:              in.GetIntegration().GeolocationUri,
+		DASURI:                      in.GetIntegration().DasUri,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 	confJSON, err := json.Marshal(config)
 	if err != nil {
@@ -810,6 +831,10 @@ func (a *ApplicationAPI) GetLoRaCloudIntegration(ctx context.Context, in *pb.Get
 			Das:                         conf.DAS,
 			DasToken:                    conf.DASToken,
 			DasModemPort:                uint32(conf.DASModemPort),
+			DasGnssPort:                 uint32(conf.DASGNSSPort),
+			DasStreamPort:               uint32(conf.DASStreamPort),
+			GeolocationUri:              conf.GeolocationURI,
+			DasUri:                      conf.DASURI,
 		},
 	}, nil
 }
@@ -844,6 +869,13 @@ func (a *ApplicationAPI) UpdateLoRaCloudIntegration(ctx context.Context, in *pb.
 		DAS:                         in.GetIntegration().Das,
 		DASToken:                    in.GetIntegration().DasToken,
 		DASModemPort:                uint8(in.GetIntegration().DasModemPort),
+		DASGNSSPort:                 uint8(in.GetIntegration().DasGnssPort),
+		DASStreamPort:               uint8(in.GetIntegration().DasStreamPort),
+		GeolocationURI:              in.GetIntegration().GeolocationUri,
+		DASURI:                      in.GetIntegration().DasUri,
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 	confJSON, err := json.Marshal(conf)
 	if err != nil {
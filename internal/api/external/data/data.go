@@ -8,4 +8,7 @@ type ExternalAPIStruct struct {
 	JWTDefaultTTL   int64  `mapstructure:"jwt_default_ttl_sec"`
 	OTPSecret       string `mapstructure:"otp_secret"`
 	CORSAllowOrigin string `mapstructure:"cors_allow_origin"`
+	// TrustedProxies is the number of the reverse proxies in front of the
+	// server, used to find the address of the client in x-forwarded-for
+	TrustedProxies int `mapstructure:"trusted_proxies"`
 }
@@ -62,7 +62,7 @@ func (a *ProvisionedDeviceAPI) Create(ctx context.Context, req *api.CreateReques
 	}
 
 	// only organizaiton admin or device admin can create device
-	if !cred.IsDeviceAdmin {
+	if !cred.Has(auth.PermDeviceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied: neither org admin nor device admin")
 	}
 
@@ -3,6 +3,7 @@ package external
 import (
 	"database/sql"
 	"encoding/json"
+	"sort"
 	"strconv"
 
 	appd "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
@@ -28,11 +29,13 @@ import (
 	psPb "github.com/mxc-foundation/lpwan-app-server/api/ps-serves-appserver"
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/device"
 	dps "github.com/mxc-foundation/lpwan-app-server/internal/api/external/dp"
-	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
+	"github.com/mxc-foundation/lpwan-app-server/internal/audit"
 	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
 	authcus "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
+	"github.com/mxc-foundation/lpwan-app-server/internal/devicedata"
 	"github.com/mxc-foundation/lpwan-app-server/internal/eventlog"
+	"github.com/mxc-foundation/lpwan-app-server/internal/integration/loracloud"
 	"github.com/mxc-foundation/lpwan-app-server/internal/logging"
 	"github.com/mxc-foundation/lpwan-app-server/internal/modules/application"
 	devmod "github.com/mxc-foundation/lpwan-app-server/internal/modules/device"
@@ -52,11 +55,14 @@ type DeviceAPI struct {
 	mxpCli              pb.DSDeviceServiceClient
 	psCli               psPb.DeviceProvisionClient
 	nsCli               *nscli.Client
+	// deviceData is the store of the device events, nil if the postgresql
+	// integration is not enabled
+	deviceData devicedata.Store
 }
 
 // NewDeviceAPI creates a new NodeAPI.
 func NewDeviceAPI(applicationID uuid.UUID, h *store.Handler, mxpCli *mxpcli.Client,
-	psCli *pscli.Client, nsCli *nscli.Client, auth auth.Authenticator) *DeviceAPI {
+	psCli *pscli.Client, nsCli *nscli.Client, deviceData devicedata.Store, auth auth.Authenticator) *DeviceAPI {
 	return &DeviceAPI{
 		st:                  h,
 		ApplicationServerID: applicationID,
@@ -64,6 +70,7 @@ func NewDeviceAPI(applicationID uuid.UUID, h *store.Handler, mxpCli *mxpcli.Clie
 		mxpCli:              mxpCli.GetM2MDeviceServiceClient(),
 		psCli:               psCli.GetDeviceProvisionServiceClient(),
 		nsCli:               nsCli,
+		deviceData:          deviceData,
 	}
 }
 
@@ -107,7 +114,7 @@ func (a *DeviceAPI) Create(ctx context.Context, req *api.CreateDeviceRequest) (*
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsDeviceAdmin {
+	if !cred.Has(auth.PermDeviceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -169,7 +176,7 @@ func (a *DeviceAPI) Get(ctx context.Context, req *api.GetDeviceRequest) (*api.Ge
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
+	if !cred.Has(auth.PermDeviceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -417,7 +424,7 @@ func (a *DeviceAPI) Update(ctx context.Context, req *api.UpdateDeviceRequest) (*
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsDeviceAdmin {
+	if !cred.Has(auth.PermDeviceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -498,7 +505,7 @@ func (a *DeviceAPI) Delete(ctx context.Context, req *api.DeleteDeviceRequest) (*
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsDeviceAdmin {
+	if !cred.Has(auth.PermDeviceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -508,6 +515,18 @@ func (a *DeviceAPI) Delete(ctx context.Context, req *api.DeleteDeviceRequest) (*
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	// the device is gone, the failure to remove its data is not reported
+	// to the caller, the expired data is removed by the retention policies
+	if a.deviceData != nil {
+		if _, err := devicedata.DeleteAllTypes(ctx, a.deviceData, devicedata.DeleteFilter{
+			DevEUIs: []lorawan.EUI64{eui},
+		}); err != nil {
+			log.WithError(err).WithField("dev_eui", eui).Error("couldn't delete device events")
+		}
+	}
+	if err := loracloud.DeleteGeolocBuffer(ctx, eui); err != nil {
+		log.WithError(err).WithField("dev_eui", eui).Error("couldn't delete geolocation buffer")
+	}
 
 	return &response, nil
 }
@@ -572,8 +591,20 @@ func (a *DeviceAPI) GetKeys(ctx context.Context, req *api.GetDeviceKeysRequest)
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	if valid, err := devmod.NewValidator(a.st).ValidateNodeAccess(ctx, authcus.Update, eui); !valid || err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	d, err := a.st.GetDevice(ctx, eui, false)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	app, err := a.st.GetApplication(ctx, d.ApplicationID)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(app.OrganizationID))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.Has(auth.PermDeviceKeysRead) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
 	dk, err := a.st.GetDeviceKeys(ctx, eui)
@@ -798,14 +829,22 @@ func (a *DeviceAPI) GetActivation(ctx context.Context, req *api.GetDeviceActivat
 		return nil, status.Errorf(codes.InvalidArgument, "devEUI: %s", err)
 	}
 
-	if valid, err := devmod.NewValidator(a.st).ValidateNodeAccess(ctx, authcus.Read, devEUI); !valid || err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
-	}
-
 	d, err := a.st.GetDevice(ctx, devEUI, false)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
+	app, err := a.st.GetApplication(ctx, d.ApplicationID)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	// the activation contains the session keys of the device
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(app.OrganizationID))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.Has(auth.PermDeviceKeysRead) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
 
 	n, err := a.st.GetNetworkServerForDevEUI(ctx, devEUI)
 	if err != nil {
@@ -1094,17 +1133,12 @@ func ConvertUplinkAndDownlinkFrames(up *ns.UplinkFrameLog, down *ns.DownlinkFram
 func (a *DeviceAPI) GetDeviceList(ctx context.Context, req *api.GetDeviceListRequest) (*api.GetDeviceListResponse, error) {
 	logInfo := "api/appserver_serves_ui/GetDeviceList org=" + strconv.FormatInt(req.OrgId, 10)
 
-	// verify if user is global admin
-	u, err := devmod.NewValidator(a.st).GetUser(ctx)
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(req.OrgId))
 	if err != nil {
-		log.WithError(err).Error(logInfo)
-		return &api.GetDeviceListResponse{}, status.Errorf(codes.Internal, "unable to verify user: %s", err.Error())
+		return &api.GetDeviceListResponse{}, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	// is user is not global admin, user must have accesss to this organization
-	if !u.IsGlobalAdmin {
-		if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, authcus.Read, req.OrgId); !valid || err != nil {
-			return &api.GetDeviceListResponse{}, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
-		}
+	if !cred.Has(auth.PermDeviceRead) {
+		return &api.GetDeviceListResponse{}, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
 	devClient := mxpcli.Global.GetM2MDeviceServiceClient()
@@ -1156,17 +1190,12 @@ func (a *DeviceAPI) GetDeviceList(ctx context.Context, req *api.GetDeviceListReq
 func (a *DeviceAPI) GetDeviceProfile(ctx context.Context, req *api.GetDSDeviceProfileRequest) (*api.GetDSDeviceProfileResponse, error) {
 	logInfo := "api/appserver_serves_ui/GetDeviceProfile org=" + strconv.FormatInt(req.OrgId, 10)
 
-	// verify if user is global admin
-	u, err := devmod.NewValidator(a.st).GetUser(ctx)
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(req.OrgId))
 	if err != nil {
-		log.WithError(err).Error(logInfo)
-		return &api.GetDSDeviceProfileResponse{}, status.Errorf(codes.Internal, "unable to verify user: %s", err.Error())
+		return &api.GetDSDeviceProfileResponse{}, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	// is user is not global admin, user must have accesss to this organization
-	if !u.IsGlobalAdmin {
-		if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, authcus.Read, req.OrgId); !valid || err != nil {
-			return &api.GetDSDeviceProfileResponse{}, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
-		}
+	if !cred.Has(auth.PermDeviceRead) {
+		return &api.GetDSDeviceProfileResponse{}, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
 	devClient := mxpcli.Global.GetM2MDeviceServiceClient()
@@ -1198,17 +1227,12 @@ func (a *DeviceAPI) GetDeviceProfile(ctx context.Context, req *api.GetDSDevicePr
 func (a *DeviceAPI) GetDeviceHistory(ctx context.Context, req *api.GetDeviceHistoryRequest) (*api.GetDeviceHistoryResponse, error) {
 	logInfo := "api/appserver_serves_ui/GetDeviceHistory org=" + strconv.FormatInt(req.OrgId, 10)
 
-	// verify if user is global admin
-	u, err := devmod.NewValidator(a.st).GetUser(ctx)
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(req.OrgId))
 	if err != nil {
-		log.WithError(err).Error(logInfo)
-		return &api.GetDeviceHistoryResponse{}, status.Errorf(codes.Internal, "unable to verify user: %s", err.Error())
+		return &api.GetDeviceHistoryResponse{}, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	// is user is not global admin, user must have accesss to this organization
-	if !u.IsGlobalAdmin {
-		if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, authcus.Read, req.OrgId); !valid || err != nil {
-			return &api.GetDeviceHistoryResponse{}, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
-		}
+	if !cred.Has(auth.PermDeviceRead) {
+		return &api.GetDeviceHistoryResponse{}, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
 	devClient := mxpcli.Global.GetM2MDeviceServiceClient()
@@ -1233,17 +1257,12 @@ func (a *DeviceAPI) GetDeviceHistory(ctx context.Context, req *api.GetDeviceHist
 func (a *DeviceAPI) SetDeviceMode(ctx context.Context, req *api.SetDeviceModeRequest) (*api.SetDeviceModeResponse, error) {
 	logInfo := "api/appserver_serves_ui/SetDeviceMode org=" + strconv.FormatInt(req.OrgId, 10)
 
-	// verify if user is global admin
-	u, err := devmod.NewValidator(a.st).GetUser(ctx)
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(req.OrgId))
 	if err != nil {
-		log.WithError(err).Error(logInfo)
-		return &api.SetDeviceModeResponse{}, status.Errorf(codes.Internal, "unable to verify user: %s", err.Error())
+		return &api.SetDeviceModeResponse{}, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	// is user is not global admin, user must have accesss to this organization
-	if !u.IsGlobalAdmin {
-		if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, authcus.Read, req.OrgId); !valid || err != nil {
-			return &api.SetDeviceModeResponse{}, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
-		}
+	if !cred.Has(auth.PermDeviceWrite) {
+		return &api.SetDeviceModeResponse{}, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
 	devClient := mxpcli.Global.GetM2MDeviceServiceClient()
@@ -1262,3 +1281,209 @@ func (a *DeviceAPI) SetDeviceMode(ctx context.Context, req *api.SetDeviceModeReq
 		Status: resp.Status,
 	}, status.Error(codes.OK, "")
 }
+
+// bulkUpdateState is the bulk update recorded in the audit log, only the
+// keys of the variables are recorded as their values may be secrets
+type bulkUpdateState struct {
+	DevEUIs         []string          `json:"dev_euis"`
+	SetTags         map[string]string `json:"set_tags,omitempty"`
+	UnsetTags       []string          `json:"unset_tags,omitempty"`
+	SetVariableKeys []string          `json:"set_variable_keys,omitempty"`
+	UnsetVariables  []string          `json:"unset_variables,omitempty"`
+	DeviceProfileID string            `json:"device_profile_id,omitempty"`
+}
+
+func toHstore(m map[string]string) hstore.Hstore {
+	h := hstore.Hstore{Map: make(map[string]sql.NullString)}
+	for k, v := range m {
+		h.Map[k] = sql.NullString{String: v, Valid: true}
+	}
+	return h
+}
+
+// maxBulkUpdateDevices is the max number of the devices updated by one bulk
+// update, the devices are locked and updated in one transaction
+const maxBulkUpdateDevices = 1000
+
+// BulkUpdate updates the tags, variables and device profile of the devices of
+// the organization matching the filters. All the devices are updated or none.
+func (a *DeviceAPI) BulkUpdate(ctx context.Context, req *api.BulkUpdateDevicesRequest) (*api.BulkUpdateDevicesResponse, error) {
+	if req.OrganizationId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "organization_id must be set")
+	}
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(req.OrganizationId))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.Has(auth.PermDeviceWrite) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	filters := BulkDeviceFilters{
+		OrganizationID: req.OrganizationId,
+		ApplicationID:  req.ApplicationId,
+		// one more to find out if there are too many devices
+		Limit: maxBulkUpdateDevices + 1,
+	}
+	if len(req.Tags) != 0 {
+		filters.Tags = toHstore(req.Tags)
+	}
+	if req.DeviceProfileId != "" {
+		if filters.DeviceProfileID, err = uuid.FromString(req.DeviceProfileId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid device profile: %v", err)
+		}
+	}
+	for _, s := range req.DevEuis {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(s)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid dev_eui %s: %v", s, err)
+		}
+		filters.DevEUIs = append(filters.DevEUIs, devEUI)
+	}
+	if filters.IsEmpty() {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"application_id, tags, device_profile_id or dev_euis must be set")
+	}
+
+	upd := BulkDeviceUpdate{
+		SetTags:        toHstore(req.SetTags),
+		UnsetTags:      req.UnsetTags,
+		SetVariables:   toHstore(req.SetVariables),
+		UnsetVariables: req.UnsetVariables,
+	}
+	if req.NewDeviceProfileId != "" {
+		if upd.DeviceProfileID, err = uuid.FromString(req.NewDeviceProfileId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid new device profile: %v", err)
+		}
+	}
+	if len(req.SetTags) == 0 && len(req.UnsetTags) == 0 && len(req.SetVariables) == 0 &&
+		len(req.UnsetVariables) == 0 && upd.DeviceProfileID == uuid.Nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no changes requested")
+	}
+
+	var count int64
+	var devices []BulkDevice
+	if err := a.st.Tx(ctx, func(ctx context.Context, handler *store.Handler) error {
+		devices, err = handler.GetDevicesForBulkUpdate(ctx, filters, !req.DryRun)
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+		if len(devices) > maxBulkUpdateDevices {
+			return status.Errorf(codes.InvalidArgument,
+				"more than %d devices match the filters, at most %d devices can be updated at once",
+				maxBulkUpdateDevices, maxBulkUpdateDevices)
+		}
+		if len(devices) == 0 {
+			return nil
+		}
+		// the dry run fails for the same reasons as the update
+		var dp dps.DeviceProfile
+		if upd.DeviceProfileID != uuid.Nil {
+			if dp, err = a.verifyBulkDeviceProfile(ctx, handler, req.OrganizationId, devices,
+				upd.DeviceProfileID); err != nil {
+				return err
+			}
+		}
+		if req.DryRun {
+			count = int64(len(devices))
+			return nil
+		}
+		if count, err = handler.BulkUpdateDevices(ctx, devices, upd); err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+		// update the network server at last, so that if it fails the
+		// changes in the database are rolled back
+		if upd.DeviceProfileID != uuid.Nil {
+			nsClient, err := a.nsCli.GetNetworkServerServiceClient(dp.NetworkServerID)
+			if err != nil {
+				return status.Errorf(codes.Internal, err.Error())
+			}
+			return assignDeviceProfile(ctx, nsClient, devices, upd.DeviceProfileID)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if !req.DryRun && count != 0 {
+		state := bulkUpdateState{
+			SetTags:        req.SetTags,
+			UnsetTags:      req.UnsetTags,
+			UnsetVariables: req.UnsetVariables,
+		}
+		for k := range req.SetVariables {
+			state.SetVariableKeys = append(state.SetVariableKeys, k)
+		}
+		sort.Strings(state.SetVariableKeys)
+		if upd.DeviceProfileID != uuid.Nil {
+			state.DeviceProfileID = upd.DeviceProfileID.String()
+		}
+		for _, d := range devices {
+			state.DevEUIs = append(state.DevEUIs, d.DevEUI.String())
+		}
+		audit.Describe(ctx, req.OrganizationId, "devices", nil, state)
+	}
+
+	return &api.BulkUpdateDevicesResponse{Count: count}, nil
+}
+
+// verifyBulkDeviceProfile checks that the device profile exists, belongs to
+// the organization and is at the same network server as the current device
+// profiles of the devices
+func (a *DeviceAPI) verifyBulkDeviceProfile(ctx context.Context, handler *store.Handler, orgID int64,
+	devices []BulkDevice, dpID uuid.UUID) (dps.DeviceProfile, error) {
+	dp, err := handler.GetDeviceProfile(ctx, dpID, false)
+	if err != nil {
+		return dp, helpers.ErrToRPCError(err)
+	}
+	if dp.OrganizationID != orgID {
+		return dp, status.Errorf(codes.InvalidArgument, "device profile belongs to a different organization")
+	}
+	verified := make(map[uuid.UUID]bool)
+	for _, d := range devices {
+		if verified[d.DeviceProfileID] {
+			continue
+		}
+		if err := a.verifyDeviceProfileChange(ctx, dpID, d.DeviceProfileID); err != nil {
+			return dp, err
+		}
+		verified[d.DeviceProfileID] = true
+	}
+	return dp, nil
+}
+
+// assignDeviceProfile assigns the device profile to the devices on the
+// network server. If any of the updates fails, the devices updated so far
+// are reverted to their previous device profile.
+func assignDeviceProfile(ctx context.Context, nsClient ns.NetworkServerServiceClient, devices []BulkDevice,
+	dpID uuid.UUID) error {
+	var updated []*ns.Device
+	revert := func() {
+		for _, nsDev := range updated {
+			if _, err := nsClient.UpdateDevice(ctx, &ns.UpdateDeviceRequest{Device: nsDev}); err != nil {
+				log.WithError(err).WithField("dev_eui", hex.EncodeToString(nsDev.DevEui)).
+					Error("bulk update: revert device profile error")
+			}
+		}
+	}
+	for _, d := range devices {
+		if d.DeviceProfileID == dpID {
+			continue
+		}
+		res, err := nsClient.GetDevice(ctx, &ns.GetDeviceRequest{DevEui: d.DevEUI[:]})
+		if err != nil {
+			revert()
+			return status.Errorf(codes.Unknown, "get device %s error: %v", d.DevEUI, err)
+		}
+		nsDev := res.Device
+		oldDPID := nsDev.DeviceProfileId
+		nsDev.DeviceProfileId = dpID.Bytes()
+		if _, err := nsClient.UpdateDevice(ctx, &ns.UpdateDeviceRequest{Device: nsDev}); err != nil {
+			revert()
+			return status.Errorf(codes.Unknown, "update device %s error: %v", d.DevEUI, err)
+		}
+		nsDev.DeviceProfileId = oldDPID
+		updated = append(updated, nsDev)
+	}
+	return nil
+}
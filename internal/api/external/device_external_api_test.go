@@ -0,0 +1,83 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brocaar/chirpstack-api/go/v3/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+
+	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/device/data"
+)
+
+// bulkNSClient keeps the device profiles of the devices in memory, updating
+// the device failOn fails
+type bulkNSClient struct {
+	ns.NetworkServerServiceClient
+	profiles map[lorawan.EUI64][]byte
+	failOn   lorawan.EUI64
+}
+
+func (c *bulkNSClient) GetDevice(ctx context.Context, in *ns.GetDeviceRequest,
+	opts ...grpc.CallOption) (*ns.GetDeviceResponse, error) {
+	var eui lorawan.EUI64
+	copy(eui[:], in.DevEui)
+	// the response doesn't share the memory with the request, as the one
+	// received from the network server
+	return &ns.GetDeviceResponse{Device: &ns.Device{DevEui: eui[:], DeviceProfileId: c.profiles[eui]}}, nil
+}
+
+func (c *bulkNSClient) UpdateDevice(ctx context.Context, in *ns.UpdateDeviceRequest,
+	opts ...grpc.CallOption) (*empty.Empty, error) {
+	var eui lorawan.EUI64
+	copy(eui[:], in.Device.DevEui)
+	if eui == c.failOn {
+		return nil, errors.New("update failed")
+	}
+	c.profiles[eui] = in.Device.DeviceProfileId
+	return &empty.Empty{}, nil
+}
+
+func TestAssignDeviceProfile(t *testing.T) {
+	ctx := context.Background()
+	oldDP, newDP := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+	devices := []BulkDevice{
+		{DevEUI: lorawan.EUI64{1}, DeviceProfileID: oldDP},
+		{DevEUI: lorawan.EUI64{2}, DeviceProfileID: newDP},
+		{DevEUI: lorawan.EUI64{3}, DeviceProfileID: oldDP},
+	}
+	newClient := func() *bulkNSClient {
+		cli := &bulkNSClient{profiles: make(map[lorawan.EUI64][]byte)}
+		for _, d := range devices {
+			cli.profiles[d.DevEUI] = d.DeviceProfileID.Bytes()
+		}
+		return cli
+	}
+
+	cli := newClient()
+	if err := assignDeviceProfile(ctx, cli, devices, newDP); err != nil {
+		t.Fatal(err)
+	}
+	for eui, dp := range cli.profiles {
+		if !bytes.Equal(dp, newDP.Bytes()) {
+			t.Errorf("device %s: expected the new device profile", eui)
+		}
+	}
+
+	// the devices updated before the failure get their previous profile back
+	cli = newClient()
+	cli.failOn = lorawan.EUI64{3}
+	if err := assignDeviceProfile(ctx, cli, devices, newDP); err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, d := range devices {
+		if !bytes.Equal(cli.profiles[d.DevEUI], d.DeviceProfileID.Bytes()) {
+			t.Errorf("device %s: expected the device profile to be reverted", d.DevEUI)
+		}
+	}
+}
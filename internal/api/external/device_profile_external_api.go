@@ -52,7 +52,7 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsDeviceAdmin {
+	if !cred.Has(auth.PermDeviceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -133,7 +133,7 @@ func (a *DeviceProfileServiceAPI) Get(ctx context.Context, req *pb.GetDeviceProf
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
+	if !cred.Has(auth.PermDeviceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -215,7 +215,7 @@ func (a *DeviceProfileServiceAPI) Update(ctx context.Context, req *pb.UpdateDevi
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsDeviceAdmin {
+	if !cred.Has(auth.PermDeviceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -301,7 +301,7 @@ func (a *DeviceProfileServiceAPI) Delete(ctx context.Context, req *pb.DeleteDevi
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsDeviceAdmin {
+	if !cred.Has(auth.PermDeviceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -320,7 +320,7 @@ func (a *DeviceProfileServiceAPI) List(ctx context.Context, req *pb.ListDevicePr
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
+	if !cred.Has(auth.PermDeviceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
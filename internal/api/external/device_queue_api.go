@@ -14,9 +14,7 @@ import (
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/device"
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
 	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
-	authcus "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
 	"github.com/mxc-foundation/lpwan-app-server/internal/codec"
-	devmod "github.com/mxc-foundation/lpwan-app-server/internal/modules/device"
 	"github.com/mxc-foundation/lpwan-app-server/internal/nscli"
 	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
 )
@@ -37,6 +35,27 @@ func NewDeviceQueueAPI(h *store.Handler, nsCli *nscli.Client, auth auth.Authenti
 	}
 }
 
+// checkDevicePermission checks that the user has the permission in the
+// organization of the device
+func (d *DeviceQueueAPI) checkDevicePermission(ctx context.Context, devEUI lorawan.EUI64, perm auth.Permission) error {
+	device, err := d.st.GetDevice(ctx, devEUI, false)
+	if err != nil {
+		return helpers.ErrToRPCError(err)
+	}
+	app, err := d.st.GetApplication(ctx, device.ApplicationID)
+	if err != nil {
+		return helpers.ErrToRPCError(err)
+	}
+	cred, err := d.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(app.OrganizationID))
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.Has(perm) {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
 // Enqueue adds the given item to the device-queue.
 func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueueItemRequest) (*pb.EnqueueDeviceQueueItemResponse, error) {
 	var fCnt uint32
@@ -54,8 +73,8 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 		return nil, status.Errorf(codes.InvalidArgument, "devEUI: %s", err)
 	}
 
-	if valid, err := devmod.NewValidator(d.st).ValidateDeviceQueueAccess(ctx, devEUI, authcus.Create); !valid || err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	if err := d.checkDevicePermission(ctx, devEUI, auth.PermDeviceQueue); err != nil {
+		return nil, err
 	}
 
 	if err := d.st.Tx(ctx, func(ctx context.Context, handler *store.Handler) error {
@@ -117,8 +136,8 @@ func (d *DeviceQueueAPI) Flush(ctx context.Context, req *pb.FlushDeviceQueueRequ
 		return nil, status.Errorf(codes.InvalidArgument, "devEUI: %s", err)
 	}
 
-	if valid, err := devmod.NewValidator(d.st).ValidateDeviceQueueAccess(ctx, devEUI, authcus.Delete); !valid || err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	if err := d.checkDevicePermission(ctx, devEUI, auth.PermDeviceQueue); err != nil {
+		return nil, err
 	}
 
 	n, err := d.st.GetNetworkServerForDevEUI(ctx, devEUI)
@@ -146,21 +165,13 @@ func (d *DeviceQueueAPI) List(ctx context.Context, req *pb.ListDeviceQueueItemsR
 		return nil, status.Errorf(codes.InvalidArgument, "devEUI: %s", err)
 	}
 
-	device, err := d.st.GetDevice(ctx, devEUI, false)
-	if err != nil {
-		return nil, helpers.ErrToRPCError(err)
+	if err := d.checkDevicePermission(ctx, devEUI, auth.PermDeviceRead); err != nil {
+		return nil, err
 	}
-	app, err := d.st.GetApplication(ctx, device.ApplicationID)
+	device, err := d.st.GetDevice(ctx, devEUI, false)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
-	cred, err := d.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(app.OrganizationID))
-	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
-	}
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-	}
 
 	n, err := d.st.GetNetworkServerForDevEUI(ctx, devEUI)
 	if err != nil {
@@ -43,7 +43,7 @@ func (a *Server) DHXCreateStake(ctx context.Context, req *api.DHXCreateStakeRequ
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -68,7 +68,7 @@ func (a *Server) DHXCreateCouncil(ctx context.Context, req *api.DHXCreateCouncil
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -139,7 +139,8 @@ func (a *Server) DHXListStakes(ctx context.Context, req *api.DHXListStakesReques
 		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
 		}
-		if !cred.IsOrgUser && !cred.IsGlobalAdmin {
+		// any member of the organization can see the stakes
+		if cred.Permissions() == 0 {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 
@@ -148,7 +149,8 @@ func (a *Server) DHXListStakes(ctx context.Context, req *api.DHXListStakesReques
 		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
 		}
-		if !cred.IsOrgUser && !cred.IsGlobalAdmin {
+		// any member of the organization can see the stakes
+		if cred.Permissions() == 0 {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 	} else {
@@ -198,7 +200,7 @@ func (a *Server) DHXMiningHistory(ctx context.Context, req *api.DHXMiningHistory
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceRead) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 	hist, err := a.dhxCli.DHXMiningHistory(ctx, &pb.DHXMiningHistoryRequest{
@@ -237,7 +239,8 @@ func (a *Server) DHXGetLastMining(ctx context.Context, req *api.DHXGetLastMining
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
 	}
-	if req.OrgId != 0 && !cred.IsOrgUser {
+	// any member of the organization can see its mining
+	if req.OrgId != 0 && cred.Permissions() == 0 {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
@@ -269,7 +272,7 @@ func (a *Server) DHXBond(ctx context.Context, req *api.DHXBondRequest) (*api.DHX
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceWrite) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
@@ -290,7 +293,7 @@ func (a *Server) DHXUnbond(ctx context.Context, req *api.DHXUnbondRequest) (*api
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceWrite) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
@@ -311,7 +314,7 @@ func (a *Server) DHXBondInfo(ctx context.Context, req *api.DHXBondInfoRequest) (
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceRead) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
@@ -354,7 +357,7 @@ func (a *Server) DHXEstimateMining(ctx context.Context, req *api.DHXEstimateMini
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceRead) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
@@ -39,16 +39,25 @@ import (
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/staking"
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/user"
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
+	"github.com/mxc-foundation/lpwan-app-server/internal/audit"
 	authcus "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
+	"github.com/mxc-foundation/lpwan-app-server/internal/bonus"
+	"github.com/mxc-foundation/lpwan-app-server/internal/devicedata"
 	"github.com/mxc-foundation/lpwan-app-server/internal/email"
 	"github.com/mxc-foundation/lpwan-app-server/internal/grpcauth"
+	"github.com/mxc-foundation/lpwan-app-server/internal/invitation"
 	"github.com/mxc-foundation/lpwan-app-server/internal/jwt"
+	gwd "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+	metricsmod "github.com/mxc-foundation/lpwan-app-server/internal/modules/metrics"
 	"github.com/mxc-foundation/lpwan-app-server/internal/mxpcli"
 	"github.com/mxc-foundation/lpwan-app-server/internal/nscli"
 	"github.com/mxc-foundation/lpwan-app-server/internal/oidc"
 	"github.com/mxc-foundation/lpwan-app-server/internal/otp"
 	"github.com/mxc-foundation/lpwan-app-server/internal/pscli"
 	"github.com/mxc-foundation/lpwan-app-server/internal/pwhash"
+	"github.com/mxc-foundation/lpwan-app-server/internal/retention"
+	"github.com/mxc-foundation/lpwan-app-server/internal/role"
+	"github.com/mxc-foundation/lpwan-app-server/internal/statement"
 	"github.com/mxc-foundation/lpwan-app-server/internal/static"
 	"github.com/mxc-foundation/lpwan-app-server/internal/storage/pgstore"
 	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
@@ -67,6 +76,7 @@ type ExtAPIConfig struct {
 	ServerAddr                  string
 	BindNewGateway              string
 	BindOldGateway              string
+	FirmwareRollout             gwd.FirmwareRolloutConfig
 	Recaptcha                   user.RecaptchaConfig
 	Enable2FA                   bool
 	ServerRegion                string
@@ -75,7 +85,11 @@ type ExtAPIConfig struct {
 	ExternalAuth                user.ExternalAuthentication
 	ShopifyConfig               user.Shopify
 	OperatorLogo                string
+	Bonus                       *bonus.Service
 	Mailer                      *email.Mailer
+	Pricer                      Pricer
+	ClosePricer                 report.ClosePricer
+	DeviceDataStore             devicedata.Store
 	MXPCli                      *mxpcli.Client
 	PSCli                       *pscli.Client
 	NSCli                       *nscli.Client
@@ -89,13 +103,7 @@ func (srv *ExtAPIServer) Stop() {
 // Start configures the API endpoints.
 func Start(h *store.Handler, conf ExtAPIConfig) (*ExtAPIServer, error) {
 	var err error
-	// Bind external api port to listen to requests to all services
-	grpcOpts := helpers.GetgRPCServerOptions()
-	grpcServer := grpc.NewServer(grpcOpts...)
-
-	srv := &ExtAPIServer{
-		gs: grpcServer,
-	}
+	srv := &ExtAPIServer{}
 	if err := srv.SetupCusAPI(h, conf); err != nil {
 		return nil, err
 	}
@@ -108,7 +116,7 @@ func Start(h *store.Handler, conf ExtAPIConfig) (*ExtAPIServer, error) {
 	// switch between gRPC and "plain" http handler
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc") {
-			grpcServer.ServeHTTP(w, r)
+			srv.gs.ServeHTTP(w, r)
 		} else {
 			if clientHTTPHandler == nil {
 				w.WriteHeader(http.StatusNotImplemented)
@@ -178,6 +186,13 @@ func (srv *ExtAPIServer) SetupCusAPI(h *store.Handler, conf ExtAPIConfig) error
 	grpcAuth := grpcauth.New(pgs, jwtValidator, otpValidator)
 	authcus.SetupCred(pgs, jwtValidator, otpValidator)
 
+	// Bind external api port to listen to requests to all services, the
+	// administrative actions are recorded in the audit log
+	auditor := audit.NewAuditor(pgs, grpcAuth, conf.S.TrustedProxies)
+	grpcOpts := append(helpers.GetgRPCServerOptions(), grpc.ChainUnaryInterceptor(auditor.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(auditor.StreamServerInterceptor))
+	srv.gs = grpc.NewServer(grpcOpts...)
+
 	pb.RegisterFUOTADeploymentServiceServer(srv.gs, NewFUOTADeploymentAPI(h))
 	pb.RegisterDeviceQueueServiceServer(srv.gs, NewDeviceQueueAPI(h, conf.NSCli, grpcAuth))
 	pb.RegisterMulticastGroupServiceServer(srv.gs, NewMulticastGroupAPI(conf.ApplicationServerID, h, conf.NSCli))
@@ -190,6 +205,7 @@ func (srv *ExtAPIServer) SetupCusAPI(h *store.Handler, conf ExtAPIConfig) error
 		conf.MXPCli,
 		conf.PSCli,
 		conf.NSCli,
+		conf.DeviceDataStore,
 		grpcAuth,
 	))
 	// gateway
@@ -200,6 +216,7 @@ func (srv *ExtAPIServer) SetupCusAPI(h *store.Handler, conf ExtAPIConfig) error
 			ApplicationServerID: conf.ApplicationServerID,
 			ServerAddr:          conf.ServerAddr,
 			EnableSTC:           conf.EnableSTC,
+			FirmwareRollout:     conf.FirmwareRollout,
 		},
 		conf.PSCli,
 		conf.MXPCli,
@@ -233,7 +250,7 @@ func (srv *ExtAPIServer) SetupCusAPI(h *store.Handler, conf ExtAPIConfig) error
 		conf.ApplicationServerPublicHost,
 	))
 	// orgnization
-	api.RegisterOrganizationServiceServer(srv.gs, NewOrganizationAPI(h, conf.NSCli))
+	api.RegisterOrganizationServiceServer(srv.gs, NewOrganizationAPI(h, conf.NSCli, conf.MXPCli, conf.ApplicationServerID, conf.DeviceDataStore))
 	// user
 	pwhasher, err := pwhash.New(16, conf.PasswordHashIterations)
 	if err != nil {
@@ -262,6 +279,13 @@ func (srv *ExtAPIServer) SetupCusAPI(h *store.Handler, conf ExtAPIConfig) error
 	api.RegisterUserServiceServer(srv.gs, userSrv)
 	api.RegisterInternalServiceServer(srv.gs, userSrv)
 	api.RegisterExternalUserServiceServer(srv.gs, userSrv)
+	api.RegisterOrganizationInvitationServiceServer(srv.gs, invitation.NewServer(
+		pgs,
+		conf.Mailer,
+		userSrv,
+		jwtValidator,
+		grpcAuth,
+	))
 
 	api.RegisterServerInfoServiceServer(srv.gs, NewServerInfoAPI(conf.ServerRegion))
 	api.RegisterSettingsServiceServer(srv.gs, NewSettingsServerAPI())
@@ -269,6 +293,7 @@ func (srv *ExtAPIServer) SetupCusAPI(h *store.Handler, conf ExtAPIConfig) error
 
 	api.RegisterWalletServiceServer(srv.gs, NewWalletServerAPI(
 		h,
+		conf.Pricer,
 		grpcAuth,
 		conf.EnableSTC,
 	))
@@ -286,6 +311,20 @@ func (srv *ExtAPIServer) SetupCusAPI(h *store.Handler, conf ExtAPIConfig) error
 		pgs,
 	))
 
+	api.RegisterAirdropServiceServer(srv.gs, bonus.NewServer(conf.Bonus, pgs, grpcAuth))
+
+	api.RegisterStatementServiceServer(srv.gs, statement.NewServer(pgs, grpcAuth))
+
+	api.RegisterDeviceDataServiceServer(srv.gs, devicedata.NewServer(conf.DeviceDataStore, pgs, grpcAuth))
+
+	api.RegisterAuditServiceServer(srv.gs, audit.NewServer(pgs, grpcAuth))
+
+	api.RegisterEmailOutboxServiceServer(srv.gs, email.NewOutboxServer(pgs, grpcAuth))
+
+	api.RegisterOrganizationRoleServiceServer(srv.gs, role.NewServer(pgs, grpcAuth))
+
+	api.RegisterDataRetentionServiceServer(srv.gs, retention.NewServer(pgs, conf.DeviceDataStore, metricsmod.GatewayMetricsStore{}, grpcAuth))
+
 	api.RegisterShopifyIntegrationServer(srv.gs, user.NewShopifyServiceServer(
 		grpcAuth,
 		pgs,
@@ -297,6 +336,9 @@ func (srv *ExtAPIServer) SetupCusAPI(h *store.Handler, conf ExtAPIConfig) error
 
 	api.RegisterReportServiceServer(srv.gs, report.NewServer(
 		conf.MXPCli.GetFianceReportClient(),
+		conf.MXPCli.GetDHXServiceClient(),
+		conf.MXPCli.GetWalletServiceClient(),
+		conf.ClosePricer,
 		grpcAuth,
 		conf.ServerAddr,
 	))
@@ -468,6 +510,24 @@ func (srv *ExtAPIServer) getJSONGateway(ctx context.Context, conf ExtAPIConfig)
 	err = api.RegisterDHXServcieHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts)
 	log.Infof("register dhx service handler: %v", err)
 
+	err = api.RegisterAirdropServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts)
+	log.Infof("register airdrop service handler: %v", err)
+
+	err = api.RegisterStatementServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts)
+	log.Infof("register statement service handler: %v", err)
+	err = api.RegisterDeviceDataServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts)
+	log.Infof("register device data service handler: %v", err)
+	err = api.RegisterAuditServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts)
+	log.Infof("register audit service handler: %v", err)
+	err = api.RegisterOrganizationInvitationServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts)
+	log.Infof("register organization invitation service handler: %v", err)
+	err = api.RegisterEmailOutboxServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts)
+	log.Infof("register email outbox service handler: %v", err)
+	err = api.RegisterOrganizationRoleServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts)
+	log.Infof("register organization role service handler: %v", err)
+	err = api.RegisterDataRetentionServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts)
+	log.Infof("register data retention service handler: %v", err)
+
 	err = api.RegisterShopifyIntegrationHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts)
 	log.Infof("register shopify integration service handler: %v", err)
 
@@ -7,6 +7,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/brocaar/lorawan"
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -29,7 +31,9 @@ import (
 	"github.com/mxc-foundation/lpwan-app-server/internal/mxpcli"
 
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
+	"github.com/mxc-foundation/lpwan-app-server/internal/audit"
 	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	"github.com/mxc-foundation/lpwan-app-server/internal/modules/coverage"
 	metricsmod "github.com/mxc-foundation/lpwan-app-server/internal/modules/metrics"
 	"github.com/mxc-foundation/lpwan-app-server/internal/nscli"
 	"github.com/mxc-foundation/lpwan-app-server/internal/pscli"
@@ -60,11 +64,13 @@ type GwConfig struct {
 	ApplicationServerID uuid.UUID
 	ServerAddr          string
 	EnableSTC           bool
+	FirmwareRollout     FirmwareRolloutConfig
 }
 
 // NewGatewayAPI creates a new GatewayAPI.
 func NewGatewayAPI(st *pgstore.PgStore, auth auth.Authenticator, config GwConfig, pscli *pscli.Client,
 	mxpCli *mxpcli.Client, nsCli *nscli.Client, serverAddr, bindOldGateway, bindNewGateway string) *GatewayAPI {
+	config.FirmwareRollout = gw.RolloutConfigWithDefaults(config.FirmwareRollout)
 	return &GatewayAPI{
 		st:             st,
 		auth:           auth,
@@ -79,13 +85,13 @@ func NewGatewayAPI(st *pgstore.PgStore, auth auth.Authenticator, config GwConfig
 }
 
 func (a *GatewayAPI) RegisterReseller(ctx context.Context, req *api.RegisterResellerRequest) (*api.RegisterResellerResponse, error) {
-	// organization admin user or organization user should both be able to register organization as reseller for the gateway
+	// any user who can see the gateways of the organization should be able to register organization as reseller for the gateway
 	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(req.OrganizationId))
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
+	if !cred.Has(auth.PermGatewayRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -127,13 +133,27 @@ func (a *GatewayAPI) RegisterReseller(ctx context.Context, req *api.RegisterRese
 func (a *GatewayAPI) ManualTriggerUpdateFirmware(ctx context.Context, req *api.ManualTriggerUpdateFirmwareRequest) (*api.ManualTriggerUpdateFirmwareResponse, error) {
 	log.Info("ManualTriggerUpdateFirmware is called")
 
-	if err := gw.UpdateFirmware(ctx, a.st, a.bindOldGateway, a.bindNewGateway, a.serverAddr, a.pscli); err != nil {
+	if err := gw.UpdateFirmware(ctx, a.st, a.bindOldGateway, a.bindNewGateway, a.serverAddr, a.pscli, a.config.FirmwareRollout); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &api.ManualTriggerUpdateFirmwareResponse{}, nil
 }
 
+// gatewayState is the state of the gateway recorded in the audit log
+type gatewayState struct {
+	MAC            string `json:"mac"`
+	Name           string `json:"name"`
+	OrganizationID int64  `json:"organization_id"`
+	Model          string `json:"model"`
+	SerialNumber   string `json:"serial_number"`
+}
+
+// gatewayConfigState is the gateway config recorded in the audit log
+type gatewayConfigState struct {
+	Config string `json:"config"`
+}
+
 // BatchResetDefaultGatewatConfig reset gateways config to default config matching organization list
 func (a *GatewayAPI) BatchResetDefaultGatewatConfig(ctx context.Context, req *api.BatchResetDefaultGatewatConfigRequest) (*api.BatchResetDefaultGatewatConfigResponse, error) {
 	log.WithFields(log.Fields{
@@ -190,7 +210,7 @@ func (a *GatewayAPI) BatchResetDefaultGatewatConfig(ctx context.Context, req *ap
 			continue
 		}
 
-		err := a.resetDefaultGatewayConfigByOrganizationID(ctx, int64(v))
+		err := a.resetDefaultGatewayConfigByOrganizationID(ctx, int64(v), cred.Username)
 		if err != nil {
 			log.WithError(err).Errorf("failed to reset default gateway config for organization %d", v)
 			failedList = append(failedList, strconv.Itoa(v))
@@ -199,6 +219,10 @@ func (a *GatewayAPI) BatchResetDefaultGatewatConfig(ctx context.Context, req *ap
 
 		succeededList = append(succeededList, strconv.Itoa(v))
 	}
+	audit.Describe(ctx, 0, "organizations:"+req.OrganizationList, nil, map[string][]string{
+		"succeeded": succeededList,
+		"failed":    failedList,
+	})
 
 	return &api.BatchResetDefaultGatewatConfigResponse{
 		Status: fmt.Sprintf("following organization failed: %s \n following organization succeeded: %s",
@@ -206,7 +230,7 @@ func (a *GatewayAPI) BatchResetDefaultGatewatConfig(ctx context.Context, req *ap
 	}, status.Error(codes.OK, "")
 }
 
-func (a *GatewayAPI) resetDefaultGatewayConfigByOrganizationID(ctx context.Context, orgID int64) error {
+func (a *GatewayAPI) resetDefaultGatewayConfigByOrganizationID(ctx context.Context, orgID int64, author string) error {
 	filters := GatewayFilters{OrganizationID: orgID}
 	count, err := a.st.GetGatewayCount(ctx, filters)
 	if err != nil {
@@ -227,7 +251,7 @@ func (a *GatewayAPI) resetDefaultGatewayConfigByOrganizationID(ctx context.Conte
 		}
 
 		for _, v := range gwList {
-			err := a.getDefaultGatewayConfig(ctx, &Gateway{
+			gateway := Gateway{
 				MAC:             v.MAC,
 				CreatedAt:       v.CreatedAt,
 				UpdatedAt:       v.UpdatedAt,
@@ -239,12 +263,16 @@ func (a *GatewayAPI) resetDefaultGatewayConfigByOrganizationID(ctx context.Conte
 				NetworkServerID: v.NetworkServerID,
 				Model:           v.Model,
 				Config:          v.Config,
-			})
+			}
+			err := a.getDefaultGatewayConfig(ctx, &gateway)
 			if err != nil {
 				return err
 			}
 
-			err = a.st.UpdateGatewayConfigByGwID(ctx, v.Config, v.MAC)
+			err = a.st.Tx(ctx, func(ctx context.Context, ps *pgstore.PgStore) error {
+				_, err := gw.SetGatewayConfig(ctx, ps, gateway.MAC, gateway.Config, author, "batch reset to default config")
+				return err
+			})
 			if err != nil {
 				return err
 			}
@@ -274,24 +302,124 @@ func (a *GatewayAPI) ResetDefaultGatewatConfigByID(ctx context.Context, req *api
 		return nil, status.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
 	}
 
-	gw, err := a.st.GetGateway(ctx, mac, true)
+	gateway, err := a.st.GetGateway(ctx, mac, false)
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
+	before := gatewayConfigState{Config: gateway.Config}
 
-	err = a.getDefaultGatewayConfig(ctx, &gw)
+	err = a.getDefaultGatewayConfig(ctx, &gateway)
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
-	err = a.st.UpdateGatewayConfigByGwID(ctx, gw.Config, gw.MAC)
+	err = a.st.Tx(ctx, func(ctx context.Context, ps *pgstore.PgStore) error {
+		_, err := gw.SetGatewayConfig(ctx, ps, gateway.MAC, gateway.Config, cred.Username, "reset to default config")
+		return err
+	})
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
+	audit.Describe(ctx, gateway.OrganizationID, "gateway:"+gateway.MAC.String(), before,
+		gatewayConfigState{Config: gateway.Config})
 
 	return &api.ResetDefaultGatewatConfigByIDResponse{}, status.Error(codes.OK, "")
 }
 
+// defaultConfigFromRequest returns the default gateway config either given
+// in the request or generated from the channel plan. The config is validated
+// before it's stored.
+func (a *GatewayAPI) defaultConfigFromRequest(region, defaultConfig, channelPlan string,
+	overrides *api.GatewayConfigOverrides) (string, error) {
+	config := defaultConfig
+	if channelPlan != "" {
+		if defaultConfig != "" {
+			return "", status.Errorf(codes.InvalidArgument, "either default_config or channel_plan must be set, not both")
+		}
+		plan, err := gw.GetChannelPlan(channelPlan)
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if plan.Region != region {
+			return "", status.Errorf(codes.InvalidArgument, "channel plan %s is for the region %s, not %s",
+				plan.Name, plan.Region, region)
+		}
+		if config, err = plan.GenerateConfig(); err != nil {
+			return "", status.Errorf(codes.Internal, "couldn't generate config: %v", err)
+		}
+	}
+	config, err := gw.ApplyConfigOverrides(config, gatewayConfigOverridesFromPB(overrides))
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "couldn't apply overrides: %v", err)
+	}
+	config = strings.Replace(config, "{{ .ServerAddr }}", a.config.ServerAddr, -1)
+	if err := gw.ValidateConfig(config); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid gateway config: %v", err)
+	}
+	return config, nil
+}
+
+func gatewayConfigOverridesFromPB(ov *api.GatewayConfigOverrides) GatewayConfigOverrides {
+	var res GatewayConfigOverrides
+	if ov == nil {
+		return res
+	}
+	if ov.AntennaGain != nil {
+		res.AntennaGain = &ov.AntennaGain.Value
+	}
+	if ov.RssiOffset != nil {
+		res.RSSIOffset = &ov.RssiOffset.Value
+	}
+	if ov.Clksrc != nil {
+		res.ClkSrc = &ov.Clksrc.Value
+	}
+	if ov.LorawanPublic != nil {
+		res.LoRaWANPublic = &ov.LorawanPublic.Value
+	}
+	if ov.GpsTtyPath != nil {
+		res.GPSTTYPath = &ov.GpsTtyPath.Value
+	}
+	if ov.RefLatitude != nil {
+		res.RefLatitude = &ov.RefLatitude.Value
+	}
+	if ov.RefLongitude != nil {
+		res.RefLongitude = &ov.RefLongitude.Value
+	}
+	if ov.RefAltitude != nil {
+		res.RefAltitude = &ov.RefAltitude.Value
+	}
+	return res
+}
+
+func gatewayConfigOverridesToPB(ov GatewayConfigOverrides) *api.GatewayConfigOverrides {
+	res := &api.GatewayConfigOverrides{}
+	if ov.AntennaGain != nil {
+		res.AntennaGain = &wrappers.DoubleValue{Value: *ov.AntennaGain}
+	}
+	if ov.RSSIOffset != nil {
+		res.RssiOffset = &wrappers.DoubleValue{Value: *ov.RSSIOffset}
+	}
+	if ov.ClkSrc != nil {
+		res.Clksrc = &wrappers.UInt32Value{Value: *ov.ClkSrc}
+	}
+	if ov.LoRaWANPublic != nil {
+		res.LorawanPublic = &wrappers.BoolValue{Value: *ov.LoRaWANPublic}
+	}
+	if ov.GPSTTYPath != nil {
+		res.GpsTtyPath = &wrappers.StringValue{Value: *ov.GPSTTYPath}
+	}
+	if ov.RefLatitude != nil {
+		res.RefLatitude = &wrappers.DoubleValue{Value: *ov.RefLatitude}
+	}
+	if ov.RefLongitude != nil {
+		res.RefLongitude = &wrappers.DoubleValue{Value: *ov.RefLongitude}
+	}
+	if ov.RefAltitude != nil {
+		res.RefAltitude = &wrappers.Int32Value{Value: *ov.RefAltitude}
+	}
+	return res
+}
+
 // InsertNewDefaultGatewayConfig insert given new default gateway config
 func (a *GatewayAPI) InsertNewDefaultGatewayConfig(ctx context.Context, req *api.InsertNewDefaultGatewayConfigRequest) (*api.InsertNewDefaultGatewayConfigResponse, error) {
 	log.WithFields(log.Fields{
@@ -308,10 +436,14 @@ func (a *GatewayAPI) InsertNewDefaultGatewayConfig(ctx context.Context, req *api
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
+	config, err := a.defaultConfigFromRequest(req.Region, req.DefaultConfig, req.ChannelPlan, req.Overrides)
+	if err != nil {
+		return nil, err
+	}
 	defaultGatewayConfig := DefaultGatewayConfig{
 		Model:         req.Model,
 		Region:        req.Region,
-		DefaultConfig: strings.Replace(req.DefaultConfig, "{{ .ServerAddr }}", a.config.ServerAddr, -1),
+		DefaultConfig: config,
 	}
 
 	err = a.st.GetDefaultGatewayConfig(ctx, &defaultGatewayConfig)
@@ -326,6 +458,8 @@ func (a *GatewayAPI) InsertNewDefaultGatewayConfig(ctx context.Context, req *api
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
+	audit.Describe(ctx, 0, fmt.Sprintf("default-gateway-config:%s/%s", req.Model, req.Region), nil,
+		gatewayConfigState{Config: defaultGatewayConfig.DefaultConfig})
 
 	return &api.InsertNewDefaultGatewayConfigResponse{}, status.Error(codes.OK, "")
 }
@@ -346,6 +480,10 @@ func (a *GatewayAPI) UpdateDefaultGatewayConfig(ctx context.Context, req *api.Up
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
+	config, err := a.defaultConfigFromRequest(req.Region, req.DefaultConfig, req.ChannelPlan, req.Overrides)
+	if err != nil {
+		return nil, err
+	}
 	defaultGatewayConfig := DefaultGatewayConfig{
 		Model:  req.Model,
 		Region: req.Region,
@@ -356,11 +494,14 @@ func (a *GatewayAPI) UpdateDefaultGatewayConfig(ctx context.Context, req *api.Up
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
-	defaultGatewayConfig.DefaultConfig = strings.Replace(req.DefaultConfig, "{{ .ServerAddr }}", a.config.ServerAddr, -1)
+	before := gatewayConfigState{Config: defaultGatewayConfig.DefaultConfig}
+	defaultGatewayConfig.DefaultConfig = config
 	err = a.st.UpdateDefaultGatewayConfig(ctx, &defaultGatewayConfig)
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
+	audit.Describe(ctx, 0, fmt.Sprintf("default-gateway-config:%s/%s", req.Model, req.Region), before,
+		gatewayConfigState{Config: defaultGatewayConfig.DefaultConfig})
 
 	return &api.UpdateDefaultGatewayConfigResponse{}, status.Error(codes.OK, "")
 }
@@ -394,6 +535,31 @@ func (a *GatewayAPI) GetDefaultGatewayConfig(ctx context.Context, req *api.GetDe
 	return &api.GetDefaultGatewayConfigResponse{DefaultConfig: defaultGatewayConfig.DefaultConfig}, status.Error(codes.OK, "")
 }
 
+// ListGatewayChannelPlans lists the channel plans from which the default
+// gateway configs can be generated
+func (a *GatewayAPI) ListGatewayChannelPlans(ctx context.Context, req *api.ListGatewayChannelPlansRequest) (*api.ListGatewayChannelPlansResponse, error) {
+	if err := a.ensureGlobalAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	resp := &api.ListGatewayChannelPlansResponse{}
+	for _, p := range gw.ChannelPlans() {
+		plan := &api.GatewayChannelPlan{
+			Name:        p.Name,
+			Region:      p.Region,
+			Description: p.Description,
+		}
+		for _, ch := range p.MultiSF {
+			plan.UplinkFrequencies = append(plan.UplinkFrequencies, ch.Frequency)
+		}
+		if p.LoRaStd.Bandwidth != 0 {
+			plan.UplinkFrequencies = append(plan.UplinkFrequencies, p.LoRaStd.Frequency)
+		}
+		resp.Plans = append(resp.Plans, plan)
+	}
+	return resp, nil
+}
+
 // Create creates the given gateway.
 func (a *GatewayAPI) Create(ctx context.Context, req *api.CreateGatewayRequest) (*empty.Empty, error) {
 	var response empty.Empty
@@ -410,7 +576,7 @@ func (a *GatewayAPI) Create(ctx context.Context, req *api.CreateGatewayRequest)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
-	if !cred.IsGatewayAdmin {
+	if !cred.Has(auth.PermGatewayWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -438,19 +604,34 @@ func (a *GatewayAPI) Create(ctx context.Context, req *api.CreateGatewayRequest)
 	return &response, nil
 }
 
+// hasDefaultGatewayConfig returns true if the config of the gateway is
+// generated from the default gateway config of its model
+func hasDefaultGatewayConfig(gw *Gateway) bool {
+	return strings.HasPrefix(gw.Model, "MX19")
+}
+
 func (a *GatewayAPI) getDefaultGatewayConfig(ctx context.Context, gw *Gateway) error {
-	if !strings.HasPrefix(gw.Model, "MX19") {
+	if !hasDefaultGatewayConfig(gw) {
 		return nil
 	}
+	ov, err := a.st.GetGatewayConfigOverrides(ctx, gw.MAC)
+	if err != nil {
+		return errors.Wrap(err, "GetGatewayConfigOverrides")
+	}
+	return a.generateGatewayConfig(ctx, gw, ov)
+}
 
-	n, err := a.st.GetNetworkServer(ctx, gw.NetworkServerID)
+// generateGatewayConfig sets the config of the gateway to the default config
+// of its model and region with the overrides applied
+func (a *GatewayAPI) generateGatewayConfig(ctx context.Context, gateway *Gateway, ov GatewayConfigOverrides) error {
+	n, err := a.st.GetNetworkServer(ctx, gateway.NetworkServerID)
 	if err != nil {
-		log.WithError(err).Errorf("Failed to get network server %d", gw.NetworkServerID)
+		log.WithError(err).Errorf("Failed to get network server %d", gateway.NetworkServerID)
 		return errors.Wrapf(err, "GetDefaultGatewayConfig")
 	}
 
 	defaultGatewayConfig := DefaultGatewayConfig{
-		Model:  gw.Model,
+		Model:  gateway.Model,
 		Region: n.Region,
 	}
 
@@ -459,7 +640,11 @@ func (a *GatewayAPI) getDefaultGatewayConfig(ctx context.Context, gw *Gateway) e
 		return errors.Wrapf(err, "Failed to get default gateway config for model= %s, region= %s", defaultGatewayConfig.Model, defaultGatewayConfig.Region)
 	}
 
-	gw.Config = strings.Replace(defaultGatewayConfig.DefaultConfig, "{{ .GatewayID }}", gw.MAC.String(), -1)
+	config, err := gw.ApplyConfigOverrides(defaultGatewayConfig.DefaultConfig, ov)
+	if err != nil {
+		return errors.Wrap(err, "ApplyConfigOverrides")
+	}
+	gateway.Config = strings.Replace(config, "{{ .GatewayID }}", gateway.MAC.String(), -1)
 	return nil
 }
 
@@ -565,18 +750,16 @@ func (a *GatewayAPI) Get(ctx context.Context, req *api.GetGatewayRequest) (*api.
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
+	if !cred.Has(auth.PermGatewayRead) {
 		// user is neither global admin nor organization user, check whether user is reseller of the gateway
 		if a.config.EnableSTC && item.STCOrgID != nil && *item.STCOrgID != 0 {
-			stcOrgID := *item.STCOrgID
-			_, err = a.st.GetOrganizationUser(ctx, stcOrgID, cred.UserID)
+			stcCred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(*item.STCOrgID))
 			if err != nil {
-				if err == errHandler.ErrDoesNotExist {
-					return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-				}
-				return nil, status.Errorf(codes.Internal, err.Error())
+				return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+			}
+			if !stcCred.Has(auth.PermGatewayRead) {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 			}
-
 		} else {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
@@ -704,7 +887,7 @@ func (a *GatewayAPI) List(ctx context.Context, req *api.ListGatewayRequest) (*ap
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
+	if !cred.Has(auth.PermGatewayRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -740,7 +923,7 @@ func (a *GatewayAPI) ListNewGateways(ctx context.Context, req *api.ListGatewayRe
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
+	if !cred.Has(auth.PermGatewayRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -825,23 +1008,41 @@ func (a *GatewayAPI) ListLocations(ctx context.Context, req *api.ListGatewayLoca
 }
 
 func (a *GatewayAPI) ensureGatewayAdmin(ctx context.Context, mac lorawan.EUI64) error {
+	_, err := a.gatewayAdminCredentials(ctx, mac)
+	return err
+}
+
+// ensureGatewayReader checks that the user can see the gateway
+func (a *GatewayAPI) ensureGatewayReader(ctx context.Context, mac lorawan.EUI64) error {
+	_, err := a.gatewayCredentials(ctx, mac, auth.PermGatewayRead)
+	return err
+}
+
+// gatewayAdminCredentials returns credentials of the user if the user is
+// the admin of the gateway
+func (a *GatewayAPI) gatewayAdminCredentials(ctx context.Context, mac lorawan.EUI64) (*auth.Credentials, error) {
+	return a.gatewayCredentials(ctx, mac, auth.PermGatewayWrite)
+}
+
+// gatewayCredentials returns credentials of the user if the user has the
+// permission p in the organization of the gateway
+func (a *GatewayAPI) gatewayCredentials(ctx context.Context, mac lorawan.EUI64, p auth.Permission) (*auth.Credentials, error) {
 	gw, err := a.st.GetGateway(ctx, mac, false)
 	if err != nil {
 		if err == errHandler.ErrDoesNotExist {
-			return status.Errorf(codes.NotFound, "Gateway does not exist: %s", mac.String())
+			return nil, status.Errorf(codes.NotFound, "Gateway does not exist: %s", mac.String())
 		}
-		return status.Errorf(codes.Internal, "%v", err)
+		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
 
-	// check user permission, only gateway admin allowed
 	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(gw.OrganizationID))
 	if err != nil {
-		return status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
-	if !cred.IsGatewayAdmin {
-		return status.Errorf(codes.PermissionDenied, "permission denied")
+	if !cred.Has(p) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
-	return nil
+	return cred, nil
 }
 
 // Update updates the given gateway.
@@ -954,9 +1155,21 @@ func (a *GatewayAPI) Delete(ctx context.Context, req *api.DeleteGatewayRequest)
 		return nil, err
 	}
 
+	gateway, err := a.st.GetGateway(ctx, mac, false)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	if err := gw.DeleteGateway(ctx, mac, a.st, a.pscli, a.nsCli); err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	audit.Describe(ctx, gateway.OrganizationID, "gateway:"+mac.String(), gatewayState{
+		MAC:            gateway.MAC.String(),
+		Name:           gateway.Name,
+		OrganizationID: gateway.OrganizationID,
+		Model:          gateway.Model,
+		SerialNumber:   gateway.SerialNumber,
+	}, nil)
 
 	return &response, nil
 }
@@ -967,7 +1180,7 @@ func (a *GatewayAPI) GetStats(ctx context.Context, req *api.GetGatewayStatsReque
 	if err := gatewayID.UnmarshalText([]byte(req.GatewayId)); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
 	}
-	if err := a.ensureGatewayAdmin(ctx, gatewayID); err != nil {
+	if err := a.ensureGatewayReader(ctx, gatewayID); err != nil {
 		return nil, err
 	}
 
@@ -991,6 +1204,12 @@ func (a *GatewayAPI) GetStats(ctx context.Context, req *api.GetGatewayStatsReque
 			RxPacketsReceivedOk: int32(m.Metrics["rx_ok_count"]),
 			TxPacketsReceived:   int32(m.Metrics["tx_count"]),
 			TxPacketsEmitted:    int32(m.Metrics["tx_ok_count"]),
+			Heartbeats:          int32(m.Metrics[gw.MetricHeartbeats]),
+			CpuLoad:             gw.AverageMetric(m.Metrics, gw.MetricCPULoad),
+			MemoryUsage:         gw.AverageMetric(m.Metrics, gw.MetricMemoryUsage),
+			Temperature:         gw.AverageMetric(m.Metrics, gw.MetricTemperature),
+			Uptime:              int64(gw.AverageMetric(m.Metrics, gw.MetricUptime)),
+			Backhaul:            gw.PrevalentBackhaul(m.Metrics),
 		}
 
 		result[i].Timestamp = timestamppb.New(m.Time)
@@ -1001,6 +1220,84 @@ func (a *GatewayAPI) GetStats(ctx context.Context, req *api.GetGatewayStatsReque
 	}, nil
 }
 
+// GetCoverage returns the coverage cells of the gateway as GeoJSON.
+func (a *GatewayAPI) GetCoverage(ctx context.Context, req *api.GetGatewayCoverageRequest) (*api.GetGatewayCoverageResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	if err := a.ensureGatewayReader(ctx, mac); err != nil {
+		return nil, err
+	}
+
+	geoJSON, err := coverage.GetGatewayCoverageGeoJSON(ctx, a.st, mac)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &api.GetGatewayCoverageResponse{GeoJson: string(geoJSON)}, nil
+}
+
+// GetPingMatrix returns which gateways of the organization hear the pings of
+// each other, with the RSSI and SNR trends over time.
+func (a *GatewayAPI) GetPingMatrix(ctx context.Context, req *api.GetPingMatrixRequest) (*api.GetPingMatrixResponse, error) {
+	if req.OrganizationId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "organization_id must be set")
+	}
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(req.OrganizationId))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+	if !cred.Has(auth.PermGatewayRead) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	period := strings.ToLower(req.Interval)
+	if period != gw.PingPeriodHour && period != gw.PingPeriodDay {
+		return nil, status.Errorf(codes.InvalidArgument, "interval must be one of: %s, %s",
+			gw.PingPeriodHour, gw.PingPeriodDay)
+	}
+	if err := req.StartTimestamp.CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start_timestamp: %s", err)
+	}
+	if err := req.EndTimestamp.CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "end_timestamp: %s", err)
+	}
+
+	links, err := gw.GetPingMatrix(ctx, a.st, req.OrganizationId, req.StartTimestamp.AsTime(),
+		req.EndTimestamp.AsTime(), period)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := api.GetPingMatrixResponse{}
+	for _, l := range links {
+		link := &api.PingLink{
+			SenderGatewayId:   l.SenderMAC.String(),
+			ReceiverGatewayId: l.ReceiverMAC.String(),
+			Sent:              uint32(l.Sent),
+			Received:          uint32(l.Received),
+			AvgRssi:           l.AvgRSSI,
+			AvgSnr:            l.AvgSNR,
+			RssiChange:        l.RSSIChange,
+		}
+		for _, pt := range l.Trend {
+			link.Trend = append(link.Trend, &api.PingLinkPoint{
+				Timestamp: timestamppb.New(pt.Period),
+				Sent:      uint32(pt.Sent),
+				Received:  uint32(pt.Received),
+				AvgRssi:   pt.AvgRSSI,
+				AvgSnr:    pt.AvgSNR,
+				BestRssi:  int32(pt.BestRSSI),
+			})
+		}
+		resp.Links = append(resp.Links, link)
+	}
+
+	return &resp, nil
+}
+
 // GetLastPing returns the last emitted ping and gateways receiving this ping.
 func (a *GatewayAPI) GetLastPing(ctx context.Context, req *api.GetLastPingRequest) (*api.GetLastPingResponse, error) {
 	var mac lorawan.EUI64
@@ -1125,21 +1422,221 @@ func (a *GatewayAPI) UpdateGwConfig(ctx context.Context, req *api.UpdateGwConfig
 		return nil, status.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
 	}
 
-	if err := a.ensureGatewayAdmin(ctx, mac); err != nil {
+	cred, err := a.gatewayAdminCredentials(ctx, mac)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := a.st.UpdateGatewayConfigByGwID(ctx, req.Conf, mac); err != nil {
+	var cv *GatewayConfigVersion
+	if err := a.st.Tx(ctx, func(ctx context.Context, ps *pgstore.PgStore) error {
+		var err error
+		cv, err = gw.SetGatewayConfig(ctx, ps, mac, req.Conf, cred.Username, req.Reason)
+		return err
+	}); err != nil {
 		log.WithError(err).Error("Update conf to gw failed")
 		return &api.UpdateGwConfigResponse{Status: "Update config failed, please check your gateway connection."},
 			status.Errorf(codes.Internal, "cannot update gateway config: %s", err)
 	}
 
-	return &api.UpdateGwConfigResponse{
+	resp := &api.UpdateGwConfigResponse{
 		Status: "Update gateway config file successful",
+	}
+	if cv != nil {
+		resp.Version = int64(cv.Version)
+	}
+	return resp, nil
+}
+
+func gwConfigVersionToPB(cv GatewayConfigVersion) *api.GwConfigVersion {
+	res := &api.GwConfigVersion{
+		Version:    int64(cv.Version),
+		ConfigHash: cv.ConfigHash.String(),
+		Author:     cv.Author,
+		Reason:     cv.Reason,
+		CreatedAt:  timestamppb.New(cv.CreatedAt),
+	}
+	if cv.AcknowledgedAt != nil {
+		res.AcknowledgedAt = timestamppb.New(*cv.AcknowledgedAt)
+	}
+	return res
+}
+
+// ListGwConfigVersions lists the stored versions of the gateway config
+func (a *GatewayAPI) ListGwConfigVersions(ctx context.Context, req *api.ListGwConfigVersionsRequest) (*api.ListGwConfigVersionsResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	if err := a.ensureGatewayAdmin(ctx, mac); err != nil {
+		return nil, err
+	}
+
+	count, err := a.st.GetGatewayConfigVersionCount(ctx, mac)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	versions, err := a.st.GetGatewayConfigVersions(ctx, mac, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := &api.ListGwConfigVersionsResponse{TotalCount: int64(count)}
+	for _, cv := range versions {
+		resp.Result = append(resp.Result, gwConfigVersionToPB(cv))
+	}
+	return resp, nil
+}
+
+// GetGwConfigVersion returns the given version of the gateway config
+func (a *GatewayAPI) GetGwConfigVersion(ctx context.Context, req *api.GetGwConfigVersionRequest) (*api.GetGwConfigVersionResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	if err := a.ensureGatewayAdmin(ctx, mac); err != nil {
+		return nil, err
+	}
+
+	cv, err := a.st.GetGatewayConfigVersion(ctx, mac, int(req.Version))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	return &api.GetGwConfigVersionResponse{
+		Version: gwConfigVersionToPB(cv),
+		Conf:    cv.Config,
 	}, nil
 }
 
+// DiffGwConfigVersions returns unified diff between two versions of the
+// gateway config
+func (a *GatewayAPI) DiffGwConfigVersions(ctx context.Context, req *api.DiffGwConfigVersionsRequest) (*api.DiffGwConfigVersionsResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	if err := a.ensureGatewayAdmin(ctx, mac); err != nil {
+		return nil, err
+	}
+
+	from, err := a.st.GetGatewayConfigVersion(ctx, mac, int(req.FromVersion))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	to, err := a.st.GetGatewayConfigVersion(ctx, mac, int(req.ToVersion))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	diff, err := gw.DiffGatewayConfigVersions(from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "couldn't diff config versions: %v", err)
+	}
+
+	return &api.DiffGwConfigVersionsResponse{Diff: diff}, nil
+}
+
+// RestoreGwConfigVersion makes the given version the current gateway config
+func (a *GatewayAPI) RestoreGwConfigVersion(ctx context.Context, req *api.RestoreGwConfigVersionRequest) (*api.RestoreGwConfigVersionResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	cred, err := a.gatewayAdminCredentials(ctx, mac)
+	if err != nil {
+		return nil, err
+	}
+
+	var cv *GatewayConfigVersion
+	if err := a.st.Tx(ctx, func(ctx context.Context, ps *pgstore.PgStore) error {
+		var err error
+		cv, err = gw.RestoreGatewayConfigVersion(ctx, ps, mac, int(req.Version), cred.Username)
+		return err
+	}); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := &api.RestoreGwConfigVersionResponse{}
+	if cv != nil {
+		resp.Version = int64(cv.Version)
+	}
+	return resp, nil
+}
+
+// GetGwConfigOverrides returns the settings of the gateway applied on top of
+// the default gateway config
+func (a *GatewayAPI) GetGwConfigOverrides(ctx context.Context, req *api.GetGwConfigOverridesRequest) (*api.GetGwConfigOverridesResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	if err := a.ensureGatewayAdmin(ctx, mac); err != nil {
+		return nil, err
+	}
+
+	ov, err := a.st.GetGatewayConfigOverrides(ctx, mac)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	return &api.GetGwConfigOverridesResponse{Overrides: gatewayConfigOverridesToPB(ov)}, nil
+}
+
+// SetGwConfigOverrides replaces the settings of the gateway applied on top of
+// the default gateway config and regenerates the gateway config
+func (a *GatewayAPI) SetGwConfigOverrides(ctx context.Context, req *api.SetGwConfigOverridesRequest) (*api.SetGwConfigOverridesResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	cred, err := a.gatewayAdminCredentials(ctx, mac)
+	if err != nil {
+		return nil, err
+	}
+
+	gateway, err := a.st.GetGateway(ctx, mac, false)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	if !hasDefaultGatewayConfig(&gateway) {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"config of the gateway model %s is not generated from the default config", gateway.Model)
+	}
+	before := gatewayConfigState{Config: gateway.Config}
+	ov := gatewayConfigOverridesFromPB(req.Overrides)
+	if err := a.generateGatewayConfig(ctx, &gateway, ov); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "couldn't generate gateway config: %v", err)
+	}
+	if err := gw.ValidateConfig(gateway.Config); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid gateway config: %v", err)
+	}
+
+	var cv *GatewayConfigVersion
+	if err := a.st.Tx(ctx, func(ctx context.Context, ps *pgstore.PgStore) error {
+		if err := ps.SetGatewayConfigOverrides(ctx, mac, ov); err != nil {
+			return err
+		}
+		var err error
+		cv, err = gw.SetGatewayConfig(ctx, ps, mac, gateway.Config, cred.Username, "config overrides changed")
+		return err
+	}); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	audit.Describe(ctx, gateway.OrganizationID, "gateway:"+mac.String(), before,
+		gatewayConfigState{Config: gateway.Config})
+
+	resp := &api.SetGwConfigOverridesResponse{}
+	if cv != nil {
+		resp.Version = int64(cv.Version)
+	}
+	return resp, nil
+}
+
 func (a *GatewayAPI) getDefaultGatewayProfile(ctx context.Context, gateway *Gateway) error {
 	gpID, nID, err := a.st.GetDefaultGatewayProfile(ctx)
 	if err != nil {
@@ -1167,7 +1664,7 @@ func (a *GatewayAPI) Register(ctx context.Context, req *api.RegisterRequest) (*a
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	if !cred.IsGlobalAdmin && !cred.IsOrgAdmin && !cred.IsGatewayAdmin {
+	if !cred.Has(auth.PermGatewayWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -1312,7 +1809,7 @@ func (a *GatewayAPI) GetGatewayList(ctx context.Context, req *api.GetGatewayList
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
 	}
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
+	if !cred.Has(auth.PermGatewayRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -1360,7 +1857,7 @@ func (a *GatewayAPI) GetGatewayProfile(ctx context.Context, req *api.GetGSGatewa
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermOrganizationAdmin) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -1401,7 +1898,7 @@ func (a *GatewayAPI) GetGatewayHistory(ctx context.Context, req *api.GetGatewayH
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermOrganizationAdmin) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -1452,3 +1949,203 @@ func (a *GatewayAPI) SetGatewayMode(ctx context.Context, req *api.SetGatewayMode
 		Status: resp.Status,
 	}, status.Error(codes.OK, "")
 }
+
+func (a *GatewayAPI) ensureGlobalAdmin(ctx context.Context) error {
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions())
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.IsGlobalAdmin {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+func firmwareRolloutErrToRPCError(err error) error {
+	if errors.Cause(err) == gw.ErrFirmwareRolloutState {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return helpers.ErrToRPCError(err)
+}
+
+func firmwareRolloutToPB(rollout FirmwareRollout) *api.FirmwareRollout {
+	res := &api.FirmwareRollout{
+		Id:                   rollout.ID,
+		Model:                rollout.Model,
+		ResourceLink:         rollout.ResourceLink,
+		FirmwareHash:         rollout.FirmwareHash.String(),
+		PreviousResourceLink: rollout.PreviousResourceLink,
+		Percentage:           int32(rollout.Percentage),
+		MaxSilentPercentage:  int32(rollout.MaxSilentPercentage),
+		Status:               rollout.Status,
+		CreatedAt:            timestamppb.New(rollout.CreatedAt),
+		UpdatedAt:            timestamppb.New(rollout.UpdatedAt),
+	}
+	if rollout.PreviousResourceLink != "" {
+		res.PreviousFirmwareHash = rollout.PreviousFirmwareHash.String()
+	}
+	return res
+}
+
+// CreateFirmwareRollout starts staged rollout of new firmware for the gateways
+// of the given model
+func (a *GatewayAPI) CreateFirmwareRollout(ctx context.Context, req *api.CreateFirmwareRolloutRequest) (*api.CreateFirmwareRolloutResponse, error) {
+	if err := a.ensureGlobalAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.Model == "" || req.ResourceLink == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "model and resource_link are required")
+	}
+	var hash types.MD5SUM
+	if err := hash.UnmarshalText([]byte(req.FirmwareHash)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad firmware hash: %v", err)
+	}
+	if req.Percentage < 0 || req.Percentage > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "percentage must be between 0 and 100")
+	}
+	if req.MaxSilentPercentage < 0 || req.MaxSilentPercentage > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_silent_percentage must be between 0 and 100")
+	}
+	if req.SilentTimeoutSeconds < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "silent_timeout_seconds must not be negative")
+	}
+
+	var canaries []lorawan.EUI64
+	for _, id := range req.CanaryGatewayIds {
+		var mac lorawan.EUI64
+		if err := mac.UnmarshalText([]byte(id)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad gateway mac %s: %v", id, err)
+		}
+		canaries = append(canaries, mac)
+	}
+
+	rollout := FirmwareRollout{
+		Model:               req.Model,
+		ResourceLink:        req.ResourceLink,
+		FirmwareHash:        hash,
+		Percentage:          int(req.Percentage),
+		MaxSilentPercentage: int(req.MaxSilentPercentage),
+		SilentTimeout:       time.Duration(req.SilentTimeoutSeconds) * time.Second,
+	}
+	if rollout.MaxSilentPercentage == 0 {
+		rollout.MaxSilentPercentage = a.config.FirmwareRollout.MaxSilentPercentage
+	}
+	if rollout.SilentTimeout == 0 {
+		rollout.SilentTimeout = a.config.FirmwareRollout.SilentTimeout
+	}
+
+	if err := a.st.Tx(ctx, func(ctx context.Context, ps *pgstore.PgStore) error {
+		for _, mac := range canaries {
+			gateway, err := ps.GetGateway(ctx, mac, false)
+			if err != nil {
+				return errors.Wrapf(err, "canary gateway %s", mac)
+			}
+			if gateway.Model != req.Model {
+				return status.Errorf(codes.InvalidArgument, "canary gateway %s is of model %s", mac, gateway.Model)
+			}
+		}
+		return gw.CreateFirmwareRollout(ctx, ps, &rollout, canaries)
+	}); err != nil {
+		return nil, firmwareRolloutErrToRPCError(err)
+	}
+
+	return &api.CreateFirmwareRolloutResponse{Id: rollout.ID}, nil
+}
+
+// ListFirmwareRollouts lists firmware rollouts starting from the latest one
+func (a *GatewayAPI) ListFirmwareRollouts(ctx context.Context, req *api.ListFirmwareRolloutsRequest) (*api.ListFirmwareRolloutsResponse, error) {
+	if err := a.ensureGlobalAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	count, err := a.st.GetFirmwareRolloutCount(ctx, req.Model)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	rollouts, err := a.st.GetFirmwareRollouts(ctx, req.Model, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := &api.ListFirmwareRolloutsResponse{TotalCount: int64(count)}
+	for _, rollout := range rollouts {
+		resp.Result = append(resp.Result, firmwareRolloutToPB(rollout))
+	}
+	return resp, nil
+}
+
+// GetFirmwareRollout returns the firmware rollout and the state of the
+// gateways selected for it
+func (a *GatewayAPI) GetFirmwareRollout(ctx context.Context, req *api.GetFirmwareRolloutRequest) (*api.GetFirmwareRolloutResponse, error) {
+	if err := a.ensureGlobalAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	rollout, err := a.st.GetFirmwareRollout(ctx, req.Id, false)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	gateways, err := a.st.GetFirmwareRolloutGateways(ctx, req.Id)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := &api.GetFirmwareRolloutResponse{Rollout: firmwareRolloutToPB(rollout)}
+	for _, rg := range gateways {
+		row := &api.FirmwareRolloutGateway{
+			GatewayId: rg.GatewayMAC.String(),
+			Canary:    rg.Canary,
+			Status:    rg.Status,
+		}
+		if rg.OfferedAt != nil {
+			row.OfferedAt = timestamppb.New(*rg.OfferedAt)
+		}
+		if rg.ConfirmedAt != nil {
+			row.ConfirmedAt = timestamppb.New(*rg.ConfirmedAt)
+		}
+		if rg.LastHeartbeat != 0 {
+			row.LastHeartbeat = timestamppb.New(time.Unix(rg.LastHeartbeat, 0))
+		}
+		resp.Gateways = append(resp.Gateways, row)
+	}
+	return resp, nil
+}
+
+func (a *GatewayAPI) firmwareRolloutAction(ctx context.Context, id int64,
+	action func(context.Context, gw.RolloutStore, int64) error) (*api.FirmwareRolloutActionResponse, error) {
+	if err := a.ensureGlobalAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var rollout FirmwareRollout
+	if err := a.st.Tx(ctx, func(ctx context.Context, ps *pgstore.PgStore) error {
+		if err := action(ctx, ps, id); err != nil {
+			return err
+		}
+		var err error
+		rollout, err = ps.GetFirmwareRollout(ctx, id, false)
+		return err
+	}); err != nil {
+		return nil, firmwareRolloutErrToRPCError(err)
+	}
+
+	return &api.FirmwareRolloutActionResponse{Status: rollout.Status}, nil
+}
+
+// PauseFirmwareRollout stops offering the firmware of the rollout to further
+// gateways
+func (a *GatewayAPI) PauseFirmwareRollout(ctx context.Context, req *api.FirmwareRolloutActionRequest) (*api.FirmwareRolloutActionResponse, error) {
+	return a.firmwareRolloutAction(ctx, req.Id, gw.PauseFirmwareRollout)
+}
+
+// ResumeFirmwareRollout continues the paused firmware rollout
+func (a *GatewayAPI) ResumeFirmwareRollout(ctx context.Context, req *api.FirmwareRolloutActionRequest) (*api.FirmwareRolloutActionResponse, error) {
+	return a.firmwareRolloutAction(ctx, req.Id, gw.ResumeFirmwareRollout)
+}
+
+// RollbackFirmwareRollout aborts the rollout and restores the previous
+// firmware of the model
+func (a *GatewayAPI) RollbackFirmwareRollout(ctx context.Context, req *api.FirmwareRolloutActionRequest) (*api.FirmwareRolloutActionResponse, error) {
+	return a.firmwareRolloutAction(ctx, req.Id, gw.RollbackFirmwareRollout)
+}
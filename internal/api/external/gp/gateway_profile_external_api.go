@@ -9,6 +9,7 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/brocaar/chirpstack-api/go/v3/ns"
@@ -40,6 +41,7 @@ type GatewayProfile struct {
 	CreatedAt       time.Time         `db:"created_at"`
 	UpdatedAt       time.Time         `db:"updated_at"`
 	Name            string            `db:"name"`
+	PingInterval    time.Duration     `db:"ping_interval"`
 	GatewayProfile  ns.GatewayProfile `db:"-"`
 }
 
@@ -88,7 +90,7 @@ func NewGatewayProfileAPI(st Store, nsCli *nscli.Client, auth auth.Authenticator
 func GetGatewayProfile(ctx context.Context, id uuid.UUID, st Store, nsCli *nscli.Client) (*GatewayProfile, error) {
 	var gp GatewayProfile
 	var err error
-	// this function only returns gp.NetworkServerID, gp.CreatedAt, gp.UpdatedAt, gp.Name
+	// this function only returns gp.NetworkServerID, gp.CreatedAt, gp.UpdatedAt, gp.Name, gp.PingInterval
 	if gp, err = st.GetGatewayProfile(ctx, id); err != nil {
 		return nil, err
 	}
@@ -182,9 +184,15 @@ func (a *GatewayProfileAPI) Create(ctx context.Context, req *pb.CreateGatewayPro
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
+	pingInterval, err := pingIntervalFromPB(req.GatewayProfile.PingInterval)
+	if err != nil {
+		return nil, err
+	}
+
 	gp := GatewayProfile{
 		NetworkServerID: req.GatewayProfile.NetworkServerId,
 		Name:            req.GatewayProfile.Name,
+		PingInterval:    pingInterval,
 		GatewayProfile: ns.GatewayProfile{
 			Channels: req.GatewayProfile.Channels,
 		},
@@ -234,6 +242,9 @@ func (a *GatewayProfileAPI) Get(ctx context.Context, req *pb.GetGatewayProfileRe
 			Channels:        gp.GatewayProfile.Channels,
 		},
 	}
+	if gp.PingInterval > 0 {
+		out.GatewayProfile.PingInterval = durationpb.New(gp.PingInterval)
+	}
 
 	out.CreatedAt = timestamppb.New(gp.CreatedAt)
 	out.UpdatedAt = timestamppb.New(gp.UpdatedAt)
@@ -282,6 +293,10 @@ func (a *GatewayProfileAPI) Update(ctx context.Context, req *pb.UpdateGatewayPro
 			"cannot modify network server id for existing gateway profile")
 	}
 
+	gp.PingInterval, err = pingIntervalFromPB(req.GatewayProfile.PingInterval)
+	if err != nil {
+		return nil, err
+	}
 	gp.Name = req.GatewayProfile.Name
 	gp.GatewayProfile.Channels = req.GatewayProfile.Channels
 	gp.GatewayProfile.ExtraChannels = []*ns.GatewayProfileExtraChannel{}
@@ -304,6 +319,22 @@ func (a *GatewayProfileAPI) Update(ctx context.Context, req *pb.UpdateGatewayPro
 	return &empty.Empty{}, nil
 }
 
+// pingIntervalFromPB validates the ping interval of the gateway-profile, nil
+// means that the network-server gateway discovery interval should be used
+func pingIntervalFromPB(d *durationpb.Duration) (time.Duration, error) {
+	if d == nil {
+		return 0, nil
+	}
+	if err := d.CheckValid(); err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "ping_interval: %v", err)
+	}
+	interval := d.AsDuration()
+	if interval < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "ping_interval must not be negative")
+	}
+	return interval, nil
+}
+
 // DeleteGatewayProfile deletes gateway profile from local server then from network server
 func DeleteGatewayProfile(ctx context.Context, st Store, gpID uuid.UUID, nsCli *nscli.Client) error {
 	// get network server before gateway profile gets deleted
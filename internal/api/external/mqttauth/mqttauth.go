@@ -70,15 +70,16 @@ func (s *Server) GetJWT(ctx context.Context, req *pb.GetJWTRequest) (*pb.GetJWTR
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
 
-	if !cred.IsOrgUser {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
+	if !cred.Has(auth.PermDeviceRead) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
-	jwToken, err := s.jwtv.SignToken(jwt.Claims{
+	claims := jwt.Claims{
 		UserID:         cred.UserID,
 		Username:       cred.Username,
 		OrganizationID: req.OrganizationId,
-	}, req.TtlInSeconds, []string{"mosquitto-auth"})
+	}
+	jwToken, err := s.jwtv.SignToken(claims, req.TtlInSeconds, []string{"mosquitto-auth"})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "couldn't create a token: %v", err)
 	}
@@ -231,26 +232,31 @@ func (s *Server) CheckACL(ctx context.Context, req *pb.CheckACLRequest) (*pb.Che
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
 
-	if !cred.IsDeviceAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-	}
-
 	//acc = 1 is read, 2 is write, 3 is readwrite (not impelemented at the moment) , 4 is subscribe
 	switch req.Acc {
 	case 1:
 		// read message from given topic
+		if !cred.Has(auth.PermDeviceRead) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
 		if err := s.checkACLForRead(ctx, req.Topic, cred.OrgID); err != nil {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 		return &pb.CheckACLResponse{}, nil
 	case 4:
 		// subscribe topic
+		if !cred.Has(auth.PermDeviceRead) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
 		if err := s.checkACLForSubscribe(ctx, req.Topic, cred.OrgID); err != nil {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 		return &pb.CheckACLResponse{}, nil
 	case 2:
 		// publish message to given topic
+		if !cred.Has(auth.PermDeviceQueue) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
 		if err := s.checkACLForWrite(ctx, req.Topic, cred.OrgID); err != nil {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
@@ -314,7 +320,7 @@ func (s *Server) SubsribeDeviceEvents(ctx context.Context, req *pb.SubsribeDevic
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsDeviceAdmin {
+	if !cred.Has(auth.PermDeviceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -370,7 +376,7 @@ func (s *Server) SubsribeApplicationEvents(ctx context.Context, req *pb.Subsribe
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsDeviceAdmin {
+	if !cred.Has(auth.PermDeviceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -402,7 +408,7 @@ func (s *Server) SendCommandToDevice(ctx context.Context, req *pb.SendCommandToD
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsDeviceAdmin {
+	if !cred.Has(auth.PermDeviceQueue) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -0,0 +1,138 @@
+package mqttauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brocaar/lorawan"
+	"github.com/lestrrat-go/jwx/jwa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	"github.com/mxc-foundation/lpwan-app-server/internal/jwt"
+	app "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
+	device "github.com/mxc-foundation/lpwan-app-server/internal/modules/device/data"
+)
+
+// testAuth returns the same credentials for every request
+type testAuth struct {
+	cred *auth.Credentials
+}
+
+func (ta testAuth) GetCredentials(ctx context.Context, opts *auth.Options) (*auth.Credentials, error) {
+	return ta.cred, nil
+}
+
+// testStore has the application 1 of the organization 3 with a single device
+type testStore struct{}
+
+var testDevEUI = lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+func (testStore) GetDevice(ctx context.Context, devEUI lorawan.EUI64, forUpdate bool) (device.Device, error) {
+	return device.Device{DevEUI: devEUI, ApplicationID: 1}, nil
+}
+
+func (testStore) GetApplicationWithIDAndOrganizationID(ctx context.Context, id, orgID int64) (app.Application, error) {
+	return app.Application{ID: id, OrganizationID: orgID}, nil
+}
+
+func newTestServer(t *testing.T, cred *auth.Credentials) *Server {
+	eventTopic, err := CompileRegexpFromTopicTemplate("event", EventTopicTemplate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commandTopic, err := CompileRegexpFromTopicTemplate("command", CommandTopicTemplate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwtv := jwt.NewValidator(jwa.HS256, []byte("BlV5At5TU+LWXSEkiXZVvjuhWy6zBHJzA1jBvDbses4="), 86400)
+	return NewServer(testStore{}, testAuth{cred: cred}, jwtv, eventTopic, commandTopic, nil, nil)
+}
+
+func TestRolePermissions(t *testing.T) {
+	ctx := context.Background()
+	// the user with the custom role that allows only to see the finances
+	finance := &auth.Credentials{
+		UserID: 5, Username: "finance@example.com", OrgID: 3, IsOrgUser: true,
+		RoleID: 2, RolePermissions: auth.Permissions(0).With(auth.PermFinanceRead),
+	}
+	// the user with the custom role that can see the devices but can't send
+	// anything to them
+	reader := &auth.Credentials{
+		UserID: 6, Username: "reader@example.com", OrgID: 3, IsOrgUser: true,
+		RoleID: 4, RolePermissions: auth.Permissions(0).With(auth.PermDeviceRead),
+	}
+	// the user with the built-in organization user role
+	orgUser := &auth.Credentials{UserID: 7, Username: "user@example.com", OrgID: 3, IsOrgUser: true}
+
+	eventTopic := "application/1/device/" + testDevEUI.String() + "/event/up"
+	commandTopic := "application/1/device/" + testDevEUI.String() + "/command/down"
+
+	for _, tc := range []struct {
+		name string
+		cred *auth.Credentials
+		call func(s *Server) error
+		code codes.Code
+	}{
+		{
+			name: "role without the device permissions can't get the token",
+			cred: finance,
+			call: func(s *Server) error {
+				_, err := s.GetJWT(ctx, &pb.GetJWTRequest{OrganizationId: 3})
+				return err
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "role with the device read permission gets the token",
+			cred: reader,
+			call: func(s *Server) error {
+				_, err := s.GetJWT(ctx, &pb.GetJWTRequest{OrganizationId: 3})
+				return err
+			},
+		},
+		{
+			name: "role without the device permissions can't subscribe",
+			cred: finance,
+			call: func(s *Server) error {
+				_, err := s.CheckACL(ctx, &pb.CheckACLRequest{Acc: 4, Topic: eventTopic})
+				return err
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "role with the device read permission subscribes",
+			cred: reader,
+			call: func(s *Server) error {
+				_, err := s.CheckACL(ctx, &pb.CheckACLRequest{Acc: 4, Topic: eventTopic})
+				return err
+			},
+		},
+		{
+			name: "role without the device queue permission can't send commands",
+			cred: reader,
+			call: func(s *Server) error {
+				_, err := s.CheckACL(ctx, &pb.CheckACLRequest{Acc: 2, Topic: commandTopic})
+				return err
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "organization user sends commands",
+			cred: orgUser,
+			call: func(s *Server) error {
+				_, err := s.CheckACL(ctx, &pb.CheckACLRequest{Acc: 2, Topic: commandTopic})
+				return err
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.call(newTestServer(t, tc.cred))
+			if status.Code(err) != tc.code {
+				t.Errorf("expected code %v, got %v", tc.code, err)
+			}
+		})
+	}
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/brocaar/chirpstack-api/go/v3/ns"
 	"github.com/brocaar/lorawan"
 
-	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
 	auth "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
 	"github.com/mxc-foundation/lpwan-app-server/internal/backend/networkserver"
 	"github.com/mxc-foundation/lpwan-app-server/internal/modules/multicast-group"
@@ -229,7 +228,7 @@ func (a *MulticastGroupAPI) List(ctx context.Context, req *pb.ListMulticastGroup
 	if filters.OrganizationID != 0 {
 		idFilter = true
 
-		if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, auth.Read, req.OrganizationId); !valid || err != nil {
+		if valid, err := multicast.NewValidator().ValidateMulticastGroupsAccess(ctx, auth.List, req.OrganizationId); !valid || err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 		}
 	}
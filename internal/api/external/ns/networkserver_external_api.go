@@ -391,7 +391,8 @@ func (a *NetworkServerAPI) List(ctx context.Context, req *pb.ListNetworkServerRe
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
+	// the network servers are used by both the devices and the gateways
+	if !cred.Has(auth.PermDeviceRead) && !cred.Has(auth.PermGatewayRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
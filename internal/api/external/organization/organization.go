@@ -17,7 +17,8 @@ import (
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/dp"
 	nsapi "github.com/mxc-foundation/lpwan-app-server/internal/api/external/ns"
 	nsd "github.com/mxc-foundation/lpwan-app-server/internal/api/external/ns"
-	auth "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	authcus "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
 	appd "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
 	spmod "github.com/mxc-foundation/lpwan-app-server/internal/modules/service-profile"
 	spd "github.com/mxc-foundation/lpwan-app-server/internal/modules/service-profile/data"
@@ -53,6 +54,7 @@ type OrgUser struct {
 	IsAdmin        bool      `db:"is_admin"`
 	IsDeviceAdmin  bool      `db:"is_device_admin"`
 	IsGatewayAdmin bool      `db:"is_gateway_admin"`
+	RoleID         *int64    `db:"role_id"`
 	CreatedAt      time.Time `db:"created_at"`
 	UpdatedAt      time.Time `db:"updated_at"`
 }
@@ -89,46 +91,60 @@ func (f OrgFilters) SQL() string {
 
 // Validator defines struct type for vadidating user access to APIs provided by this package
 type Validator struct {
-	Credentials *auth.Credentials
+	Credentials *authcus.Credentials
 	st          Store
 }
 
 // Validate defines methods used on struct Validator
 type Validate interface {
-	ValidateOrganizationAccess(ctx context.Context, flag auth.Flag, organizationID int64) (bool, error)
-	ValidateOrganizationsAccess(ctx context.Context, flag auth.Flag) (bool, error)
-	ValidateOrganizationUsersAccess(ctx context.Context, flag auth.Flag, organizationID int64) (bool, error)
-	GetUser(ctx context.Context) (auth.User, error)
+	ValidateOrganizationAccess(ctx context.Context, flag authcus.Flag, organizationID int64) (bool, error)
+	ValidateOrganizationsAccess(ctx context.Context, flag authcus.Flag) (bool, error)
+	ValidateOrganizationUsersAccess(ctx context.Context, flag authcus.Flag, organizationID int64) (bool, error)
+	GetUser(ctx context.Context) (authcus.User, error)
 }
 
 // NewValidator returns new Validate instance for this package
 func NewValidator(st Store) Validate {
 	return &Validator{
-		Credentials: auth.NewCredentials(),
+		Credentials: authcus.NewCredentials(),
 		st:          st,
 	}
 }
 
 // GetUser returns user and corresponding attributes after authenticating the user
-func (v *Validator) GetUser(ctx context.Context) (auth.User, error) {
+func (v *Validator) GetUser(ctx context.Context) (authcus.User, error) {
 	return v.Credentials.GetUser(ctx)
 }
 
-// ValidateOrganizationAccess validates if the client has access to the
-// given organization.
-func (v *Validator) ValidateOrganizationAccess(ctx context.Context, flag auth.Flag, organizationID int64) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
+// isOrgUser returns true if the user or the API key has any permission in
+// the organization, such users may see the organization and its users
+func (v *Validator) isOrgUser(ctx context.Context, organizationID int64) (bool, error) {
+	cred, err := v.Credentials.OrgCredentials(ctx, organizationID)
+	if err != nil {
+		return false, err
+	}
+	return cred.Permissions() != 0, nil
+}
+
+// isGlobalAdmin returns true if the user or the API key is the global admin
+func (v *Validator) isGlobalAdmin(ctx context.Context) (bool, error) {
+	cred, err := v.Credentials.OrgCredentials(ctx, 0)
 	if err != nil {
-		return false, errors.Wrap(err, "ValidateOrganizationAccess")
+		return false, err
 	}
+	return cred.IsGlobalAdmin, nil
+}
 
+// ValidateOrganizationAccess validates if the client has access to the
+// given organization.
+func (v *Validator) ValidateOrganizationAccess(ctx context.Context, flag authcus.Flag, organizationID int64) (bool, error) {
 	switch flag {
-	case auth.Read:
-		return v.st.CheckReadOrganizationAccess(ctx, u.Email, u.ID, organizationID)
-	case auth.Update:
-		return v.st.CheckUpdateOrganizationAccess(ctx, u.Email, u.ID, organizationID)
-	case auth.Delete:
-		return v.st.CheckDeleteOrganizationAccess(ctx, u.Email, u.ID, organizationID)
+	case authcus.Read:
+		return v.isOrgUser(ctx, organizationID)
+	case authcus.Update:
+		return v.Credentials.HasPermission(ctx, organizationID, auth.PermOrganizationAdmin)
+	case authcus.Delete:
+		return v.isGlobalAdmin(ctx)
 	default:
 		panic("unsupported flag")
 	}
@@ -136,17 +152,16 @@ func (v *Validator) ValidateOrganizationAccess(ctx context.Context, flag auth.Fl
 
 // ValidateOrganizationsAccess validates if the client has access to the
 // organizations.
-func (v *Validator) ValidateOrganizationsAccess(ctx context.Context, flag auth.Flag) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
-	if err != nil {
-		return false, errors.Wrap(err, "ValidateOrganizationsAccess")
-	}
-
+func (v *Validator) ValidateOrganizationsAccess(ctx context.Context, flag authcus.Flag) (bool, error) {
 	switch flag {
-	case auth.Create:
-		return v.st.CheckCreateOrganizationAccess(ctx, u.Email, u.ID)
-	case auth.List:
-		return v.st.CheckListOrganizationAccess(ctx, u.Email, u.ID)
+	case authcus.Create:
+		return v.isGlobalAdmin(ctx)
+	case authcus.List:
+		// any user, the organizations are filtered on the user
+		if _, err := v.Credentials.GetUser(ctx); err != nil {
+			return false, errors.Wrap(err, "ValidateOrganizationsAccess")
+		}
+		return true, nil
 	default:
 		panic("unsupported flag")
 	}
@@ -154,17 +169,12 @@ func (v *Validator) ValidateOrganizationsAccess(ctx context.Context, flag auth.F
 
 // ValidateOrganizationUsersAccess validates if the client has access to
 // the organization users.
-func (v *Validator) ValidateOrganizationUsersAccess(ctx context.Context, flag auth.Flag, organizationID int64) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
-	if err != nil {
-		return false, errors.Wrap(err, "ValidateOrganizationUsersAccess")
-	}
-
+func (v *Validator) ValidateOrganizationUsersAccess(ctx context.Context, flag authcus.Flag, organizationID int64) (bool, error) {
 	switch flag {
-	case auth.Create:
-		return v.st.CheckCreateOrganizationUserAccess(ctx, u.Email, u.ID, organizationID)
-	case auth.List:
-		return v.st.CheckListOrganizationUserAccess(ctx, u.Email, u.ID, organizationID)
+	case authcus.Create:
+		return v.Credentials.HasPermission(ctx, organizationID, auth.PermOrganizationAdmin)
+	case authcus.List:
+		return v.isOrgUser(ctx, organizationID)
 	default:
 		panic("unsupported flag")
 	}
@@ -174,15 +184,6 @@ func (v *Validator) ValidateOrganizationUsersAccess(ctx context.Context, flag au
 type Store interface {
 	GetDefaultNetworkServer(ctx context.Context) (nsd.NetworkServer, error)
 	CreateApplication(ctx context.Context, item *appd.Application) error
-
-	CheckReadOrganizationAccess(ctx context.Context, username string, userID int64, organizationID int64) (bool, error)
-	CheckUpdateOrganizationAccess(ctx context.Context, username string, userID int64, organizationID int64) (bool, error)
-	CheckDeleteOrganizationAccess(ctx context.Context, username string, userID int64, organizationID int64) (bool, error)
-	CheckCreateOrganizationAccess(ctx context.Context, username string, userID int64) (bool, error)
-	CheckListOrganizationAccess(ctx context.Context, username string, userID int64) (bool, error)
-	CheckCreateOrganizationUserAccess(ctx context.Context, username string, userID int64, organizationID int64) (bool, error)
-	CheckListOrganizationUserAccess(ctx context.Context, username string, userID int64, organizationID int64) (bool, error)
-	CheckReadOrganizationUserAccess(ctx context.Context, username string, organizationID int64, userID, operatorUserID int64) (bool, error)
 }
 
 // DefaultApplicationName defines name of the default application for given org id
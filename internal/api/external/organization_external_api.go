@@ -1,18 +1,29 @@
 package external
 
 import (
+	"fmt"
+	"io"
+	"time"
+
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes/empty"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/mxc-foundation/lpwan-app-server/api/extapi"
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
-	auth "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
+	"github.com/mxc-foundation/lpwan-app-server/internal/audit"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	authcus "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
+	"github.com/mxc-foundation/lpwan-app-server/internal/devicedata"
+	"github.com/mxc-foundation/lpwan-app-server/internal/mxpcli"
 	"github.com/mxc-foundation/lpwan-app-server/internal/nscli"
+	"github.com/mxc-foundation/lpwan-app-server/internal/orgtransfer"
 	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
 )
 
@@ -20,13 +31,20 @@ import (
 type OrganizationAPI struct {
 	st    *store.Handler
 	nsCli *nscli.Client
+	// deviceData is the store of the device events, nil if the postgresql
+	// integration is not enabled
+	deviceData devicedata.Store
+	transfer   *orgtransfer.Transfer
 }
 
 // NewOrganizationAPI creates a new OrganizationAPI.
-func NewOrganizationAPI(h *store.Handler, nsCli *nscli.Client) *OrganizationAPI {
+func NewOrganizationAPI(h *store.Handler, nsCli *nscli.Client, mxpCli *mxpcli.Client,
+	applicationServerID uuid.UUID, deviceData devicedata.Store) *OrganizationAPI {
 	return &OrganizationAPI{
-		st:    h,
-		nsCli: nsCli,
+		st:         h,
+		nsCli:      nsCli,
+		deviceData: deviceData,
+		transfer:   orgtransfer.New(h, nsCli, mxpCli, applicationServerID),
 	}
 }
 
@@ -36,7 +54,7 @@ func (a *OrganizationAPI) Create(ctx context.Context, req *pb.CreateOrganization
 		return nil, status.Errorf(codes.InvalidArgument, "organization must not be nil")
 	}
 
-	if valid, err := organization.NewValidator(a.st).ValidateOrganizationsAccess(ctx, auth.Create); !valid || err != nil {
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationsAccess(ctx, authcus.Create); !valid || err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
@@ -52,6 +70,7 @@ func (a *OrganizationAPI) Create(ctx context.Context, req *pb.CreateOrganization
 	if err != nil {
 		return nil, err
 	}
+	audit.Describe(ctx, org.ID, fmt.Sprintf("organization:%d", org.ID), nil, org)
 	// set all default settings for new organization, this step should not interrupt creating organization
 	organization.ActivateOrganization(ctx, a.st, a.st, a.st, org.ID, a.nsCli)
 	return &pb.CreateOrganizationResponse{
@@ -61,7 +80,7 @@ func (a *OrganizationAPI) Create(ctx context.Context, req *pb.CreateOrganization
 
 // Get returns the organization matching the given ID.
 func (a *OrganizationAPI) Get(ctx context.Context, req *pb.GetOrganizationRequest) (*pb.GetOrganizationResponse, error) {
-	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, auth.Read, req.Id); !valid || err != nil {
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, authcus.Read, req.Id); !valid || err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
@@ -89,7 +108,7 @@ func (a *OrganizationAPI) Get(ctx context.Context, req *pb.GetOrganizationReques
 
 // List lists the organizations to which the user has access.
 func (a *OrganizationAPI) List(ctx context.Context, req *pb.ListOrganizationRequest) (*pb.ListOrganizationResponse, error) {
-	if valid, err := organization.NewValidator(a.st).ValidateOrganizationsAccess(ctx, auth.List); !valid || err != nil {
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationsAccess(ctx, authcus.List); !valid || err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
@@ -145,7 +164,7 @@ func (a *OrganizationAPI) Update(ctx context.Context, req *pb.UpdateOrganization
 		return nil, status.Errorf(codes.InvalidArgument, "organization must not be nil")
 	}
 
-	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, auth.Update, req.Organization.Id); !valid || err != nil {
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, authcus.Update, req.Organization.Id); !valid || err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
@@ -159,6 +178,7 @@ func (a *OrganizationAPI) Update(ctx context.Context, req *pb.UpdateOrganization
 		return nil, err
 	}
 
+	before := org
 	org.Name = req.Organization.Name
 	org.DisplayName = req.Organization.DisplayName
 
@@ -173,6 +193,7 @@ func (a *OrganizationAPI) Update(ctx context.Context, req *pb.UpdateOrganization
 	if err != nil {
 		return nil, err
 	}
+	audit.Describe(ctx, org.ID, fmt.Sprintf("organization:%d", org.ID), before, org)
 
 	return &empty.Empty{}, nil
 }
@@ -180,11 +201,25 @@ func (a *OrganizationAPI) Update(ctx context.Context, req *pb.UpdateOrganization
 // Delete deletes the organization matching the given ID.
 // Note: this should never happen, when there are still items in the organization, the organization should not be deleted
 func (a *OrganizationAPI) Delete(ctx context.Context, req *pb.DeleteOrganizationRequest) (*empty.Empty, error) {
-	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, auth.Delete, req.Id); !valid || err != nil {
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, authcus.Delete, req.Id); !valid || err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	org, err := a.st.GetOrganization(ctx, req.Id, false)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	appIDs, err := a.st.GetOrganizationApplicationIDs(ctx, req.Id)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
 	if err := a.st.Tx(ctx, func(ctx context.Context, handler *store.Handler) error {
+		// the deployments would be left behind without the multicast groups
+		if _, err := handler.DeleteFUOTADeploymentsBefore(ctx, req.Id, time.Time{}); err != nil {
+			return status.Errorf(codes.Unknown, "%v", err)
+		}
+
 		if err := handler.DeleteAllGatewaysForOrganizationID(ctx, req.Id); err != nil {
 			return status.Errorf(codes.Unknown, "%v", err)
 		}
@@ -212,13 +247,37 @@ func (a *OrganizationAPI) Delete(ctx context.Context, req *pb.DeleteOrganization
 	}); err != nil {
 		return nil, status.Errorf(codes.Unknown, err.Error())
 	}
+	if a.deviceData != nil {
+		if _, err := devicedata.DeleteAllTypes(ctx, a.deviceData, devicedata.DeleteFilter{
+			ApplicationIDs: appIDs,
+		}); err != nil {
+			log.WithError(err).WithField("organization_id", req.Id).Error("couldn't delete device events")
+		}
+	}
+	audit.Describe(ctx, org.ID, fmt.Sprintf("organization:%d", org.ID), org, nil)
 
 	return &empty.Empty{}, nil
 }
 
+// orgUserPermissions are the permissions of the user in the organization
+// recorded in the audit log
+type orgUserPermissions struct {
+	IsAdmin        bool `json:"is_admin"`
+	IsDeviceAdmin  bool `json:"is_device_admin"`
+	IsGatewayAdmin bool `json:"is_gateway_admin"`
+}
+
+func newOrgUserPermissions(isAdmin, isDeviceAdmin, isGatewayAdmin bool) orgUserPermissions {
+	return orgUserPermissions{
+		IsAdmin:        isAdmin,
+		IsDeviceAdmin:  isDeviceAdmin,
+		IsGatewayAdmin: isGatewayAdmin,
+	}
+}
+
 // ListUsers lists the users assigned to the given organization.
 func (a *OrganizationAPI) ListUsers(ctx context.Context, req *pb.ListOrganizationUsersRequest) (*pb.ListOrganizationUsersResponse, error) {
-	if valid, err := organization.NewValidator(a.st).ValidateOrganizationUsersAccess(ctx, auth.List, req.OrganizationId); !valid || err != nil {
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationUsersAccess(ctx, authcus.List, req.OrganizationId); !valid || err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
@@ -244,6 +303,9 @@ func (a *OrganizationAPI) ListUsers(ctx context.Context, req *pb.ListOrganizatio
 			IsDeviceAdmin:  u.IsDeviceAdmin,
 			IsGatewayAdmin: u.IsGatewayAdmin,
 		}
+		if u.RoleID != nil {
+			row.RoleId = *u.RoleID
+		}
 
 		row.CreatedAt = timestamppb.New(u.CreatedAt)
 		row.UpdatedAt = timestamppb.New(u.UpdatedAt)
@@ -260,7 +322,7 @@ func (a *OrganizationAPI) AddUser(ctx context.Context, req *pb.AddOrganizationUs
 		return nil, status.Errorf(codes.InvalidArgument, "organization_user must not be nil")
 	}
 
-	if valid, err := organization.NewValidator(a.st).ValidateOrganizationUsersAccess(ctx, auth.Create, req.OrganizationUser.OrganizationId); !valid || err != nil {
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationUsersAccess(ctx, authcus.Create, req.OrganizationUser.OrganizationId); !valid || err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
@@ -274,6 +336,9 @@ func (a *OrganizationAPI) AddUser(ctx context.Context, req *pb.AddOrganizationUs
 	if err != nil {
 		return nil, err
 	}
+	audit.Describe(ctx, req.OrganizationUser.OrganizationId, fmt.Sprintf("user:%d", req.OrganizationUser.UserId),
+		nil, newOrgUserPermissions(req.OrganizationUser.IsAdmin, req.OrganizationUser.IsDeviceAdmin,
+			req.OrganizationUser.IsGatewayAdmin))
 
 	return &empty.Empty{}, nil
 }
@@ -284,11 +349,16 @@ func (a *OrganizationAPI) UpdateUser(ctx context.Context, req *pb.UpdateOrganiza
 		return nil, status.Errorf(codes.InvalidArgument, "organization_user must not be nil")
 	}
 
-	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, auth.Update, req.OrganizationUser.OrganizationId); !valid || err != nil {
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, authcus.Update, req.OrganizationUser.OrganizationId); !valid || err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	err := a.st.UpdateOrganizationUser(ctx,
+	u, err := a.st.GetOrganizationUser(ctx, req.OrganizationUser.OrganizationId, req.OrganizationUser.UserId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	err = a.st.UpdateOrganizationUser(ctx,
 		req.OrganizationUser.OrganizationId,
 		req.OrganizationUser.UserId,
 		req.OrganizationUser.IsAdmin,
@@ -298,27 +368,38 @@ func (a *OrganizationAPI) UpdateUser(ctx context.Context, req *pb.UpdateOrganiza
 	if err != nil {
 		return nil, err
 	}
+	audit.Describe(ctx, req.OrganizationUser.OrganizationId, fmt.Sprintf("user:%d", req.OrganizationUser.UserId),
+		newOrgUserPermissions(u.IsAdmin, u.IsDeviceAdmin, u.IsGatewayAdmin),
+		newOrgUserPermissions(req.OrganizationUser.IsAdmin, req.OrganizationUser.IsDeviceAdmin,
+			req.OrganizationUser.IsGatewayAdmin))
 
 	return &empty.Empty{}, nil
 }
 
 // DeleteUser deletes the given user from the organization.
 func (a *OrganizationAPI) DeleteUser(ctx context.Context, req *pb.DeleteOrganizationUserRequest) (*empty.Empty, error) {
-	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, auth.Delete, req.OrganizationId); !valid || err != nil {
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, authcus.Delete, req.OrganizationId); !valid || err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	err := a.st.DeleteOrganizationUser(ctx, req.OrganizationId, req.UserId)
+	u, err := a.st.GetOrganizationUser(ctx, req.OrganizationId, req.UserId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	err = a.st.DeleteOrganizationUser(ctx, req.OrganizationId, req.UserId)
 	if err != nil {
 		return nil, err
 	}
+	audit.Describe(ctx, req.OrganizationId, fmt.Sprintf("user:%d", req.UserId),
+		newOrgUserPermissions(u.IsAdmin, u.IsDeviceAdmin, u.IsGatewayAdmin), nil)
 
 	return &empty.Empty{}, nil
 }
 
 // GetUser returns the user details for the given user ID.
 func (a *OrganizationAPI) GetUser(ctx context.Context, req *pb.GetOrganizationUserRequest) (*pb.GetOrganizationUserResponse, error) {
-	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, auth.Read, req.OrganizationId); !valid || err != nil {
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, authcus.Read, req.OrganizationId); !valid || err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
@@ -337,9 +418,126 @@ func (a *OrganizationAPI) GetUser(ctx context.Context, req *pb.GetOrganizationUs
 			IsGatewayAdmin: u.IsGatewayAdmin,
 		},
 	}
+	if u.RoleID != nil {
+		resp.OrganizationUser.RoleId = *u.RoleID
+	}
 
 	resp.CreatedAt = timestamppb.New(u.CreatedAt)
 	resp.UpdatedAt = timestamppb.New(u.UpdatedAt)
 
 	return &resp, nil
 }
+
+// organizationArchiveChunkSize is the size of the chunks the organization
+// archive is streamed in
+const organizationArchiveChunkSize = 65535
+
+// maxOrganizationArchiveSize limits the size of the imported archive that is
+// kept in memory
+const maxOrganizationArchiveSize = 1 << 30
+
+// Export streams the organization with all its objects as the archive
+// encrypted with the passphrase. The archive contains the device keys, so
+// only the organization admins who may read the device keys may export it.
+func (a *OrganizationAPI) Export(req *pb.ExportOrganizationRequest, srv pb.OrganizationService_ExportServer) error {
+	ctx := srv.Context()
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationAccess(ctx, authcus.Update, req.OrganizationId); !valid || err != nil {
+		return status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+	// the archive contains the keys of the devices
+	if ok, err := authcus.NewCredentials().HasPermission(ctx, req.OrganizationId, auth.PermDeviceKeysRead); err != nil || !ok {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if err := orgtransfer.CheckPassphrase(req.Passphrase); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	archive, err := a.transfer.Export(ctx, req.OrganizationId)
+	if err != nil {
+		return helpers.ErrToRPCError(err)
+	}
+	data, err := orgtransfer.Encrypt(archive, req.Passphrase)
+	if err != nil {
+		return status.Errorf(codes.Internal, "couldn't encrypt archive: %v", err)
+	}
+	for len(data) > 0 {
+		n := organizationArchiveChunkSize
+		if n > len(data) {
+			n = len(data)
+		}
+		if err := srv.Send(&pb.ExportOrganizationResponse{Data: data[:n]}); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	audit.Describe(ctx, req.OrganizationId, fmt.Sprintf("organization:%d", req.OrganizationId), nil, map[string]interface{}{
+		"devices":  len(archive.Devices),
+		"gateways": len(archive.Gateways),
+	})
+	log.WithFields(log.Fields{
+		"organization_id": req.OrganizationId,
+		"devices":         len(archive.Devices),
+		"gateways":        len(archive.Gateways),
+	}).Info("organization exported")
+	return nil
+}
+
+// Import creates the organization from the archive returned by Export of
+// this or another supernode. The archive is received in chunks, the options
+// are taken from the first message.
+func (a *OrganizationAPI) Import(srv pb.OrganizationService_ImportServer) error {
+	ctx := srv.Context()
+	if valid, err := organization.NewValidator(a.st).ValidateOrganizationsAccess(ctx, authcus.Create); !valid || err != nil {
+		return status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+	var req *pb.ImportOrganizationRequest
+	var data []byte
+	for {
+		chunk, err := srv.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req == nil {
+			req = chunk
+		}
+		if len(data)+len(chunk.Archive) > maxOrganizationArchiveSize {
+			return status.Errorf(codes.InvalidArgument, "archive is larger than %d bytes", maxOrganizationArchiveSize)
+		}
+		data = append(data, chunk.Archive...)
+	}
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "archive is required")
+	}
+	archive, err := orgtransfer.Decrypt(data, req.Passphrase)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	res, err := a.transfer.Import(ctx, archive, orgtransfer.ImportOptions{
+		NetworkServerID: req.NetworkServerId,
+		Name:            req.Name,
+		DryRun:          req.DryRun,
+	})
+	if err != nil {
+		return helpers.ErrToRPCError(err)
+	}
+	resp := &pb.ImportOrganizationResponse{OrganizationId: res.OrganizationID}
+	for _, c := range res.Conflicts {
+		resp.Conflicts = append(resp.Conflicts, &pb.ImportConflict{
+			Kind:   c.Kind,
+			Id:     c.ID,
+			Reason: c.Reason,
+		})
+	}
+	if !req.DryRun {
+		audit.Describe(ctx, res.OrganizationID, fmt.Sprintf("organization:%d", res.OrganizationID), nil, map[string]interface{}{
+			"exported_id": archive.Organization.ID,
+			"exported_at": archive.ExportedAt,
+			"conflicts":   len(res.Conflicts),
+		})
+	}
+	return srv.SendAndClose(resp)
+}
@@ -6,7 +6,7 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
-	"strings"
+	"time"
 
 	"github.com/jung-kurt/gofpdf"
 	"github.com/sirupsen/logrus"
@@ -18,17 +18,33 @@ import (
 	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
 )
 
+// ClosePricer provides the daily close prices of crypto currencies
+type ClosePricer interface {
+	// GetClosePrice returns the close price of the crypto currency in the
+	// units of the fiat currency on the given UTC day
+	GetClosePrice(ctx context.Context, crypto, fiat string, day time.Time) (float64, error)
+}
+
 // Server defines the download service Server API structure
 type Server struct {
 	financeReportCli pb.FinanceReportServiceClient
+	dhxCli           pb.DHXServiceClient
+	walletCli        pb.WalletServiceClient
+	pricer           ClosePricer
 	auth             auth.Authenticator
 	server           string
 }
 
-// NewServer creates a new download service server
-func NewServer(mxpCli pb.FinanceReportServiceClient, auth auth.Authenticator, server string) *Server {
+// NewServer creates a new download service server. If pricer is not nil the
+// fiat value of the mined MXC is calculated using the close prices it
+// provides, otherwise the values calculated by mxprotocol server are used
+func NewServer(mxpCli pb.FinanceReportServiceClient, dhxCli pb.DHXServiceClient, walletCli pb.WalletServiceClient,
+	pricer ClosePricer, auth auth.Authenticator, server string) *Server {
 	return &Server{
 		financeReportCli: mxpCli,
+		dhxCli:           dhxCli,
+		walletCli:        walletCli,
+		pricer:           pricer,
 		auth:             auth,
 		server:           server,
 	}
@@ -60,14 +76,24 @@ func (s *Server) MiningReportPDF(req *api.MiningReportRequest, srv api.ReportSer
 	if err != nil {
 		return status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceRead) {
 		return status.Errorf(codes.PermissionDenied, "permission denied")
 	}
-	decimals := req.Decimals
-	if decimals == 0 {
-		decimals = 4
+	sections, err := s.getReportSections(srv.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	buff, err := s.renderPDF(sections, cred.Username)
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", err)
 	}
 
+	return sendStream(buff, srv.Send)
+}
+
+// renderPDF renders the sections of the mining report into pdf document
+func (s *Server) renderPDF(sections []reportSection, username string) (bytes.Buffer, error) {
 	pdf := gofpdf.New(gofpdf.OrientationPortrait, gofpdf.UnitPoint, gofpdf.PageSizeA4, "")
 	// configure format
 	format := defaultPDFConfiguration(pdf)
@@ -75,70 +101,32 @@ func (s *Server) MiningReportPDF(req *api.MiningReportRequest, srv api.ReportSer
 	// new page
 	addNewPageWithCustomization(pdf, format)
 	// add banner for first page
-	addReportBanner(pdf, format, s.server, cred.Username)
-
-	for _, item := range req.Currency {
-		switch item {
-		case "ETH_MXC":
-			tableContent := [][]string{{}}
-			tableContent[0] = []string{
-				"\nDate",
-				"\nMXC Mined",
-				"\nMXC Close Price",
-				fmt.Sprintf("\n%s Mined", strings.ToUpper(req.FiatCurrency)),
-				"\nOnline Seconds",
-			}
-			// configure cell width
-			totalUnits := (format.tableWidth - float64(len(tableContent[0])-1)*format.charSpacing) / format.contentFontSize
-			dateUnits := 5.5
-			mxcMinedUnits := 14.0
-			onlineSecondsUnits := 10.0
-			mxcClosePriceUnits := (totalUnits - dateUnits - mxcMinedUnits - onlineSecondsUnits) / 2
-			fiatMinedUnits := mxcClosePriceUnits
-			cellWidth := []float64{
-				dateUnits * format.contentFontSize,
-				mxcMinedUnits * format.contentFontSize,
-				mxcClosePriceUnits * format.contentFontSize,
-				fiatMinedUnits * format.contentFontSize,
-				onlineSecondsUnits * format.contentFontSize,
-			}
-			// get table content
-			res, err := s.financeReportCli.GetMXCMiningReportByDate(srv.Context(), &pb.GetMXCMiningReportByDateRequest{
-				OrganizationId: req.OrganizationId,
-				Start:          req.Start,
-				End:            req.End,
-				FiatCurrency:   req.FiatCurrency,
-				Decimals:       decimals,
-			})
-			if err != nil {
-				return status.Errorf(codes.Internal, "failed to get MXC mining report : %v", err)
-			}
-			for _, v := range res.MiningRecordList {
-				y, m, d := v.DateTime.AsTime().Date()
-				dateStr := fmt.Sprintf("%04d-%02d-%02d", y, m, d)
-				tableContent = append(tableContent, []string{
-					dateStr,
-					v.MXCMined,
-					v.MXCSettlementPrice,
-					v.FiatCurrencyMined,
-					fmt.Sprintf("%d", v.OnlineSeconds),
-				})
-			}
-			// add table content
-			if err = addReportTable(pdf, format, tableContent, cellWidth); err != nil {
-				return status.Errorf(codes.Internal, "%v", err)
-			}
+	addReportBanner(pdf, format, s.server, username)
+
+	for i, section := range sections {
+		// every currency starts on a new page
+		if i > 0 {
+			addNewPageWithCustomization(pdf, format)
+		}
+		tableContent := [][]string{{}}
+		for _, title := range section.header {
+			tableContent[0] = append(tableContent[0], "\n"+title)
+		}
+		tableContent = append(tableContent, section.rows...)
+		tableContent = append(tableContent, section.total)
+		// add table content
+		if err := addReportTable(pdf, format, section.title, tableContent,
+			cellWidth(format, section.widths)); err != nil {
+			return bytes.Buffer{}, err
 		}
 	}
 	// drawGrid(pdf, format)
 	buff := bytes.Buffer{}
 	buff.Reset()
-	err = pdf.Output(&buff)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to output report content to buffer: %v", err)
+	if err := pdf.Output(&buff); err != nil {
+		return bytes.Buffer{}, fmt.Errorf("failed to output report content to buffer: %v", err)
 	}
-
-	return sendStream(buff, srv.Send)
+	return buff, nil
 }
 
 func sendStream(data bytes.Buffer, send func(response *api.MiningReportResponse) error) (err error) {
@@ -146,7 +134,9 @@ func sendStream(data bytes.Buffer, send func(response *api.MiningReportResponse)
 		rb := make([]byte, 65535)
 		n, err := io.ReadFull(&data, rb)
 		if err != nil {
-			if err == io.ErrUnexpectedEOF {
+			// io.EOF is returned when the size of the report is a multiple
+			// of the chunk size and nothing is left to read
+			if err == io.ErrUnexpectedEOF || err == io.EOF {
 				if err = send(&api.MiningReportResponse{
 					Data:   rb[:n],
 					Finish: true,
@@ -173,63 +163,73 @@ func (s *Server) MiningReportCSV(req *api.MiningReportRequest, srv api.ReportSer
 	if err != nil {
 		return status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceRead) {
 		return status.Errorf(codes.PermissionDenied, "permission denied")
 	}
-	decimals := req.Decimals
-	if decimals == 0 {
-		decimals = 4
+	sections, err := s.getReportSections(srv.Context(), req)
+	if err != nil {
+		return err
 	}
 
 	buff := bytes.Buffer{}
 	buff.Reset()
 	wFile := csv.NewWriter(&buff)
 
-	for _, item := range req.Currency {
-		switch item {
-		case "ETH_MXC":
-			res, err := s.financeReportCli.GetMXCMiningReportByDate(srv.Context(), &pb.GetMXCMiningReportByDateRequest{
-				OrganizationId: req.OrganizationId,
-				Start:          req.Start,
-				End:            req.End,
-				FiatCurrency:   req.FiatCurrency,
-				Decimals:       decimals,
-			})
-			if err != nil {
-				return status.Errorf(codes.Internal, "failed to get MXC mining report : %v", err)
-			}
-
-			err = wFile.Write([]string{
-				"Date",
-				"MXC Mined",
-				"MXC Close Price",
-				fmt.Sprintf("%s Mined", strings.ToUpper(req.FiatCurrency)),
-				"Online Seconds"})
-			if err != nil {
-				logrus.Debugf("Error occurs when writing title to buffer: %v", err)
+	for _, section := range sections {
+		if len(sections) > 1 {
+			if err := wFile.Write([]string{section.title}); err != nil {
+				logrus.Debugf("Error occurs when writing section title to buffer: %v", err)
 			}
-
-			for _, v := range res.MiningRecordList {
-				y, m, d := v.DateTime.AsTime().Date()
-				dateStr := fmt.Sprintf("%04d-%02d-%02d", y, m, d)
-				err = wFile.Write([]string{
-					dateStr,
-					v.MXCMined,
-					v.MXCSettlementPrice,
-					v.FiatCurrencyMined,
-					fmt.Sprintf("%d", v.OnlineSeconds)})
-				if err != nil {
-					logrus.Debugf("Error occurs when writing value to buffer: %v", err)
-				}
+		}
+		if err := wFile.Write(section.header); err != nil {
+			logrus.Debugf("Error occurs when writing title to buffer: %v", err)
+		}
+		for _, row := range section.rows {
+			if err := wFile.Write(row); err != nil {
+				logrus.Debugf("Error occurs when writing value to buffer: %v", err)
 			}
 		}
+		if err := wFile.Write(section.total); err != nil {
+			logrus.Debugf("Error occurs when writing total to buffer: %v", err)
+		}
 	}
-	if err := wFile.Write([]string{"*This information is provided to the best of our current knowledge & ability. " +
-		"The MXC Foundation takes no legal responsibility for the accuracy or timeliness of this data. " +
-		"On-chain data is used to compile this information"}); err != nil {
+	if err := wFile.Write([]string{disclaimer}); err != nil {
 		return status.Errorf(codes.Internal, "Error occurs when writing disclaimer to buffer: %v", err)
 	}
 	wFile.Flush()
 
 	return sendStream(buff, srv.Send)
 }
+
+// MiningReportXLSX formats mining data into xlsx workbook with a sheet for
+// every currency then send to client in stream
+func (s *Server) MiningReportXLSX(req *api.MiningReportRequest, srv api.ReportService_MiningReportXLSXServer) error {
+	cred, err := s.auth.GetCredentials(srv.Context(), auth.NewOptions().WithOrgID(req.OrganizationId))
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.Has(auth.PermFinanceRead) {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	sections, err := s.getReportSections(srv.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	var sheets []xlsxSheet
+	for _, section := range sections {
+		sheet := xlsxSheet{name: section.currency}
+		sheet.rows = append(sheet.rows, section.header)
+		sheet.rows = append(sheet.rows, section.rows...)
+		sheet.rows = append(sheet.rows, section.total, []string{}, []string{disclaimer})
+		sheets = append(sheets, sheet)
+	}
+
+	buff := bytes.Buffer{}
+	buff.Reset()
+	if err := writeXLSX(&buff, sheets); err != nil {
+		return status.Errorf(codes.Internal, "failed to output report content to buffer: %v", err)
+	}
+
+	return sendStream(buff, srv.Send)
+}
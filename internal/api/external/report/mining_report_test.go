@@ -1,7 +1,11 @@
 package report
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"io/ioutil"
+	"strings"
 
 	"google.golang.org/grpc/metadata"
 
@@ -23,7 +27,65 @@ type testMXPCli struct {
 
 func (tc *testMXPCli) GetMXCMiningReportByDate(ctx context.Context, in *pb.GetMXCMiningReportByDateRequest,
 	opts ...grpc.CallOption) (*pb.GetMXCMiningReportByDateResponse, error) {
-	return &pb.GetMXCMiningReportByDateResponse{}, nil
+	return &pb.GetMXCMiningReportByDateResponse{
+		MiningRecordList: []*pb.MiningRecord{
+			{
+				DateTime:           timestamppb.New(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+				MXCMined:           "10.5",
+				MXCSettlementPrice: "0.02",
+				FiatCurrencyMined:  "0.21",
+				OnlineSeconds:      86400,
+			},
+			{
+				DateTime:           timestamppb.New(time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC)),
+				MXCMined:           "9.5",
+				MXCSettlementPrice: "0.02",
+				FiatCurrencyMined:  "0.19",
+				OnlineSeconds:      43200,
+			},
+		},
+	}, nil
+}
+
+type testDHXCli struct {
+	pb.DHXServiceClient
+}
+
+func (tc *testDHXCli) DHXMiningHistory(ctx context.Context, in *pb.DHXMiningHistoryRequest,
+	opts ...grpc.CallOption) (*pb.DHXMiningHistoryResponse, error) {
+	return &pb.DHXMiningHistoryResponse{
+		DhxMining: []*pb.DHXMining{
+			{
+				MiningDate:     timestamppb.New(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+				OrgId:          in.OrgId,
+				OrgMiningPower: "1000",
+				OrgDhxBonded:   "20",
+				OrgDhxMined:    "1.25",
+			},
+			{
+				MiningDate:     timestamppb.New(time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC)),
+				OrgId:          in.OrgId,
+				OrgMiningPower: "1000",
+				OrgDhxBonded:   "20",
+				OrgDhxMined:    "0.5",
+			},
+		},
+	}, nil
+}
+
+type testWalletCli struct {
+	pb.WalletServiceClient
+}
+
+func (tc *testWalletCli) GetTransactionHistory(ctx context.Context, in *pb.GetTransactionHistoryRequest,
+	opts ...grpc.CallOption) (*pb.GetTransactionHistoryResponse, error) {
+	return &pb.GetTransactionHistoryResponse{
+		Tx: []*pb.Transaction{
+			{Id: 1, Timestamp: timestamppb.Now(), Amount: "0.001", PaymentType: "BONUS"},
+			{Id: 2, Timestamp: timestamppb.Now(), Amount: "-0.0005", PaymentType: "WITHDRAW"},
+			{Id: 3, Timestamp: timestamppb.Now(), Amount: "0.002", PaymentType: "BONUS"},
+		},
+	}, nil
 }
 
 type testAuth struct {
@@ -39,6 +101,7 @@ func (ta *testAuth) GetCredentials(ctx context.Context, opts *auth.Options) (*au
 }
 
 type streamServer struct {
+	data bytes.Buffer
 }
 
 func (s *streamServer) Send(response *api.MiningReportResponse) error {
@@ -61,6 +124,9 @@ func (s *streamServer) Context() context.Context {
 }
 
 func (s *streamServer) SendMsg(m interface{}) error {
+	if resp, ok := m.(*api.MiningReportResponse); ok {
+		s.data.Write(resp.Data)
+	}
 	return nil
 }
 
@@ -89,7 +155,7 @@ func TestGetMiningReportCSVFileURI(t *testing.T) {
 			}, nil
 		},
 	}
-	server := NewServer(mxpCli, ta, "local_test_server")
+	server := NewServer(mxpCli, &testDHXCli{}, &testWalletCli{}, nil, ta, "local_test_server")
 	request := api.MiningReportRequest{
 		OrganizationId: 1,
 		Currency:       []string{"ETH_MXC"},
@@ -126,10 +192,10 @@ func TestGetMiningReportPDFFileURI(t *testing.T) {
 			}, nil
 		},
 	}
-	server := NewServer(mxpCli, ta, "local_test_server")
+	server := NewServer(mxpCli, &testDHXCli{}, &testWalletCli{}, nil, ta, "local_test_server")
 	request := api.MiningReportRequest{
 		OrganizationId: 1,
-		Currency:       []string{"ETH_MXC"},
+		Currency:       []string{"ETH_MXC", "DHX", "BTC"},
 		FiatCurrency:   "usd",
 		Start:          timestamppb.New(time.Now().AddDate(-1, 0, 0)),
 		End:            timestamppb.New(time.Now()),
@@ -140,3 +206,144 @@ func TestGetMiningReportPDFFileURI(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func testAdminAuth() *testAuth {
+	return &testAuth{
+		validator: func(opts *auth.Options) (*auth.Credentials, error) {
+			return &auth.Credentials{
+				UserID:     1,
+				Username:   "user1",
+				IsExisting: true,
+				OrgID:      opts.OrgID,
+				IsOrgAdmin: true,
+			}, nil
+		},
+	}
+}
+
+func TestGetReportSections(t *testing.T) {
+	server := NewServer(&testMXPCli{}, &testDHXCli{}, &testWalletCli{}, nil, testAdminAuth(), "local_test_server")
+	request := &api.MiningReportRequest{
+		OrganizationId: 1,
+		Currency:       []string{"ETH_MXC", "DHX", "BTC"},
+		FiatCurrency:   "usd",
+		Decimals:       4,
+	}
+	sections, err := server.getReportSections(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sections) != 3 {
+		t.Fatalf("expected 3 sections, got %d", len(sections))
+	}
+	expected := map[string][]string{
+		"ETH_MXC": {"Total", "20.0000", "", "0.4000", "129600"},
+		"DHX":     {"Total", "", "", "1.7500"},
+		"BTC":     {"Total", "", "0.0030"},
+	}
+	for _, section := range sections {
+		if strings.Join(section.total, ",") != strings.Join(expected[section.currency], ",") {
+			t.Errorf("%s: expected total %v, got %v", section.currency, expected[section.currency], section.total)
+		}
+		if len(section.header) != len(section.widths) {
+			t.Errorf("%s: every column must have width", section.currency)
+		}
+	}
+	if len(sections[2].rows) != 2 {
+		t.Errorf("expected outgoing BTC transactions to be skipped, got %d rows", len(sections[2].rows))
+	}
+
+	request.Currency = []string{"ETH_MXC", "XYZ"}
+	if _, err := server.getReportSections(context.Background(), request); err == nil {
+		t.Errorf("expected error for unsupported currency")
+	}
+}
+
+// testClosePricer knows the close prices of mxc/usd on some days
+type testClosePricer map[string]float64
+
+func (tp testClosePricer) GetClosePrice(ctx context.Context, crypto, fiat string, day time.Time) (float64, error) {
+	price, ok := tp[crypto+"/"+fiat+" "+day.Format("2006-01-02")]
+	if !ok {
+		return 0, fmt.Errorf("close price is not known")
+	}
+	return price, nil
+}
+
+func TestMXCSectionClosePrices(t *testing.T) {
+	pricer := testClosePricer{"mxc/usd 2021-03-01": 0.03}
+	server := NewServer(&testMXPCli{}, &testDHXCli{}, &testWalletCli{}, pricer, testAdminAuth(), "local_test_server")
+	sections, err := server.getReportSections(context.Background(), &api.MiningReportRequest{
+		OrganizationId: 1,
+		Currency:       []string{"ETH_MXC"},
+		FiatCurrency:   "USD",
+		Decimals:       4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	section := sections[0]
+	// the first day is valued using the recorded close price, for the
+	// second day the value calculated by mxprotocol server is used
+	if strings.Join(section.rows[0], ",") != "2021-03-01,10.5,0.03,0.3150,86400" {
+		t.Errorf("unexpected first row: %v", section.rows[0])
+	}
+	if strings.Join(section.rows[1], ",") != "2021-03-02,9.5,0.02,0.19,43200" {
+		t.Errorf("unexpected second row: %v", section.rows[1])
+	}
+	if section.total[3] != "0.5050" {
+		t.Errorf("expected fiat total 0.5050, got %s", section.total[3])
+	}
+}
+
+func TestGetMiningReportXLSX(t *testing.T) {
+	server := NewServer(&testMXPCli{}, &testDHXCli{}, &testWalletCli{}, nil, testAdminAuth(), "local_test_server")
+	request := api.MiningReportRequest{
+		OrganizationId: 1,
+		Currency:       []string{"ETH_MXC", "DHX", "BTC"},
+		FiatCurrency:   "usd",
+		Start:          timestamppb.New(time.Now().AddDate(-1, 0, 0)),
+		End:            timestamppb.New(time.Now()),
+	}
+	stream := &streamServer{}
+	if err := server.MiningReportXLSX(&request, stream); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(stream.data.Bytes()), int64(stream.data.Len()))
+	if err != nil {
+		t.Fatalf("report is not a valid xlsx file: %v", err)
+	}
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = string(content)
+	}
+	for _, name := range []string{"ETH_MXC", "DHX", "BTC"} {
+		if !strings.Contains(files["xl/workbook.xml"], `name="`+name+`"`) {
+			t.Errorf("sheet %s is missing", name)
+		}
+	}
+	if !strings.Contains(files["xl/worksheets/sheet2.xml"], `<c r="D4"><v>1.7500</v></c>`) {
+		t.Errorf("DHX total is missing: %s", files["xl/worksheets/sheet2.xml"])
+	}
+	if !strings.Contains(files["xl/worksheets/sheet1.xml"], `<t>USD Mined</t>`) {
+		t.Errorf("MXC header is missing: %s", files["xl/worksheets/sheet1.xml"])
+	}
+}
+
+func TestXLSXColumn(t *testing.T) {
+	for index, name := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := xlsxColumn(index); got != name {
+			t.Errorf("column %d: expected %s, got %s", index, name, got)
+		}
+	}
+}
@@ -75,10 +75,7 @@ func addNewPageWithCustomization(pdf *gofpdf.Fpdf, f pdfFormat) {
 		return
 	}
 	pdf.MoveTo(f.gridWidth, f.pageHeight-f.indentationBottom-4*f.disclaimerFontSize)
-	pdf.MultiCell(f.tableWidth, f.disclaimerFontSize,
-		"*This information is provided to the best of our current knowledge & ability. "+
-			"The MXC Foundation takes no legal responsibility for the accuracy or timeliness of this data. "+
-			"On-chain data is used to compile this information", gofpdf.BorderNone, gofpdf.AlignLeft, false)
+	pdf.MultiCell(f.tableWidth, f.disclaimerFontSize, disclaimer, gofpdf.BorderNone, gofpdf.AlignLeft, false)
 }
 
 func addReportBanner(pdf *gofpdf.Fpdf, f pdfFormat, supernode, username string) {
@@ -116,7 +113,32 @@ func addReportBanner(pdf *gofpdf.Fpdf, f pdfFormat, supernode, username string)
 			"UEN: 201817203G", gofpdf.BorderNone, gofpdf.AlignCenter, false)
 }
 
-func addReportTable(pdf *gofpdf.Fpdf, f pdfFormat, table [][]string, cellWidth []float64) error {
+// cellWidth converts the widths of the columns given in units of the content
+// font size into the widths of the table cells, columns with zero width share
+// the rest of the table width evenly
+func cellWidth(f pdfFormat, units []float64) []float64 {
+	totalUnits := (f.tableWidth - float64(len(units)-1)*f.charSpacing) / f.contentFontSize
+	var fixedUnits float64
+	var shared int
+	for _, u := range units {
+		if u == 0 {
+			shared++
+		}
+		fixedUnits += u
+	}
+	width := make([]float64, len(units))
+	for i, u := range units {
+		if u == 0 {
+			u = (totalUnits - fixedUnits) / float64(shared)
+		}
+		width[i] = u * f.contentFontSize
+	}
+	return width
+}
+
+// addReportTable adds the table with given title starting at the top of the
+// current page, the table continues on the following pages if needed
+func addReportTable(pdf *gofpdf.Fpdf, f pdfFormat, title string, table [][]string, cellWidth []float64) error {
 	// insanity check
 	if len(table[0]) != len(cellWidth) {
 		return fmt.Errorf("length of cellWidth must be same as length of table columns")
@@ -125,6 +147,12 @@ func addReportTable(pdf *gofpdf.Fpdf, f pdfFormat, table [][]string, cellWidth [
 	recHeight := 3.0
 	tableX := f.indentationLeft / 2
 	tableY := f.bannerHeight + f.lineSpacing
+	if title != "" {
+		pdf.SetFont("arial", "B", f.contentFontSize)
+		pdf.SetTextColor(28, 20, 120)
+		pdf.Text(tableX, tableY+f.contentFontSize, title)
+		tableY += f.contentFontSize + f.lineSpacing
+	}
 	tableWidth := f.tableWidth
 	tableHeight := f.pageHeight - tableY - f.indentationBottom
 	cellHeight := 1.2 * f.contentFontSize
@@ -0,0 +1,235 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	pb "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
+)
+
+// currencies supported in mining report
+const (
+	currencyMXC = "ETH_MXC"
+	currencyDHX = "DHX"
+	currencyBTC = "BTC"
+)
+
+const disclaimer = "*This information is provided to the best of our current knowledge & ability. " +
+	"The MXC Foundation takes no legal responsibility for the accuracy or timeliness of this data. " +
+	"On-chain data is used to compile this information"
+
+// reportSection holds the table of one currency of the mining report, the
+// same sections are rendered by every report format
+type reportSection struct {
+	currency string
+	title    string
+	header   []string
+	rows     [][]string
+	total    []string
+	// amount is the total income in the currency of the section
+	amount string
+	// widths of the columns in the pdf report in units of the font size,
+	// columns with zero width share the rest of the table evenly
+	widths []float64
+}
+
+// getReportSections retrieves the mining data of every requested currency
+func (s *Server) getReportSections(ctx context.Context, req *api.MiningReportRequest) ([]reportSection, error) {
+	decimals := req.Decimals
+	if decimals == 0 {
+		decimals = 4
+	}
+
+	var sections []reportSection
+	for _, item := range req.Currency {
+		var section reportSection
+		var err error
+		switch item {
+		case currencyMXC:
+			section, err = s.getMXCSection(ctx, req, decimals)
+		case currencyDHX:
+			section, err = s.getDHXSection(ctx, req, decimals)
+		case currencyBTC:
+			section, err = s.getBTCSection(ctx, req, decimals)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported currency: %s", item)
+		}
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, section)
+	}
+	if len(sections) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no currency requested")
+	}
+	return sections, nil
+}
+
+func (s *Server) getMXCSection(ctx context.Context, req *api.MiningReportRequest, decimals int32) (reportSection, error) {
+	res, err := s.financeReportCli.GetMXCMiningReportByDate(ctx, &pb.GetMXCMiningReportByDateRequest{
+		OrganizationId: req.OrganizationId,
+		Start:          req.Start,
+		End:            req.End,
+		FiatCurrency:   req.FiatCurrency,
+		Decimals:       decimals,
+	})
+	if err != nil {
+		return reportSection{}, status.Errorf(codes.Internal, "failed to get MXC mining report : %v", err)
+	}
+
+	fiat := strings.ToUpper(req.FiatCurrency)
+	section := reportSection{
+		currency: currencyMXC,
+		title:    "MXC Mining",
+		header: []string{
+			"Date",
+			"MXC Mined",
+			"MXC Close Price",
+			fmt.Sprintf("%s Mined", fiat),
+			"Online Seconds",
+		},
+		widths: []float64{5.5, 14.0, 0, 0, 10.0},
+	}
+	var mxcTotal, fiatTotal decimal.Decimal
+	var secondsTotal int64
+	for _, v := range res.MiningRecordList {
+		closePrice, fiatMined := s.mxcFiatValue(ctx, v, strings.ToLower(req.FiatCurrency), decimals)
+		section.rows = append(section.rows, []string{
+			formatDate(v.DateTime),
+			v.MXCMined,
+			closePrice,
+			fiatMined,
+			fmt.Sprintf("%d", v.OnlineSeconds),
+		})
+		mxcTotal = mxcTotal.Add(parseAmount(v.MXCMined))
+		fiatTotal = fiatTotal.Add(parseAmount(fiatMined))
+		secondsTotal += v.OnlineSeconds
+	}
+	section.amount = mxcTotal.StringFixed(decimals)
+	section.total = []string{
+		"Total",
+		section.amount,
+		"",
+		fiatTotal.StringFixed(decimals),
+		fmt.Sprintf("%d", secondsTotal),
+	}
+	return section, nil
+}
+
+// mxcFiatValue returns the close price of MXC on the day of the mining record
+// and the fiat value of the MXC mined on that day. The close price is taken
+// from the price history, if it's not available the values calculated by
+// mxprotocol server are returned.
+func (s *Server) mxcFiatValue(ctx context.Context, v *pb.MiningRecord, fiat string, decimals int32) (string, string) {
+	if s.pricer == nil {
+		return v.MXCSettlementPrice, v.FiatCurrencyMined
+	}
+	price, err := s.pricer.GetClosePrice(ctx, "mxc", fiat, v.DateTime.AsTime())
+	if err != nil {
+		logrus.Warnf("couldn't get close price of mxc/%s for %s: %v", fiat, formatDate(v.DateTime), err)
+		return v.MXCSettlementPrice, v.FiatCurrencyMined
+	}
+	closePrice := decimal.NewFromFloat(price)
+	return closePrice.String(), parseAmount(v.MXCMined).Mul(closePrice).StringFixed(decimals)
+}
+
+func (s *Server) getDHXSection(ctx context.Context, req *api.MiningReportRequest, decimals int32) (reportSection, error) {
+	res, err := s.dhxCli.DHXMiningHistory(ctx, &pb.DHXMiningHistoryRequest{
+		OrgId: req.OrganizationId,
+		From:  req.Start,
+		Till:  req.End,
+	})
+	if err != nil {
+		return reportSection{}, status.Errorf(codes.Internal, "failed to get DHX mining history: %v", err)
+	}
+
+	section := reportSection{
+		currency: currencyDHX,
+		title:    "DHX Mining",
+		header: []string{
+			"Date",
+			"Mining Power",
+			"DHX Bonded",
+			"DHX Mined",
+		},
+		widths: []float64{5.5, 0, 0, 0},
+	}
+	var minedTotal decimal.Decimal
+	for _, v := range res.DhxMining {
+		mined := parseAmount(v.OrgDhxMined)
+		section.rows = append(section.rows, []string{
+			formatDate(v.MiningDate),
+			parseAmount(v.OrgMiningPower).StringFixed(decimals),
+			parseAmount(v.OrgDhxBonded).StringFixed(decimals),
+			mined.StringFixed(decimals),
+		})
+		minedTotal = minedTotal.Add(mined)
+	}
+	section.amount = minedTotal.StringFixed(decimals)
+	section.total = []string{"Total", "", "", section.amount}
+	return section, nil
+}
+
+// getBTCSection returns BTC income of the organization, that is every BTC
+// transaction crediting the organization's wallet in the given period
+func (s *Server) getBTCSection(ctx context.Context, req *api.MiningReportRequest, decimals int32) (reportSection, error) {
+	res, err := s.walletCli.GetTransactionHistory(ctx, &pb.GetTransactionHistoryRequest{
+		OrgId:    req.OrganizationId,
+		Currency: currencyBTC,
+		From:     req.Start,
+		Till:     req.End,
+	})
+	if err != nil {
+		return reportSection{}, status.Errorf(codes.Internal, "failed to get BTC transaction history: %v", err)
+	}
+
+	section := reportSection{
+		currency: currencyBTC,
+		title:    "BTC Income",
+		header: []string{
+			"Date",
+			"Payment Type",
+			"BTC Received",
+		},
+		widths: []float64{5.5, 0, 0},
+	}
+	var total decimal.Decimal
+	for _, v := range res.Tx {
+		amount := parseAmount(v.Amount)
+		if !amount.IsPositive() {
+			continue
+		}
+		section.rows = append(section.rows, []string{
+			formatDate(v.Timestamp),
+			v.PaymentType,
+			amount.StringFixed(decimals),
+		})
+		total = total.Add(amount)
+	}
+	section.amount = total.StringFixed(decimals)
+	section.total = []string{"Total", "", section.amount}
+	return section, nil
+}
+
+func formatDate(ts *timestamppb.Timestamp) string {
+	y, m, d := ts.AsTime().Date()
+	return fmt.Sprintf("%04d-%02d-%02d", y, m, d)
+}
+
+// parseAmount parses amount returned by mxprotocol server, amounts that
+// can't be parsed are not included in the totals
+func parseAmount(amount string) decimal.Decimal {
+	d, err := decimal.NewFromString(amount)
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
@@ -0,0 +1,49 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+)
+
+// Statement is the mining report of the organization for the period
+type Statement struct {
+	// PDF is the report document
+	PDF []byte
+	// Totals holds the total income of the organization by currency
+	Totals map[string]string
+}
+
+// GenerateStatement generates the pdf mining report of the organization for
+// the period between start and end that includes all the supported
+// currencies. It is used to send the statements to the subscribers, so the
+// permissions are not checked
+func (s *Server) GenerateStatement(ctx context.Context, organizationID int64, fiatCurrency string,
+	start, end time.Time, username string) (*Statement, error) {
+	sections, err := s.getReportSections(ctx, &api.MiningReportRequest{
+		OrganizationId: organizationID,
+		Currency:       []string{currencyMXC, currencyDHX, currencyBTC},
+		FiatCurrency:   fiatCurrency,
+		Start:          timestamppb.New(start),
+		End:            timestamppb.New(end),
+	})
+	if err != nil {
+		return nil, err
+	}
+	buff, err := s.renderPDF(sections, username)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &Statement{
+		PDF:    buff.Bytes(),
+		Totals: make(map[string]string),
+	}
+	for _, section := range sections {
+		st.Totals[section.currency] = section.amount
+	}
+	return st, nil
+}
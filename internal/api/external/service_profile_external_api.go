@@ -103,7 +103,7 @@ func (a *ServiceProfileServiceAPI) Get(ctx context.Context, req *pb.GetServicePr
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
+	if !cred.Has(auth.PermDeviceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -226,7 +226,7 @@ func (a *ServiceProfileServiceAPI) List(ctx context.Context, req *pb.ListService
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsGlobalAdmin && !cred.IsOrgUser {
+	if !cred.Has(auth.PermDeviceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -242,7 +242,7 @@ func (a *ServiceProfileServiceAPI) List(ctx context.Context, req *pb.ListService
 		if err != nil {
 			return nil, helpers.ErrToRPCError(err)
 		}
-	} else if req.OrganizationId == 0 && cred.IsOrgUser {
+	} else if req.OrganizationId == 0 && cred.Has(auth.PermDeviceRead) {
 		sps, err = a.st.GetServiceProfilesForUser(ctx, cred.UserID, int(req.Limit), int(req.Offset))
 		if err != nil {
 			return nil, helpers.ErrToRPCError(err)
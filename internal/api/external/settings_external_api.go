@@ -11,6 +11,7 @@ import (
 	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
 	pb "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
 
+	"github.com/mxc-foundation/lpwan-app-server/internal/audit"
 	"github.com/mxc-foundation/lpwan-app-server/internal/modules/serverinfo"
 	"github.com/mxc-foundation/lpwan-app-server/internal/mxpcli"
 )
@@ -63,6 +64,22 @@ func (s *SettingsServerAPI) ModifySettings(ctx context.Context, req *api.ModifyS
 
 	settingClient := mxpcli.Global.GetSettingsServiceClient()
 
+	// the previous settings are only needed for the audit log, failure to get
+	// them doesn't prevent the modification
+	var before map[string]interface{}
+	if cur, err := settingClient.GetSettings(ctx, &pb.GetSettingsRequest{}); err != nil {
+		log.WithError(err).Warn(logInfo + ": couldn't get current settings")
+	} else {
+		// the keys are the same as in the modification below, the downlink
+		// price is set as the downlink fee and the supernode income ratio as
+		// the transaction percentage share
+		before = map[string]interface{}{
+			"low_balance_warning":          cur.LowBalanceWarning,
+			"downlink_fee":                 cur.DownlinkPrice,
+			"transaction_percentage_share": cur.SupernodeIncomeRatio,
+		}
+	}
+
 	resp, err := settingClient.ModifySettings(ctx, &pb.ModifySettingsRequest{
 		LowBalanceWarning:          req.LowBalanceWarning,
 		DownlinkFee:                req.DownlinkFee,
@@ -72,6 +89,17 @@ func (s *SettingsServerAPI) ModifySettings(ctx context.Context, req *api.ModifyS
 		log.WithError(err).Error(logInfo)
 		return &api.ModifySettingsResponse{}, status.Errorf(codes.Unavailable, err.Error())
 	}
+	after := make(map[string]interface{})
+	if req.LowBalanceWarning != nil {
+		after["low_balance_warning"] = req.LowBalanceWarning.Value
+	}
+	if req.DownlinkFee != nil {
+		after["downlink_fee"] = req.DownlinkFee.Value
+	}
+	if req.TransactionPercentageShare != nil {
+		after["transaction_percentage_share"] = req.TransactionPercentageShare.Value
+	}
+	audit.Describe(ctx, 0, "settings", before, after)
 
 	return &api.ModifySettingsResponse{
 		Status: resp.Status,
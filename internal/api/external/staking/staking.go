@@ -58,7 +58,7 @@ func (s *Server) Stake(ctx context.Context, req *api.StakeRequest) (*api.StakeRe
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -87,7 +87,7 @@ func (s *Server) Unstake(ctx context.Context, req *api.UnstakeRequest) (*api.Uns
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -113,7 +113,7 @@ func (s *Server) GetActiveStakes(ctx context.Context, req *api.GetActiveStakesRe
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -150,7 +150,7 @@ func (s *Server) StakeInfo(ctx context.Context, req *api.StakeInfoRequest) (*api
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -190,7 +190,7 @@ func (s *Server) GetStakingRevenue(ctx context.Context, req *api.StakingRevenueR
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -214,7 +214,7 @@ func (s *Server) GetStakingHistory(ctx context.Context, req *api.StakingHistoryR
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -13,7 +13,6 @@ import (
 	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
 	pb "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
 
-	"github.com/mxc-foundation/lpwan-app-server/internal/modules/topup"
 	"github.com/mxc-foundation/lpwan-app-server/internal/mxpcli"
 )
 
@@ -33,8 +32,12 @@ func NewTopUpServerAPI(auth auth.Authenticator) *TopUpServerAPI {
 func (s *TopUpServerAPI) GetTopUpHistory(ctx context.Context, req *api.GetTopUpHistoryRequest) (*api.GetTopUpHistoryResponse, error) {
 	logInfo := "api/appserver_serves_ui/GetTopUpHistory org=" + strconv.FormatInt(req.OrgId, 10)
 
-	if err := topup.NewValidator().IsOrgAdmin(ctx, req.OrgId); err != nil {
-		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	cred, err := s.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(req.OrgId))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.Has(auth.PermFinanceRead) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
 	topupClient := mxpcli.Global.GetTopupServiceClient()
@@ -74,7 +77,7 @@ func (s *TopUpServerAPI) GetTopUpDestination(ctx context.Context, req *api.GetTo
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -262,7 +262,7 @@ func (a *Server) UnbindExternalUser(ctx context.Context, req *pb.UnbindExternalU
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed : %s", err.Error())
 	}
 
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermOrganizationAdmin) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -294,7 +294,7 @@ func (a *Server) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed : %s", err.Error())
 	}
 
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermOrganizationAdmin) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -373,7 +373,7 @@ func (a *Server) ConfirmBindingEmail(ctx context.Context, req *pb.ConfirmBinding
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err.Error())
 	}
 
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermOrganizationAdmin) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -0,0 +1,136 @@
+package user
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kinds of the records returned by the global search
+const (
+	SearchKindOrganization   = "organization"
+	SearchKindApplication    = "application"
+	SearchKindDevice         = "device"
+	SearchKindGateway        = "gateway"
+	SearchKindMulticastGroup = "multicast-group"
+	SearchKindDeviceProfile  = "device-profile"
+	SearchKindUser           = "user"
+)
+
+// SearchKinds are all the kinds of the records returned by the global search
+var SearchKinds = []string{
+	SearchKindOrganization,
+	SearchKindApplication,
+	SearchKindDevice,
+	SearchKindGateway,
+	SearchKindMulticastGroup,
+	SearchKindDeviceProfile,
+	SearchKindUser,
+}
+
+// Values of the status filter
+const (
+	SearchStatusOnline  = "online"
+	SearchStatusOffline = "offline"
+)
+
+const (
+	// DeviceOfflineAfter is the time since the last uplink after which the
+	// device is considered offline
+	DeviceOfflineAfter = 24 * time.Hour
+	// GatewayOfflineAfter is the time since the last stats after which the
+	// gateway is considered offline
+	GatewayOfflineAfter = 10 * time.Minute
+)
+
+// SearchQuery is the parsed global search query
+type SearchQuery struct {
+	// Text is matched against the names and EUIs
+	Text string
+	// Kinds limits the search to the given kinds of the records, all the
+	// kinds are searched if empty
+	Kinds []string
+	// Status limits the search to online or offline devices and gateways
+	Status string
+	// OrganizationID limits the search to the organization with the ID
+	OrganizationID int64
+	// OrganizationName limits the search to the organization with the name
+	OrganizationName string
+	// Profile limits the search to the devices using the device profile with
+	// the name and to the device profiles with the name
+	Profile string
+	// Tags limits the search to the devices and gateways having all the tags
+	Tags map[string]string
+}
+
+// HasKind returns true if the records of the kind may match the query
+func (q SearchQuery) HasKind(kind string) bool {
+	if len(q.Kinds) != 0 && !contains(q.Kinds, kind) {
+		return false
+	}
+	if (q.Status != "" || len(q.Tags) != 0) && kind != SearchKindDevice && kind != SearchKindGateway {
+		return false
+	}
+	if q.Profile != "" && kind != SearchKindDevice && kind != SearchKindDeviceProfile {
+		return false
+	}
+	return true
+}
+
+// SearchFacet is the number of the records of the kind matching the query
+type SearchFacet struct {
+	Kind  string `db:"kind"`
+	Count int64  `db:"count"`
+}
+
+var searchFilterRegexp = regexp.MustCompile(`([^ :]+):([^ ]+)`)
+
+// ParseSearchQuery parses the global search query. The query consists of the
+// text and key:value filters, the keys kind, status, org and profile are
+// the filters described by SearchQuery, any other key is a tag. Example:
+// "foo status:offline room:12" searches for the offline devices and
+// gateways matching "foo" with the tag room set to 12.
+func ParseSearchQuery(search string) (SearchQuery, error) {
+	var q SearchQuery
+	for _, m := range searchFilterRegexp.FindAllStringSubmatch(search, -1) {
+		key, value := m[1], m[2]
+		switch strings.ToLower(key) {
+		case "kind":
+			if !contains(SearchKinds, value) {
+				return q, fmt.Errorf("unknown kind %q, must be one of %s", value, strings.Join(SearchKinds, ", "))
+			}
+			q.Kinds = append(q.Kinds, value)
+		case "status":
+			if value != SearchStatusOnline && value != SearchStatusOffline {
+				return q, fmt.Errorf("status must be %s or %s", SearchStatusOnline, SearchStatusOffline)
+			}
+			q.Status = value
+		case "org":
+			if id, err := strconv.ParseInt(value, 10, 64); err == nil {
+				q.OrganizationID = id
+			} else {
+				q.OrganizationName = value
+			}
+		case "profile":
+			q.Profile = value
+		default:
+			if q.Tags == nil {
+				q.Tags = make(map[string]string)
+			}
+			q.Tags[key] = value
+		}
+	}
+	q.Text = strings.Join(strings.Fields(searchFilterRegexp.ReplaceAllString(search, "")), " ")
+	return q, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package user
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	for _, tc := range []struct {
+		search   string
+		expected SearchQuery
+		err      bool
+	}{
+		{
+			search:   "  foo  bar ",
+			expected: SearchQuery{Text: "foo bar"},
+		},
+		{
+			search: "foo status:offline room:12 floor:3",
+			expected: SearchQuery{
+				Text:   "foo",
+				Status: SearchStatusOffline,
+				Tags:   map[string]string{"room": "12", "floor": "3"},
+			},
+		},
+		{
+			search:   "org:42 kind:device kind:gateway",
+			expected: SearchQuery{OrganizationID: 42, Kinds: []string{SearchKindDevice, SearchKindGateway}},
+		},
+		{
+			search:   "org:acme profile:sensor-v2 0102",
+			expected: SearchQuery{Text: "0102", OrganizationName: "acme", Profile: "sensor-v2"},
+		},
+		{
+			search: "status:sleeping",
+			err:    true,
+		},
+		{
+			search: "kind:router",
+			err:    true,
+		},
+	} {
+		q, err := ParseSearchQuery(tc.search)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%q: expected an error", tc.search)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", tc.search, err)
+			continue
+		}
+		if !reflect.DeepEqual(q, tc.expected) {
+			t.Errorf("%q: expected %+v, got %+v", tc.search, tc.expected, q)
+		}
+	}
+}
+
+func TestSearchQueryHasKind(t *testing.T) {
+	for _, tc := range []struct {
+		query    SearchQuery
+		expected []string
+	}{
+		{
+			query:    SearchQuery{Text: "foo"},
+			expected: SearchKinds,
+		},
+		{
+			query:    SearchQuery{Status: SearchStatusOnline},
+			expected: []string{SearchKindDevice, SearchKindGateway},
+		},
+		{
+			query:    SearchQuery{Tags: map[string]string{"room": "12"}, Kinds: []string{SearchKindGateway}},
+			expected: []string{SearchKindGateway},
+		},
+		{
+			query:    SearchQuery{Profile: "sensor-v2"},
+			expected: []string{SearchKindDevice, SearchKindDeviceProfile},
+		},
+	} {
+		var kinds []string
+		for _, k := range SearchKinds {
+			if tc.query.HasKind(k) {
+				kinds = append(kinds, k)
+			}
+		}
+		if !reflect.DeepEqual(kinds, tc.expected) {
+			t.Errorf("%+v: expected %v, got %v", tc.query, tc.expected, kinds)
+		}
+	}
+}
@@ -15,8 +15,13 @@ import (
 	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
 	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
 	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
 )
 
+// shopifyOrdersJob is the name of the job checking the shopify orders in the
+// leader election
+const shopifyOrdersJob = "shopify-orders"
+
 // ShopifyAdminAPI defines shopify admin api configuration
 type ShopifyAdminAPI struct {
 	Hostname   string `mapstructure:"hostname"`
@@ -335,7 +340,13 @@ func (su *ShopifyUser) nextRun(ctx context.Context) (time.Time, error) {
 
 func (su *ShopifyUser) run(ctx context.Context) {
 	for {
-		next, err := su.nextRun(ctx)
+		// only the leader checks the orders, others check again later
+		// whether they took over
+		next := time.Now().Add(time.Minute)
+		var err error
+		if leader.IsLeader(shopifyOrdersJob) {
+			next, err = su.nextRun(ctx)
+		}
 		if err != nil {
 			log.Errorf("check shopify order failure: %v", err)
 		}
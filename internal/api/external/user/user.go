@@ -69,16 +69,22 @@ type Organization struct {
 
 // SearchResult defines a search result.
 type SearchResult struct {
-	Kind             string         `db:"kind"`
-	Score            float64        `db:"score"`
-	OrganizationID   *int64         `db:"organization_id"`
-	OrganizationName *string        `db:"organization_name"`
-	ApplicationID    *int64         `db:"application_id"`
-	ApplicationName  *string        `db:"application_name"`
-	DeviceDevEUI     *lorawan.EUI64 `db:"device_dev_eui"`
-	DeviceName       *string        `db:"device_name"`
-	GatewayMAC       *lorawan.EUI64 `db:"gateway_mac"`
-	GatewayName      *string        `db:"gateway_name"`
+	Kind               string         `db:"kind"`
+	Score              float64        `db:"score"`
+	OrganizationID     *int64         `db:"organization_id"`
+	OrganizationName   *string        `db:"organization_name"`
+	ApplicationID      *int64         `db:"application_id"`
+	ApplicationName    *string        `db:"application_name"`
+	DeviceDevEUI       *lorawan.EUI64 `db:"device_dev_eui"`
+	DeviceName         *string        `db:"device_name"`
+	GatewayMAC         *lorawan.EUI64 `db:"gateway_mac"`
+	GatewayName        *string        `db:"gateway_name"`
+	MulticastGroupID   *string        `db:"multicast_group_id"`
+	MulticastGroupName *string        `db:"multicast_group_name"`
+	DeviceProfileID    *string        `db:"device_profile_id"`
+	DeviceProfileName  *string        `db:"device_profile_name"`
+	UserID             *int64         `db:"user_id"`
+	Username           *string        `db:"username"`
 }
 
 // Store defines db APIs used by this package
@@ -118,6 +124,9 @@ type Store interface {
 	DeleteUser(ctx context.Context, userID int64) error
 	// SetUserLastLogin updates display_name and last_login_service
 	SetUserLastLogin(ctx context.Context, userID int64, displayName, service string) error
+	// JoinInvitedOrganizations adds the newly registered user to the
+	// organizations whose invitations the user has accepted
+	JoinInvitedOrganizations(ctx context.Context, userID int64) error
 
 	// GetUserIDByExternalUserID gets user id from service name and external user id
 	GetUserIDByExternalUserID(ctx context.Context, service string, externalUserID string) (int64, error)
@@ -138,9 +147,13 @@ type Store interface {
 	// ConfirmExternalUserID updates external id of an external user and set verification to empty string
 	ConfirmExternalUserID(ctx context.Context, extUser ExternalUser) error
 
-	// GlobalSearch performs a search on organizations, applications, gateways
-	// and devices
-	GlobalSearch(ctx context.Context, userID int64, globalAdmin bool, search string, limit, offset int) ([]SearchResult, error)
+	// GlobalSearch performs a search on organizations, applications,
+	// devices, gateways, multicast groups, device profiles and users visible
+	// to the user, the best matches first
+	GlobalSearch(ctx context.Context, userID int64, globalAdmin bool, query SearchQuery, limit, offset int) ([]SearchResult, error)
+	// GlobalSearchFacets returns the number of the records of each kind
+	// matching the query
+	GlobalSearchFacets(ctx context.Context, userID int64, globalAdmin bool, query SearchQuery) ([]SearchFacet, error)
 	GetDefaultNetworkServer(ctx context.Context) (nsd.NetworkServer, error)
 	// ShopifyStore defines db apis for shopify service
 	ShopifyStore
@@ -260,7 +260,15 @@ func (a *Server) GlobalSearch(ctx context.Context, req *inpb.GlobalSearchRequest
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	results, err := a.store.GlobalSearch(ctx, cred.UserID, cred.IsGlobalAdmin, req.Search, int(req.Limit), int(req.Offset))
+	query, err := ParseSearchQuery(req.Search)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid search query: %v", err)
+	}
+	results, err := a.store.GlobalSearch(ctx, cred.UserID, cred.IsGlobalAdmin, query, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	facets, err := a.store.GlobalSearchFacets(ctx, cred.UserID, cred.IsGlobalAdmin, query)
 	if err != nil {
 		return nil, helpers.ErrToRPCError(err)
 	}
@@ -301,9 +309,37 @@ func (a *Server) GlobalSearch(ctx context.Context, req *inpb.GlobalSearchRequest
 			res.GatewayName = *r.GatewayName
 		}
 
+		if r.MulticastGroupID != nil {
+			res.MulticastGroupId = *r.MulticastGroupID
+		}
+		if r.MulticastGroupName != nil {
+			res.MulticastGroupName = *r.MulticastGroupName
+		}
+
+		if r.DeviceProfileID != nil {
+			res.DeviceProfileId = *r.DeviceProfileID
+		}
+		if r.DeviceProfileName != nil {
+			res.DeviceProfileName = *r.DeviceProfileName
+		}
+
+		if r.UserID != nil {
+			res.UserId = *r.UserID
+		}
+		if r.Username != nil {
+			res.Username = *r.Username
+		}
+
 		out.Result = append(out.Result, &res)
 	}
 
+	for _, f := range facets {
+		out.Facets = append(out.Facets, &inpb.GlobalSearchFacet{
+			Kind:  f.Kind,
+			Count: f.Count,
+		})
+	}
+
 	return &out, nil
 }
 
@@ -561,7 +597,7 @@ func (a *Server) FinishRegistration(ctx context.Context, req *inpb.FinishRegistr
 
 // ActivateUserAndNewOrganization creates the organization for the new user, adds the user to the org
 //  and activates the user, then create default service profile, default application and default device profile for
-//  the organization
+//  the organization. The user also joins the organizations the user has accepted the invitations to.
 func (a *Server) ActivateUserAndNewOrganization(ctx context.Context, userID int64, ph, organizationName, organizationDisplayName string) error {
 	ou, err := a.store.ActivateUser(ctx, userID, ph, organizationName, organizationDisplayName)
 	if err != nil {
@@ -569,5 +605,10 @@ func (a *Server) ActivateUserAndNewOrganization(ctx context.Context, userID int6
 	}
 
 	organization.ActivateOrganization(ctx, a.orgStore, a.spStore, a.dpStore, ou.OrganizationID, a.nsCli)
+	// the user is registered already, failure to join the organizations
+	// the user has been invited to shouldn't interrupt the registration
+	if err := a.store.JoinInvitedOrganizations(ctx, userID); err != nil {
+		logrus.WithError(err).Errorf("couldn't add user %d to the invited organizations", userID)
+	}
 	return nil
 }
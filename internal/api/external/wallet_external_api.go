@@ -10,13 +10,14 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
 	pb "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
 	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
-	"github.com/mxc-foundation/lpwan-app-server/internal/coingecko"
 	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
-	"github.com/mxc-foundation/lpwan-app-server/internal/modules/wallet"
+	mining "github.com/mxc-foundation/lpwan-app-server/internal/modules/mining/data"
 	"github.com/mxc-foundation/lpwan-app-server/internal/mxpcli"
 	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
 )
@@ -35,21 +36,34 @@ type WalletServerAPI struct {
 }
 
 // NewWalletServerAPI validates the new wallet server api
-func NewWalletServerAPI(h *store.Handler, auth auth.Authenticator, enableSTC bool) *WalletServerAPI {
+func NewWalletServerAPI(h *store.Handler, pricer Pricer, auth auth.Authenticator, enableSTC bool) *WalletServerAPI {
 	return &WalletServerAPI{
-		pricer:    coingecko.New(),
+		pricer:    pricer,
 		st:        h,
 		auth:      auth,
 		enableSTC: enableSTC,
 	}
 }
 
+// checkOrgPermission checks that the user has the permission in the
+// organization
+func (s *WalletServerAPI) checkOrgPermission(ctx context.Context, orgID int64, perm auth.Permission) error {
+	cred, err := s.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(orgID))
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.Has(perm) {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
 // GetWalletBalance gets the wallet balance
 func (s *WalletServerAPI) GetWalletBalance(ctx context.Context, req *api.GetWalletBalanceRequest) (*api.GetWalletBalanceResponse, error) {
 	logInfo := "api/appserver_serves_ui/GetWalletBalance org=" + strconv.FormatInt(req.OrgId, 10)
 
-	if err := wallet.NewValidator().IsOrgAdmin(ctx, req.OrgId); err != nil {
-		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	if err := s.checkOrgPermission(ctx, req.OrgId, auth.PermFinanceRead); err != nil {
+		return nil, err
 	}
 
 	walletClient := mxpcli.Global.GetWalletServiceClient()
@@ -75,7 +89,7 @@ func (s *WalletServerAPI) GetGatewayMiningHealth(ctx context.Context, req *api.G
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
-	if !cred.IsGatewayAdmin {
+	if !cred.Has(auth.PermGatewayWrite) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 	gws, err := s.st.GetOrgGateways(ctx, cred.OrgID, req.GatewayMac)
@@ -127,7 +141,7 @@ func (s *WalletServerAPI) TopUpGatewayMiningFuel(ctx context.Context, req *api.T
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceWrite) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 	mreq := &pb.TopUpGatewayMiningFuelRequest{
@@ -165,7 +179,7 @@ func (s *WalletServerAPI) WithdrawGatewayMiningFuel(ctx context.Context, req *ap
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceWrite) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 	mreq := &pb.WithdrawGatewayMiningFuelRequest{
@@ -219,7 +233,7 @@ func (s *WalletServerAPI) GetGatewayMiningIncome(ctx context.Context, req *api.G
 	}
 
 	if !cred.IsGlobalAdmin {
-		if !cred.IsOrgAdmin {
+		if !cred.Has(auth.PermFinanceRead) {
 			// user is neither global admin nor organization admin, return permission denied
 			return nil, status.Error(codes.PermissionDenied, "permission denied")
 		}
@@ -265,7 +279,7 @@ func (s *WalletServerAPI) GetWalletMiningIncome(ctx context.Context, req *api.Ge
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -292,7 +306,7 @@ func (s *WalletServerAPI) GetMiningInfo(ctx context.Context, req *api.GetMiningI
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceRead) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -325,8 +339,8 @@ func (s *WalletServerAPI) GetMiningInfo(ctx context.Context, req *api.GetMiningI
 func (s *WalletServerAPI) GetVmxcTxHistory(ctx context.Context, req *api.GetVmxcTxHistoryRequest) (*api.GetVmxcTxHistoryResponse, error) {
 	logInfo := "api/appserver_serves_ui/GetVmxcTxHistory org=" + strconv.FormatInt(req.OrgId, 10)
 
-	if err := wallet.NewValidator().IsOrgAdmin(ctx, req.OrgId); err != nil {
-		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	if err := s.checkOrgPermission(ctx, req.OrgId, auth.PermFinanceRead); err != nil {
+		return nil, err
 	}
 
 	walletClient := mxpcli.Global.GetWalletServiceClient()
@@ -364,8 +378,8 @@ func (s *WalletServerAPI) GetVmxcTxHistory(ctx context.Context, req *api.GetVmxc
 func (s *WalletServerAPI) GetNetworkUsageHist(ctx context.Context, req *api.GetNetworkUsageHistRequest) (*api.GetNetworkUsageHistResponse, error) {
 	logInfo := "api/appserver_serves_ui/GetWalletUsageHist org=" + strconv.FormatInt(req.OrgId, 10)
 
-	if err := wallet.NewValidator().IsOrgAdmin(ctx, req.OrgId); err != nil {
-		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	if err := s.checkOrgPermission(ctx, req.OrgId, auth.PermFinanceRead); err != nil {
+		return nil, err
 	}
 
 	walletClient := mxpcli.Global.GetWalletServiceClient()
@@ -408,8 +422,8 @@ func (s *WalletServerAPI) GetNetworkUsageHist(ctx context.Context, req *api.GetN
 func (s *WalletServerAPI) GetDlPrice(ctx context.Context, req *api.GetDownLinkPriceRequest) (*api.GetDownLinkPriceResponse, error) {
 	logInfo := "api/appserver_serves_ui/GetDlPrice org=" + strconv.FormatInt(req.OrgId, 10)
 
-	if err := wallet.NewValidator().IsOrgAdmin(ctx, req.OrgId); err != nil {
-		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	if err := s.checkOrgPermission(ctx, req.OrgId, auth.PermFinanceRead); err != nil {
+		return nil, err
 	}
 
 	walletClient := mxpcli.Global.GetWalletServiceClient()
@@ -430,8 +444,8 @@ func (s *WalletServerAPI) GetDlPrice(ctx context.Context, req *api.GetDownLinkPr
 func (s *WalletServerAPI) GetMXCprice(ctx context.Context, req *api.GetMXCpriceRequest) (*api.GetMXCpriceResponse, error) {
 	logInfo := "api/appserver_serves_ui/GetMXCprice org=" + strconv.FormatInt(req.OrgId, 10)
 
-	if err := wallet.NewValidator().IsOrgAdmin(ctx, req.OrgId); err != nil {
-		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	if err := s.checkOrgPermission(ctx, req.OrgId, auth.PermFinanceRead); err != nil {
+		return nil, err
 	}
 
 	if req.MxcPrice == "" {
@@ -455,8 +469,8 @@ func (s *WalletServerAPI) GetMXCprice(ctx context.Context, req *api.GetMXCpriceR
 // GetTransactionHistory returns the history of transactions of the specified
 // type over the specified period
 func (s *WalletServerAPI) GetTransactionHistory(ctx context.Context, req *api.GetTransactionHistoryRequest) (*api.GetTransactionHistoryResponse, error) {
-	if err := wallet.NewValidator().IsOrgAdmin(ctx, req.OrgId); err != nil {
-		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	if err := s.checkOrgPermission(ctx, req.OrgId, auth.PermFinanceRead); err != nil {
+		return nil, err
 	}
 
 	walletClient := mxpcli.Global.GetWalletServiceClient()
@@ -482,3 +496,56 @@ func (s *WalletServerAPI) GetTransactionHistory(ctx context.Context, req *api.Ge
 	}
 	return resp, nil
 }
+
+// ListMiningSubmissions returns the mining periods submitted to m2m together
+// with their delivery state
+func (s *WalletServerAPI) ListMiningSubmissions(ctx context.Context, req *api.ListMiningSubmissionsRequest) (*api.ListMiningSubmissionsResponse, error) {
+	cred, err := s.auth.GetCredentials(ctx, auth.NewOptions())
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.IsGlobalAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	switch req.Status {
+	case "", mining.SubmissionPending, mining.SubmissionSent, mining.SubmissionFailed:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status: %s", req.Status)
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	count, err := s.st.GetMiningSubmissionCount(ctx, req.Status)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	submissions, err := s.st.GetMiningSubmissions(ctx, req.Status, limit, req.Offset)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := &api.ListMiningSubmissionsResponse{TotalCount: count}
+	for _, sub := range submissions {
+		item := &api.MiningSubmission{
+			IdempotencyKey: sub.IdempotencyKey,
+			PeriodStart:    timestamppb.New(sub.PeriodStart),
+			PeriodSeconds:  sub.PeriodSeconds,
+			GatewayCount:   int64(len(sub.Gateways)),
+			Status:         sub.Status,
+			Attempts:       int64(sub.Attempts),
+			LastError:      sub.LastError,
+		}
+		if sub.Status == mining.SubmissionPending {
+			item.NextAttemptAt = timestamppb.New(sub.NextAttemptAt)
+		}
+		if sub.SentAt != nil {
+			item.SentAt = timestamppb.New(*sub.SentAt)
+		}
+		resp.Submissions = append(resp.Submissions, item)
+	}
+
+	return resp, nil
+}
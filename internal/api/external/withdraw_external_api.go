@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/mxc-foundation/lpwan-app-server/internal/audit"
 	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
 
 	"github.com/mxc-foundation/lpwan-app-server/internal/mxpcli"
@@ -30,6 +31,12 @@ func NewWithdrawServerAPI(auth auth.Authenticator) *WithdrawServerAPI {
 	}
 }
 
+// withdrawFeeState is the withdraw fee recorded in the audit log
+type withdrawFeeState struct {
+	Currency    string `json:"currency"`
+	WithdrawFee string `json:"withdraw_fee"`
+}
+
 // ModifyWithdrawFee modifies the withdraw fee
 func (s *WithdrawServerAPI) ModifyWithdrawFee(ctx context.Context, req *api.ModifyWithdrawFeeRequest) (*api.ModifyWithdrawFeeResponse, error) {
 	logInfo := "api/appserver_serves_ui/ModifyWithdrawFee"
@@ -40,6 +47,15 @@ func (s *WithdrawServerAPI) ModifyWithdrawFee(ctx context.Context, req *api.Modi
 
 	withdrawClient := mxpcli.Global.GetWithdrawServiceClient()
 
+	// the previous fee is only needed for the audit log, failure to get it
+	// doesn't prevent the modification
+	var before *withdrawFeeState
+	if fee, err := withdrawClient.GetWithdrawFee(ctx, &pb.GetWithdrawFeeRequest{Currency: req.Currency}); err != nil {
+		log.WithError(err).Warn(logInfo + ": couldn't get current withdraw fee")
+	} else {
+		before = &withdrawFeeState{Currency: fee.Currency, WithdrawFee: fee.WithdrawFee}
+	}
+
 	resp, err := withdrawClient.ModifyWithdrawFee(ctx, &pb.ModifyWithdrawFeeRequest{
 		Currency:    req.Currency,
 		WithdrawFee: req.WithdrawFee,
@@ -49,6 +65,8 @@ func (s *WithdrawServerAPI) ModifyWithdrawFee(ctx context.Context, req *api.Modi
 		log.WithError(err).Error(logInfo)
 		return &api.ModifyWithdrawFeeResponse{}, status.Errorf(codes.Unavailable, err.Error())
 	}
+	audit.Describe(ctx, 0, "withdraw-fee:"+req.Currency, before,
+		withdrawFeeState{Currency: req.Currency, WithdrawFee: req.WithdrawFee})
 
 	return &api.ModifyWithdrawFeeResponse{
 		Status: resp.Status,
@@ -78,8 +96,12 @@ func (s *WithdrawServerAPI) GetWithdrawFee(ctx context.Context, req *api.GetWith
 func (s *WithdrawServerAPI) GetWithdrawHistory(ctx context.Context, req *api.GetWithdrawHistoryRequest) (*api.GetWithdrawHistoryResponse, error) {
 	logInfo := "api/appserver_serves_ui/GetWithdrawHistory org=" + strconv.FormatInt(req.OrgId, 10)
 
-	if err := withdraw.NewValidator().IsOrgAdmin(ctx, req.OrgId); err != nil {
-		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	cred, err := s.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(req.OrgId))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.Has(auth.PermFinanceRead) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
 	withdrawClient := mxpcli.Global.GetWithdrawServiceClient()
@@ -125,7 +147,7 @@ func (s *WithdrawServerAPI) GetWithdraw(ctx context.Context, req *api.GetWithdra
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication failed:%v", err)
 	}
-	if !cred.IsOrgAdmin {
+	if !cred.Has(auth.PermFinanceWrite) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -31,6 +31,8 @@ var errToCode = map[error]codes.Code{
 	errHandler.ErrFUOTADeploymentNullPayload:      codes.InvalidArgument,
 	errHandler.ErrInvalidHeaderName:               codes.InvalidArgument,
 	errHandler.ErrInvalidPrecision:                codes.InvalidArgument,
+	errHandler.ErrInvalidInfluxDBVersion:          codes.InvalidArgument,
+	errHandler.ErrInfluxDBV2Settings:              codes.InvalidArgument,
 }
 
 // ErrToRPCError converts the given error into a gRPC error.
@@ -4,28 +4,36 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/cmdserver"
 
 	"github.com/gofrs/uuid"
 	"github.com/sirupsen/logrus"
 
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/external"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/report"
 	"github.com/mxc-foundation/lpwan-app-server/internal/bonus"
 	"github.com/mxc-foundation/lpwan-app-server/internal/config"
+	"github.com/mxc-foundation/lpwan-app-server/internal/devicedata"
 	"github.com/mxc-foundation/lpwan-app-server/internal/devprovision"
 	"github.com/mxc-foundation/lpwan-app-server/internal/downlink"
 	"github.com/mxc-foundation/lpwan-app-server/internal/email"
 	"github.com/mxc-foundation/lpwan-app-server/internal/integration"
 	"github.com/mxc-foundation/lpwan-app-server/internal/integration/models"
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
 	"github.com/mxc-foundation/lpwan-app-server/internal/migrations/code"
 	"github.com/mxc-foundation/lpwan-app-server/internal/modules/as"
 	"github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway"
+	rs "github.com/mxc-foundation/lpwan-app-server/internal/modules/redis"
 	"github.com/mxc-foundation/lpwan-app-server/internal/modules/serverinfo"
 	"github.com/mxc-foundation/lpwan-app-server/internal/mxpapisrv"
 	"github.com/mxc-foundation/lpwan-app-server/internal/mxpcli"
 	"github.com/mxc-foundation/lpwan-app-server/internal/nscli"
+	"github.com/mxc-foundation/lpwan-app-server/internal/price"
 	"github.com/mxc-foundation/lpwan-app-server/internal/pscli"
+	"github.com/mxc-foundation/lpwan-app-server/internal/retention"
 	"github.com/mxc-foundation/lpwan-app-server/internal/shopify"
+	"github.com/mxc-foundation/lpwan-app-server/internal/statement"
 	"github.com/mxc-foundation/lpwan-app-server/internal/storage/pgstore"
 	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
 	mgr "github.com/mxc-foundation/lpwan-app-server/internal/system_manager"
@@ -65,6 +73,18 @@ type App struct {
 	applicationServerID uuid.UUID
 	// device provisioning session list
 	devSessionList *devprovision.DeviceSessionList
+	// elects the replica running the background jobs
+	elector *leader.Elector
+	// sends periodic statements to the subscribers
+	statement *statement.Service
+	// provides prices of crypto currencies and records the close prices
+	pricer *price.Service
+	// removes the expired device events stored by the postgresql integration
+	deviceData *devicedata.Service
+	// sends the emails queued by the mailer
+	outbox *email.Outbox
+	// removes the data older than the retention policies of the organizations
+	retention *retention.Service
 }
 
 // Start starts all the routines required for appserver and returns the App
@@ -86,11 +106,31 @@ func Start(ctx context.Context, cfg config.Config) (*App, error) {
 		_ = app.Close()
 		return nil, err
 	}
+	if err := app.leaderElection(ctx, cfg); err != nil {
+		// we already have an error
+		_ = app.Close()
+		return nil, err
+	}
 	if err := app.initInParallel(ctx, cfg); err != nil {
 		// we already have an error
 		_ = app.Close()
 		return nil, err
 	}
+	if app.pricer, err = price.New(cfg.ApplicationServer.Price, app.pgstore); err != nil {
+		_ = app.Close()
+		return nil, err
+	}
+	app.pricer.Start()
+	// the outbox must be started before the APIs using the mailer
+	app.outbox = email.StartOutbox(cfg.ApplicationServer.EmailOutbox, app.pgstore, app.mailer)
+	// periodic statements are sent using the mailer
+	app.statement = statement.Start(cfg.ApplicationServer.Statement, app.pgstore,
+		report.NewServer(app.mxpCli.GetFianceReportClient(), app.mxpCli.GetDHXServiceClient(),
+			app.mxpCli.GetWalletServiceClient(), app.pricer, nil, cfg.General.ServerAddr),
+		app.mxpCli.GetStakingServiceClient(), app.mailer)
+	app.deviceData = devicedata.Start(cfg.ApplicationServer.DeviceData,
+		integration.DeviceDataStore(app.integrations))
+	app.retention = retention.Start(app.pgstore, integration.DeviceDataStore(app.integrations))
 	if err := app.startAPIs(ctx, cfg); err != nil {
 		// we already have an error
 		_ = app.Close()
@@ -142,11 +182,30 @@ func (app *App) Close() error {
 	if app.shopify != nil {
 		app.shopify.Stop()
 	}
+	if app.statement != nil {
+		app.statement.Stop()
+	}
+	if app.pricer != nil {
+		app.pricer.Stop()
+	}
+	if app.deviceData != nil {
+		app.deviceData.Stop()
+	}
+	if app.retention != nil {
+		app.retention.Stop()
+	}
+	if app.outbox != nil {
+		app.outbox.Stop()
+	}
+	if app.elector != nil {
+		app.elector.Stop()
+	}
 	for _, v := range app.integrations {
 		if err := v.Close(); err != nil {
 			logrus.Warnf("error shutting down integrations: %v", err)
 		}
 	}
+	integration.FlushApplicationIntegrations()
 	return nil
 }
 
@@ -227,6 +286,28 @@ func (app *App) systemManager(ctx context.Context, cfg config.Config) error {
 	return nil
 }
 
+// start the election of the replica running the background jobs, requires
+// redis client to be set up by the system manager
+func (app *App) leaderElection(ctx context.Context, cfg config.Config) error {
+	var locker leader.Locker
+	switch cfg.ApplicationServer.LeaderElection.Backend {
+	case leader.BackendRedis:
+		l, err := rs.NewLocker(rs.RedisClient())
+		if err != nil {
+			return err
+		}
+		locker = l
+	case leader.BackendPostgres:
+		locker = app.pgstore.NewAdvisoryLocker()
+	case "":
+		logrus.Warn("leader election is not configured, all the background jobs run in this replica")
+	default:
+		return fmt.Errorf("unknown leader election backend: %s", cfg.ApplicationServer.LeaderElection.Backend)
+	}
+	app.elector = leader.Start(cfg.ApplicationServer.LeaderElection, locker)
+	return nil
+}
+
 // services that can be initialized in parallel, put here the ones that don't
 // depend on other services
 func (app *App) initInParallel(ctx context.Context, cfg config.Config) error {
@@ -245,7 +326,16 @@ func (app *App) initInParallel(ctx context.Context, cfg config.Config) error {
 // the gRPC servers
 func (app *App) startAPIs(ctx context.Context, cfg config.Config) error {
 	var err error
-	app.devSessionList, err = devprovision.Start(app.psCli, app.nsCli)
+	var sessionStore devprovision.SessionStore
+	switch cfg.ApplicationServer.DeviceProvisioning.SessionStore {
+	case devprovision.SessionStoreRedis:
+		sessionStore = rs.NewDevProvisionSessionStore(rs.RedisClient())
+	case devprovision.SessionStoreMemory, "":
+	default:
+		return fmt.Errorf("unknown device provisioning session store: %s",
+			cfg.ApplicationServer.DeviceProvisioning.SessionStore)
+	}
+	app.devSessionList, err = devprovision.Start(cfg.ApplicationServer.DeviceProvisioning, sessionStore)
 	if err != nil {
 		return err
 	}
@@ -265,6 +355,7 @@ func (app *App) startAPIs(ctx context.Context, cfg config.Config) error {
 		ServerAddr:             cfg.General.ServerAddr,
 		BindNewGateway:         cfg.ApplicationServer.APIForGateway.NewGateway.Bind,
 		BindOldGateway:         cfg.ApplicationServer.APIForGateway.OldGateway.Bind,
+		FirmwareRollout:        cfg.ApplicationServer.APIForGateway.FirmwareRollout,
 		Recaptcha:              cfg.Recaptcha,
 		Enable2FA:              cfg.General.Enable2FALogin,
 		ServerRegion:           cfg.General.ServerRegion,
@@ -273,7 +364,11 @@ func (app *App) startAPIs(ctx context.Context, cfg config.Config) error {
 		ExternalAuth:           cfg.ExternalAuth,
 		ShopifyConfig:          cfg.ShopifyConfig,
 		OperatorLogo:           cfg.Operator.OperatorLogo,
+		Bonus:                  app.bonus,
 		Mailer:                 app.mailer,
+		Pricer:                 app.pricer,
+		ClosePricer:            app.pricer,
+		DeviceDataStore:        integration.DeviceDataStore(app.integrations),
 		MXPCli:                 app.mxpCli,
 		PSCli:                  app.psCli,
 		NSCli:                  app.nsCli,
@@ -0,0 +1,749 @@
+package apply
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/brocaar/chirpstack-api/go/v3/ns"
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq/hstore"
+
+	dps "github.com/mxc-foundation/lpwan-app-server/internal/api/external/dp"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/gp"
+	nsd "github.com/mxc-foundation/lpwan-app-server/internal/api/external/ns"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	appd "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
+	gwd "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+	spmod "github.com/mxc-foundation/lpwan-app-server/internal/modules/service-profile"
+	spd "github.com/mxc-foundation/lpwan-app-server/internal/modules/service-profile/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/nscli"
+)
+
+// Action is what is done to the resource
+type Action string
+
+// Actions
+const (
+	Create    Action = "create"
+	Update    Action = "update"
+	Unchanged Action = "unchanged"
+)
+
+// Change is the change of the resource that is or would be made
+type Change struct {
+	Action Action
+	// Kind is the kind of the resource, e.g. "organization"
+	Kind string
+	// Name identifies the resource, the resources of the organization are
+	// prefixed by the name of the organization
+	Name string
+	// Fields are the settings that are updated
+	Fields []string
+}
+
+// String returns the change as the line of the plan
+func (c Change) String() string {
+	switch c.Action {
+	case Create:
+		return fmt.Sprintf("+ %s %s", c.Kind, c.Name)
+	case Update:
+		return fmt.Sprintf("~ %s %s (%s)", c.Kind, c.Name, strings.Join(c.Fields, ", "))
+	default:
+		return fmt.Sprintf("= %s %s", c.Kind, c.Name)
+	}
+}
+
+// Store provides access to the resources of the manifest
+type Store interface {
+	nsd.Store
+	gp.Store
+	organization.Store
+	spmod.Store
+	dps.Store
+
+	GetDefaultGatewayConfig(ctx context.Context, cfg *gwd.DefaultGatewayConfig) error
+	AddNewDefaultGatewayConfig(ctx context.Context, cfg *gwd.DefaultGatewayConfig) error
+	UpdateDefaultGatewayConfig(ctx context.Context, cfg *gwd.DefaultGatewayConfig) error
+
+	GetOrganizationByName(ctx context.Context, name string) (organization.Organization, error)
+	CreateOrganization(ctx context.Context, org *organization.Organization) error
+	UpdateOrganization(ctx context.Context, org *organization.Organization) error
+
+	GetServiceProfileIDByName(ctx context.Context, orgID int64, name string) (uuid.UUID, error)
+	GetDeviceProfileIDByName(ctx context.Context, orgID int64, name string) (uuid.UUID, error)
+	GetDeviceProfile(ctx context.Context, id uuid.UUID, forUpdate bool) (dps.DeviceProfile, error)
+
+	GetApplicationByName(ctx context.Context, orgID int64, name string) (appd.Application, error)
+	UpdateApplication(ctx context.Context, app appd.Application) error
+
+	GetIntegrationByApplicationID(ctx context.Context, applicationID int64, kind string) (appd.Integration, error)
+	CreateIntegration(ctx context.Context, i *appd.Integration) error
+	UpdateIntegration(ctx context.Context, i *appd.Integration) error
+}
+
+// Applier makes the supernode match the manifest
+type Applier struct {
+	st                          Store
+	nsCli                       *nscli.Client
+	applicationServerID         uuid.UUID
+	applicationServerPublicHost string
+}
+
+// New returns the new Applier. The nsCli must be connected to all the
+// network-servers in the database.
+func New(st Store, nsCli *nscli.Client, applicationServerID uuid.UUID,
+	applicationServerPublicHost string) *Applier {
+	return &Applier{
+		st:                          st,
+		nsCli:                       nsCli,
+		applicationServerID:         applicationServerID,
+		applicationServerPublicHost: applicationServerPublicHost,
+	}
+}
+
+// run is the state of the single application of the manifest
+type run struct {
+	*Applier
+	dryRun  bool
+	changes []Change
+	// networkServers are the network-servers in the database and the ones
+	// created by the manifest by name. The network-servers that would be
+	// created by the dry run have ID 0.
+	networkServers map[string]nsd.NetworkServer
+}
+
+// Apply creates the resources of the manifest that don't exist yet and
+// updates the ones whose settings differ from the manifest. It returns the
+// list of the changes, if dryRun is true the changes are only computed but
+// not made. As the resources are matched by name, applying the same
+// manifest again doesn't change anything, so Apply doesn't use the
+// transaction and can simply be run again if it fails in the middle.
+func (a *Applier) Apply(ctx context.Context, m *Manifest, dryRun bool) ([]Change, error) {
+	r := &run{
+		Applier:        a,
+		dryRun:         dryRun,
+		networkServers: make(map[string]nsd.NetworkServer),
+	}
+	if err := r.applyNetworkServers(ctx, m.NetworkServers); err != nil {
+		return r.changes, err
+	}
+	for _, c := range m.DefaultGatewayConfigs {
+		if err := r.applyDefaultGatewayConfig(ctx, c); err != nil {
+			return r.changes, fmt.Errorf("default gateway config %s/%s: %w", c.Model, c.Region, err)
+		}
+	}
+	for _, o := range m.Organizations {
+		if err := r.applyOrganization(ctx, o); err != nil {
+			return r.changes, fmt.Errorf("organization %s: %w", o.Name, err)
+		}
+	}
+	return r.changes, nil
+}
+
+func (r *run) record(action Action, kind, name string, fields []string) {
+	r.changes = append(r.changes, Change{Action: action, Kind: kind, Name: name, Fields: fields})
+}
+
+// plan records the change of the resource with the given settings and
+// returns true if the resource must be updated
+func (r *run) plan(kind, name string, want, have interface{}, extra ...string) bool {
+	fields := append(diffFields(want, have), extra...)
+	if len(fields) == 0 {
+		r.record(Unchanged, kind, name, nil)
+		return false
+	}
+	r.record(Update, kind, name, fields)
+	return !r.dryRun
+}
+
+func (r *run) applyNetworkServers(ctx context.Context, nss []NetworkServer) error {
+	existing, err := r.st.GetNetworkServers(ctx, nsd.NetworkServerFilters{Limit: 999})
+	if err != nil {
+		return fmt.Errorf("couldn't get network servers: %w", err)
+	}
+	for _, n := range existing {
+		r.networkServers[n.Name] = n
+	}
+
+	for _, mn := range nss {
+		n, ok := r.networkServers[mn.Name]
+		if !ok {
+			r.record(Create, "network-server", mn.Name, nil)
+			n = nsd.NetworkServer{Name: mn.Name}
+			setNetworkServerSettings(&n, mn.NetworkServerSettings)
+			if err := n.Validate(); err != nil {
+				return fmt.Errorf("network server %s: %w", mn.Name, err)
+			}
+			if !r.dryRun {
+				if err := nsd.CreateNetworkServer(ctx, &n, r.st, r.st, r.nsCli,
+					r.applicationServerID, r.applicationServerPublicHost); err != nil {
+					return fmt.Errorf("couldn't create network server %s: %w", mn.Name, err)
+				}
+			}
+			r.networkServers[mn.Name] = n
+			continue
+		}
+
+		// the settings are validated before planning, so the dry run
+		// reports the same errors as the real run
+		updated := n
+		setNetworkServerSettings(&updated, mn.NetworkServerSettings)
+		if err := updated.Validate(); err != nil {
+			return fmt.Errorf("network server %s: %w", mn.Name, err)
+		}
+		if r.plan("network-server", mn.Name, mn.NetworkServerSettings, networkServerSettings(n)) {
+			if err := nsd.UpdateNetworkServer(ctx, &updated, r.st, r.nsCli,
+				r.applicationServerID, r.applicationServerPublicHost); err != nil {
+				return fmt.Errorf("couldn't update network server %s: %w", mn.Name, err)
+			}
+			r.networkServers[mn.Name] = updated
+		}
+	}
+	return nil
+}
+
+func networkServerSettings(n nsd.NetworkServer) NetworkServerSettings {
+	return NetworkServerSettings{
+		Server:                      n.Server,
+		CACert:                      n.CACert,
+		TLSCert:                     n.TLSCert,
+		TLSKey:                      n.TLSKey,
+		RoutingProfileCACert:        n.RoutingProfileCACert,
+		RoutingProfileTLSCert:       n.RoutingProfileTLSCert,
+		RoutingProfileTLSKey:        n.RoutingProfileTLSKey,
+		GatewayDiscoveryEnabled:     n.GatewayDiscoveryEnabled,
+		GatewayDiscoveryInterval:    n.GatewayDiscoveryInterval,
+		GatewayDiscoveryTXFrequency: n.GatewayDiscoveryTXFrequency,
+		GatewayDiscoveryDR:          n.GatewayDiscoveryDR,
+	}
+}
+
+func setNetworkServerSettings(n *nsd.NetworkServer, s NetworkServerSettings) {
+	n.Server = s.Server
+	n.CACert = s.CACert
+	n.TLSCert = s.TLSCert
+	n.TLSKey = s.TLSKey
+	n.RoutingProfileCACert = s.RoutingProfileCACert
+	n.RoutingProfileTLSCert = s.RoutingProfileTLSCert
+	n.RoutingProfileTLSKey = s.RoutingProfileTLSKey
+	n.GatewayDiscoveryEnabled = s.GatewayDiscoveryEnabled
+	n.GatewayDiscoveryInterval = s.GatewayDiscoveryInterval
+	n.GatewayDiscoveryTXFrequency = s.GatewayDiscoveryTXFrequency
+	n.GatewayDiscoveryDR = s.GatewayDiscoveryDR
+}
+
+func (r *run) applyDefaultGatewayConfig(ctx context.Context, c DefaultGatewayConfig) error {
+	name := c.Model + "/" + c.Region
+	cfg := gwd.DefaultGatewayConfig{Model: c.Model, Region: c.Region}
+	err := r.st.GetDefaultGatewayConfig(ctx, &cfg)
+	if errors.Is(err, errHandler.ErrDoesNotExist) {
+		r.record(Create, "default-gateway-config", name, nil)
+		if r.dryRun {
+			return nil
+		}
+		cfg.DefaultConfig = c.Config
+		return r.st.AddNewDefaultGatewayConfig(ctx, &cfg)
+	}
+	if err != nil {
+		return err
+	}
+
+	if cfg.DefaultConfig == c.Config {
+		r.record(Unchanged, "default-gateway-config", name, nil)
+		return nil
+	}
+	r.record(Update, "default-gateway-config", name, []string{"config"})
+	if r.dryRun {
+		return nil
+	}
+	cfg.DefaultConfig = c.Config
+	return r.st.UpdateDefaultGatewayConfig(ctx, &cfg)
+}
+
+func (r *run) applyOrganization(ctx context.Context, mo Organization) error {
+	org, err := r.st.GetOrganizationByName(ctx, mo.Name)
+	switch {
+	case errors.Is(err, errHandler.ErrDoesNotExist):
+		r.record(Create, "organization", mo.Name, nil)
+		org = organization.Organization{Name: mo.Name}
+		setOrganizationSettings(&org, mo.OrganizationSettings)
+		if !r.dryRun {
+			if err := r.st.CreateOrganization(ctx, &org); err != nil {
+				return fmt.Errorf("couldn't create organization: %w", err)
+			}
+			// same as the organization created through the API
+			organization.ActivateOrganization(ctx, r.st, r.st, r.st, org.ID, r.nsCli)
+		}
+	case err != nil:
+		return fmt.Errorf("couldn't get organization: %w", err)
+	default:
+		have := OrganizationSettings{
+			DisplayName:     org.DisplayName,
+			CanHaveGateways: org.CanHaveGateways,
+			MaxDeviceCount:  org.MaxDeviceCount,
+			MaxGatewayCount: org.MaxGatewayCount,
+		}
+		if r.plan("organization", mo.Name, mo.OrganizationSettings, have) {
+			setOrganizationSettings(&org, mo.OrganizationSettings)
+			if err := r.st.UpdateOrganization(ctx, &org); err != nil {
+				return fmt.Errorf("couldn't update organization: %w", err)
+			}
+		}
+	}
+
+	// service-profile names of the manifest, uuid.Nil for the ones that
+	// would be created by the dry run
+	sps := make(map[string]uuid.UUID)
+	for _, sp := range mo.ServiceProfiles {
+		id, err := r.applyServiceProfile(ctx, org, sp)
+		if err != nil {
+			return fmt.Errorf("service profile %s: %w", sp.Name, err)
+		}
+		sps[sp.Name] = id
+	}
+	for _, dp := range mo.DeviceProfiles {
+		if err := r.applyDeviceProfile(ctx, org, dp); err != nil {
+			return fmt.Errorf("device profile %s: %w", dp.Name, err)
+		}
+	}
+	for _, app := range mo.Applications {
+		if err := r.applyApplication(ctx, org, sps, app); err != nil {
+			return fmt.Errorf("application %s: %w", app.Name, err)
+		}
+	}
+	return nil
+}
+
+func setOrganizationSettings(org *organization.Organization, s OrganizationSettings) {
+	org.DisplayName = s.DisplayName
+	org.CanHaveGateways = s.CanHaveGateways
+	org.MaxDeviceCount = s.MaxDeviceCount
+	org.MaxGatewayCount = s.MaxGatewayCount
+}
+
+// networkServer returns the network-server with the given name
+func (r *run) networkServer(name string) (nsd.NetworkServer, error) {
+	n, ok := r.networkServers[name]
+	if !ok {
+		return n, fmt.Errorf("unknown network server %s", name)
+	}
+	return n, nil
+}
+
+// checkNetworkServer returns an error if the profile exists at another
+// network-server than the one in the manifest
+func checkNetworkServer(n nsd.NetworkServer, networkServerID int64) error {
+	if n.ID != networkServerID {
+		return fmt.Errorf("profile exists at another network server, it can't be moved to %s", n.Name)
+	}
+	return nil
+}
+
+func (r *run) applyServiceProfile(ctx context.Context, org organization.Organization, msp ServiceProfile) (uuid.UUID, error) {
+	name := org.Name + "/" + msp.Name
+	n, err := r.networkServer(msp.NetworkServer)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := r.lookupServiceProfile(ctx, org.ID, msp.Name)
+	if errors.Is(err, errHandler.ErrDoesNotExist) {
+		r.record(Create, "service-profile", name, nil)
+		if r.dryRun {
+			return uuid.Nil, nil
+		}
+		sp := spd.ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            msp.Name,
+		}
+		setServiceProfileSettings(&sp.ServiceProfile, msp.ServiceProfileSettings)
+		newID, err := spmod.CreateServiceProfile(ctx, r.st, &sp, r.nsCli)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("couldn't create service profile: %w", err)
+		}
+		return *newID, nil
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	sp, err := spmod.GetServiceProfile(ctx, r.st, id, r.nsCli, false)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("couldn't get service profile: %w", err)
+	}
+	if err := checkNetworkServer(n, sp.NetworkServerID); err != nil {
+		return uuid.Nil, err
+	}
+	if r.plan("service-profile", name, msp.ServiceProfileSettings, serviceProfileSettings(sp.ServiceProfile)) {
+		setServiceProfileSettings(&sp.ServiceProfile, msp.ServiceProfileSettings)
+		if err := spmod.UpdateServiceProfile(ctx, r.st, r.nsCli, sp); err != nil {
+			return uuid.Nil, fmt.Errorf("couldn't update service profile: %w", err)
+		}
+	}
+	return id, nil
+}
+
+// lookupServiceProfile returns the ID of the service-profile of the
+// organization, the organization that would be created by the dry run has
+// no service-profiles
+func (r *run) lookupServiceProfile(ctx context.Context, orgID int64, name string) (uuid.UUID, error) {
+	if orgID == 0 {
+		return uuid.Nil, errHandler.ErrDoesNotExist
+	}
+	return r.st.GetServiceProfileIDByName(ctx, orgID, name)
+}
+
+func serviceProfileSettings(sp ns.ServiceProfile) ServiceProfileSettings {
+	return ServiceProfileSettings{
+		ULRate:                 sp.UlRate,
+		ULBucketSize:           sp.UlBucketSize,
+		DLRate:                 sp.DlRate,
+		DLBucketSize:           sp.DlBucketSize,
+		AddGWMetadata:          sp.AddGwMetadata,
+		DevStatusReqFreq:       sp.DevStatusReqFreq,
+		ReportDevStatusBattery: sp.ReportDevStatusBattery,
+		ReportDevStatusMargin:  sp.ReportDevStatusMargin,
+		DRMin:                  sp.DrMin,
+		DRMax:                  sp.DrMax,
+		PRAllowed:              sp.PrAllowed,
+		HRAllowed:              sp.HrAllowed,
+		RAAllowed:              sp.RaAllowed,
+		NwkGeoLoc:              sp.NwkGeoLoc,
+		TargetPER:              sp.TargetPer,
+		MinGWDiversity:         sp.MinGwDiversity,
+	}
+}
+
+func setServiceProfileSettings(sp *ns.ServiceProfile, s ServiceProfileSettings) {
+	sp.UlRate = s.ULRate
+	sp.UlBucketSize = s.ULBucketSize
+	sp.DlRate = s.DLRate
+	sp.DlBucketSize = s.DLBucketSize
+	sp.AddGwMetadata = s.AddGWMetadata
+	sp.DevStatusReqFreq = s.DevStatusReqFreq
+	sp.ReportDevStatusBattery = s.ReportDevStatusBattery
+	sp.ReportDevStatusMargin = s.ReportDevStatusMargin
+	sp.DrMin = s.DRMin
+	sp.DrMax = s.DRMax
+	sp.PrAllowed = s.PRAllowed
+	sp.HrAllowed = s.HRAllowed
+	sp.RaAllowed = s.RAAllowed
+	sp.NwkGeoLoc = s.NwkGeoLoc
+	sp.TargetPer = s.TargetPER
+	sp.MinGwDiversity = s.MinGWDiversity
+}
+
+func (r *run) applyDeviceProfile(ctx context.Context, org organization.Organization, mdp DeviceProfile) error {
+	name := org.Name + "/" + mdp.Name
+	n, err := r.networkServer(mdp.NetworkServer)
+	if err != nil {
+		return err
+	}
+
+	var id uuid.UUID
+	if org.ID == 0 {
+		err = errHandler.ErrDoesNotExist
+	} else {
+		id, err = r.st.GetDeviceProfileIDByName(ctx, org.ID, mdp.Name)
+	}
+	if errors.Is(err, errHandler.ErrDoesNotExist) {
+		r.record(Create, "device-profile", name, nil)
+		if r.dryRun {
+			return nil
+		}
+		dp := dps.DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            mdp.Name,
+		}
+		setDeviceProfileSettings(&dp, mdp.DeviceProfileSettings)
+		if err := dps.CreateDeviceProfile(ctx, r.st, r.nsCli, &dp); err != nil {
+			return fmt.Errorf("couldn't create device profile: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	dp, err := r.st.GetDeviceProfile(ctx, id, false)
+	if err != nil {
+		return fmt.Errorf("couldn't get device profile: %w", err)
+	}
+	if err := checkNetworkServer(n, dp.NetworkServerID); err != nil {
+		return err
+	}
+	nsClient, err := r.nsCli.GetNetworkServerServiceClient(dp.NetworkServerID)
+	if err != nil {
+		return err
+	}
+	resp, err := nsClient.GetDeviceProfile(ctx, &ns.GetDeviceProfileRequest{Id: id.Bytes()})
+	if err != nil {
+		return fmt.Errorf("couldn't get device profile from network server: %w", err)
+	}
+	if resp.DeviceProfile == nil {
+		return errors.New("device_profile must not be nil")
+	}
+	dp.DeviceProfile = *resp.DeviceProfile
+
+	if r.plan("device-profile", name, mdp.DeviceProfileSettings, deviceProfileSettings(dp)) {
+		setDeviceProfileSettings(&dp, mdp.DeviceProfileSettings)
+		if err := dps.UpdateDeviceProfile(ctx, r.st, r.nsCli, &dp); err != nil {
+			return fmt.Errorf("couldn't update device profile: %w", err)
+		}
+	}
+	return nil
+}
+
+func deviceProfileSettings(dp dps.DeviceProfile) DeviceProfileSettings {
+	d := dp.DeviceProfile
+	return DeviceProfileSettings{
+		MACVersion:           d.MacVersion,
+		RegParamsRevision:    d.RegParamsRevision,
+		SupportsJoin:         d.SupportsJoin,
+		SupportsClassB:       d.SupportsClassB,
+		ClassBTimeout:        d.ClassBTimeout,
+		PingSlotPeriod:       d.PingSlotPeriod,
+		PingSlotDR:           d.PingSlotDr,
+		PingSlotFreq:         d.PingSlotFreq,
+		SupportsClassC:       d.SupportsClassC,
+		ClassCTimeout:        d.ClassCTimeout,
+		RXDelay1:             d.RxDelay_1,
+		RXDROffset1:          d.RxDrOffset_1,
+		RXDatarate2:          d.RxDatarate_2,
+		RXFreq2:              d.RxFreq_2,
+		FactoryPresetFreqs:   d.FactoryPresetFreqs,
+		MaxEIRP:              d.MaxEirp,
+		MaxDutyCycle:         d.MaxDutyCycle,
+		RFRegion:             d.RfRegion,
+		Supports32BitFCnt:    d.Supports_32BitFCnt,
+		PayloadCodec:         dp.PayloadCodec,
+		PayloadEncoderScript: dp.PayloadEncoderScript,
+		PayloadDecoderScript: dp.PayloadDecoderScript,
+		UplinkInterval:       Duration(dp.UplinkInterval),
+		Tags:                 hstoreToMap(dp.Tags),
+	}
+}
+
+func setDeviceProfileSettings(dp *dps.DeviceProfile, s DeviceProfileSettings) {
+	d := &dp.DeviceProfile
+	d.MacVersion = s.MACVersion
+	d.RegParamsRevision = s.RegParamsRevision
+	d.SupportsJoin = s.SupportsJoin
+	d.SupportsClassB = s.SupportsClassB
+	d.ClassBTimeout = s.ClassBTimeout
+	d.PingSlotPeriod = s.PingSlotPeriod
+	d.PingSlotDr = s.PingSlotDR
+	d.PingSlotFreq = s.PingSlotFreq
+	d.SupportsClassC = s.SupportsClassC
+	d.ClassCTimeout = s.ClassCTimeout
+	d.RxDelay_1 = s.RXDelay1
+	d.RxDrOffset_1 = s.RXDROffset1
+	d.RxDatarate_2 = s.RXDatarate2
+	d.RxFreq_2 = s.RXFreq2
+	d.FactoryPresetFreqs = s.FactoryPresetFreqs
+	d.MaxEirp = s.MaxEIRP
+	d.MaxDutyCycle = s.MaxDutyCycle
+	d.RfRegion = s.RFRegion
+	d.Supports_32BitFCnt = s.Supports32BitFCnt
+	dp.PayloadCodec = s.PayloadCodec
+	dp.PayloadEncoderScript = s.PayloadEncoderScript
+	dp.PayloadDecoderScript = s.PayloadDecoderScript
+	dp.UplinkInterval = time.Duration(s.UplinkInterval)
+	dp.Tags = mapToHstore(s.Tags)
+}
+
+func (r *run) applyApplication(ctx context.Context, org organization.Organization, sps map[string]uuid.UUID,
+	mapp Application) error {
+	name := org.Name + "/" + mapp.Name
+	spID, ok := sps[mapp.ServiceProfile]
+	if !ok {
+		// the service-profile that is not in the manifest, e.g. the default
+		// one created for the organization
+		var err error
+		if spID, err = r.lookupServiceProfile(ctx, org.ID, mapp.ServiceProfile); err != nil {
+			switch {
+			case errors.Is(err, errHandler.ErrDoesNotExist) && org.ID == 0:
+				// the organization would be created by the dry run, only
+				// the default service-profile can be used then
+			case errors.Is(err, errHandler.ErrDoesNotExist):
+				return fmt.Errorf("unknown service profile %s", mapp.ServiceProfile)
+			default:
+				return err
+			}
+		}
+	}
+
+	var app appd.Application
+	var err error
+	if org.ID == 0 {
+		err = errHandler.ErrDoesNotExist
+	} else {
+		app, err = r.st.GetApplicationByName(ctx, org.ID, mapp.Name)
+	}
+	switch {
+	case errors.Is(err, errHandler.ErrDoesNotExist):
+		r.record(Create, "application", name, nil)
+		app = appd.Application{
+			Name:             mapp.Name,
+			OrganizationID:   org.ID,
+			ServiceProfileID: spID,
+		}
+		setApplicationSettings(&app, mapp.ApplicationSettings)
+		if !r.dryRun {
+			if err := r.st.CreateApplication(ctx, &app); err != nil {
+				return fmt.Errorf("couldn't create application: %w", err)
+			}
+		}
+	case err != nil:
+		return fmt.Errorf("couldn't get application: %w", err)
+	default:
+		have := ApplicationSettings{
+			Description:          app.Description,
+			PayloadCodec:         app.PayloadCodec,
+			PayloadEncoderScript: app.PayloadEncoderScript,
+			PayloadDecoderScript: app.PayloadDecoderScript,
+		}
+		var extra []string
+		if app.ServiceProfileID != spID {
+			extra = append(extra, "service_profile")
+		}
+		if r.plan("application", name, mapp.ApplicationSettings, have, extra...) {
+			setApplicationSettings(&app, mapp.ApplicationSettings)
+			app.ServiceProfileID = spID
+			if err := r.st.UpdateApplication(ctx, app); err != nil {
+				return fmt.Errorf("couldn't update application: %w", err)
+			}
+		}
+	}
+
+	for _, i := range mapp.Integrations {
+		if err := r.applyIntegration(ctx, app, name, i); err != nil {
+			return fmt.Errorf("%s integration: %w", i.Kind, err)
+		}
+	}
+	return nil
+}
+
+func setApplicationSettings(app *appd.Application, s ApplicationSettings) {
+	app.Description = s.Description
+	app.PayloadCodec = s.PayloadCodec
+	app.PayloadEncoderScript = s.PayloadEncoderScript
+	app.PayloadDecoderScript = s.PayloadDecoderScript
+}
+
+func (r *run) applyIntegration(ctx context.Context, app appd.Application, appName string, mi Integration) error {
+	name := appName + "/" + mi.Kind
+	settings := mi.Settings
+	if len(settings) == 0 {
+		settings = json.RawMessage("{}")
+	}
+
+	var i appd.Integration
+	var err error
+	if app.ID == 0 {
+		err = errHandler.ErrDoesNotExist
+	} else {
+		i, err = r.st.GetIntegrationByApplicationID(ctx, app.ID, mi.Kind)
+	}
+	if errors.Is(err, errHandler.ErrDoesNotExist) {
+		r.record(Create, "integration", name, nil)
+		if r.dryRun {
+			return nil
+		}
+		err := r.st.CreateIntegration(ctx, &appd.Integration{
+			ApplicationID: app.ID,
+			Kind:          mi.Kind,
+			Settings:      settings,
+		})
+		if err != nil {
+			return fmt.Errorf("couldn't create integration: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get integration: %w", err)
+	}
+
+	equal, err := equalJSON(settings, i.Settings)
+	if err != nil {
+		return err
+	}
+	if equal {
+		r.record(Unchanged, "integration", name, nil)
+		return nil
+	}
+	r.record(Update, "integration", name, []string{"settings"})
+	if r.dryRun {
+		return nil
+	}
+	i.Settings = settings
+	if err := r.st.UpdateIntegration(ctx, &i); err != nil {
+		return fmt.Errorf("couldn't update integration: %w", err)
+	}
+	return nil
+}
+
+// equalJSON returns true if a and b are the same JSON values regardless of
+// the formatting and the order of the keys
+func equalJSON(a, b json.RawMessage) (bool, error) {
+	var va, vb interface{}
+	if err := json.Unmarshal(a, &va); err != nil {
+		return false, fmt.Errorf("invalid settings: %w", err)
+	}
+	if len(b) == 0 {
+		b = json.RawMessage("{}")
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		return false, fmt.Errorf("invalid stored settings: %w", err)
+	}
+	return reflect.DeepEqual(va, vb), nil
+}
+
+// diffFields compares the settings structs of the same type and returns the
+// JSON names of the fields that differ. The empty slices and maps are the
+// same as nil ones.
+func diffFields(want, have interface{}) []string {
+	wv, hv := reflect.ValueOf(want), reflect.ValueOf(have)
+	t := wv.Type()
+	var fields []string
+	for i := 0; i < t.NumField(); i++ {
+		wf, hf := wv.Field(i), hv.Field(i)
+		switch wf.Kind() {
+		case reflect.Slice, reflect.Map:
+			if wf.Len() == 0 && hf.Len() == 0 {
+				continue
+			}
+		}
+		if !reflect.DeepEqual(wf.Interface(), hf.Interface()) {
+			fields = append(fields, strings.Split(t.Field(i).Tag.Get("json"), ",")[0])
+		}
+	}
+	return fields
+}
+
+func hstoreToMap(h hstore.Hstore) map[string]string {
+	m := make(map[string]string)
+	for k, v := range h.Map {
+		if v.Valid {
+			m[k] = v.String
+		}
+	}
+	return m
+}
+
+func mapToHstore(m map[string]string) hstore.Hstore {
+	h := hstore.Hstore{Map: make(map[string]sql.NullString)}
+	for k, v := range m {
+		h.Map[k] = sql.NullString{Valid: true, String: v}
+	}
+	return h
+}
@@ -0,0 +1,478 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	nsd "github.com/mxc-foundation/lpwan-app-server/internal/api/external/ns"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	appd "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
+	gwd "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+)
+
+const testYAML = `
+network_servers:
+  - name: ns-eu
+    server: network-server:8000
+    gateway_discovery_enabled: true
+    gateway_discovery_interval: 10
+default_gateway_configs:
+  - model: MX1901
+    region: EU868
+    config: '{"SX1301_conf": {"radio_0": {"enable": true, "freq": 867500000, "tx_enable": true}, "chan_multiSF_0": {"enable": true, "radio": 0, "if": -200000}}, "gateway_conf": {"gateway_ID": "{{ .GatewayID }}", "server_address": "{{ .ServerAddr }}", "serv_port_up": 1700, "serv_port_down": 1700}}'
+organizations:
+  - name: acme
+    display_name: ACME
+    can_have_gateways: true
+    service_profiles:
+      - name: default
+        network_server: ns-eu
+        add_gw_metadata: true
+        dr_max: 5
+    device_profiles:
+      - name: otaa
+        network_server: ns-eu
+        mac_version: 1.0.3
+        supports_join: true
+        factory_preset_freqs: [868100000, 868300000]
+        uplink_interval: 1h30m
+        tags:
+          floor: "2"
+    applications:
+      - name: sensors
+        service_profile: default
+        description: Sensors
+        integrations:
+          - kind: HTTP
+            settings:
+              uplinkDataURL: http://example.com/up
+              headers:
+                - key: Authorization
+                  value: secret
+`
+
+const testTOML = `
+[[network_servers]]
+name = "ns-eu"
+server = "network-server:8000"
+gateway_discovery_enabled = true
+gateway_discovery_interval = 10
+
+[[default_gateway_configs]]
+model = "MX1901"
+region = "EU868"
+config = '{"SX1301_conf": {"radio_0": {"enable": true, "freq": 867500000, "tx_enable": true}, "chan_multiSF_0": {"enable": true, "radio": 0, "if": -200000}}, "gateway_conf": {"gateway_ID": "{{ .GatewayID }}", "server_address": "{{ .ServerAddr }}", "serv_port_up": 1700, "serv_port_down": 1700}}'
+
+[[organizations]]
+name = "acme"
+display_name = "ACME"
+can_have_gateways = true
+
+  [[organizations.service_profiles]]
+  name = "default"
+  network_server = "ns-eu"
+  add_gw_metadata = true
+  dr_max = 5
+
+  [[organizations.device_profiles]]
+  name = "otaa"
+  network_server = "ns-eu"
+  mac_version = "1.0.3"
+  supports_join = true
+  factory_preset_freqs = [868100000, 868300000]
+  uplink_interval = "1h30m"
+  tags = { floor = "2" }
+
+  [[organizations.applications]]
+  name = "sensors"
+  service_profile = "default"
+  description = "Sensors"
+
+    [[organizations.applications.integrations]]
+    kind = "HTTP"
+
+      [organizations.applications.integrations.settings]
+      uplinkDataURL = "http://example.com/up"
+
+        [[organizations.applications.integrations.settings.headers]]
+        key = "Authorization"
+        value = "secret"
+`
+
+func TestParse(t *testing.T) {
+	fromYAML, err := ParseYAML([]byte(testYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromTOML, err := ParseTOML([]byte(testTOML))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, m := range map[string]*Manifest{"yaml": fromYAML, "toml": fromTOML} {
+		if len(m.Organizations) != 1 || len(m.NetworkServers) != 1 || len(m.DefaultGatewayConfigs) != 1 {
+			t.Fatalf("%s: unexpected manifest: %+v", name, m)
+		}
+		dp := m.Organizations[0].DeviceProfiles[0]
+		if time.Duration(dp.UplinkInterval) != 90*time.Minute || dp.Tags["floor"] != "2" ||
+			!reflect.DeepEqual(dp.FactoryPresetFreqs, []uint32{868100000, 868300000}) {
+			t.Errorf("%s: unexpected device profile: %+v", name, dp)
+		}
+		if sp := m.Organizations[0].ServiceProfiles[0]; !sp.AddGWMetadata || sp.DRMax != 5 {
+			t.Errorf("%s: unexpected service profile: %+v", name, sp)
+		}
+	}
+
+	// integration settings are the same regardless of the format
+	ys := fromYAML.Organizations[0].Applications[0].Integrations[0].Settings
+	ts := fromTOML.Organizations[0].Applications[0].Integrations[0].Settings
+	if equal, err := equalJSON(ys, ts); err != nil || !equal {
+		t.Errorf("expected the same settings, got %s and %s", ys, ts)
+	}
+	if !strings.Contains(string(ys), `"uplinkDataURL"`) {
+		t.Errorf("expected the case of the settings keys to be kept, got %s", ys)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		manifest string
+		err      string
+	}{
+		{
+			name: "duplicate organization",
+			manifest: `
+organizations:
+  - name: acme
+  - name: acme`,
+			err: `duplicate organization "acme"`,
+		},
+		{
+			name: "network server without address",
+			manifest: `
+network_servers:
+  - name: ns`,
+			err: "must have name and server",
+		},
+		{
+			name: "invalid default gateway config",
+			manifest: `
+default_gateway_configs:
+  - model: MX1901
+    region: EU868
+    config: '{"SX1301_conf": {}}'`,
+			err: `default gateway config "MX1901/EU868": no radio has TX enabled`,
+		},
+		{
+			name: "application without service profile",
+			manifest: `
+organizations:
+  - name: acme
+    applications:
+      - name: sensors`,
+			err: "must have name and service_profile",
+		},
+		{
+			name: "unknown integration",
+			manifest: `
+organizations:
+  - name: acme
+    applications:
+      - name: sensors
+        service_profile: default
+        integrations:
+          - kind: FTP`,
+			err: `unknown integration kind "FTP"`,
+		},
+		{
+			name: "unknown key",
+			manifest: `
+organizations:
+  - name: acme
+    max_devce_count: 10`,
+			err: `unknown field "max_devce_count"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseYAML([]byte(tc.manifest))
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	want := DeviceProfileSettings{MACVersion: "1.0.3", SupportsJoin: true, UplinkInterval: Duration(time.Hour)}
+	have := DeviceProfileSettings{
+		MACVersion:         "1.0.2",
+		SupportsJoin:       true,
+		FactoryPresetFreqs: []uint32{},
+		Tags:               map[string]string{},
+	}
+	fields := diffFields(want, have)
+	if !reflect.DeepEqual(fields, []string{"mac_version", "uplink_interval"}) {
+		t.Errorf("unexpected fields: %v", fields)
+	}
+	if fields := diffFields(want, want); len(fields) != 0 {
+		t.Errorf("expected no fields, got %v", fields)
+	}
+}
+
+func TestEqualJSON(t *testing.T) {
+	equal, err := equalJSON(json.RawMessage(`{"a": 1, "b": [1, 2]}`), json.RawMessage(`{"b":[1,2],"a":1}`))
+	if err != nil || !equal {
+		t.Errorf("expected equal settings, got %v, %v", equal, err)
+	}
+	equal, err = equalJSON(json.RawMessage(`{}`), nil)
+	if err != nil || !equal {
+		t.Errorf("expected empty settings to be equal, got %v, %v", equal, err)
+	}
+	if equal, _ := equalJSON(json.RawMessage(`{"a": 1}`), json.RawMessage(`{"a": 2}`)); equal {
+		t.Error("expected different settings")
+	}
+}
+
+// testStore keeps the default gateway configs, organizations, applications
+// and integrations in memory, the other methods of the Store are not
+// implemented
+type testStore struct {
+	Store
+	gatewayConfigs  map[string]string
+	organizations   map[string]organization.Organization
+	serviceProfiles map[string]uuid.UUID
+	applications    map[string]appd.Application
+	integrations    map[string]appd.Integration
+	nextID          int64
+	// writes is the number of the created and updated resources
+	writes int
+}
+
+func newTestStore() *testStore {
+	return &testStore{
+		gatewayConfigs:  make(map[string]string),
+		organizations:   make(map[string]organization.Organization),
+		serviceProfiles: map[string]uuid.UUID{"default": uuid.Must(uuid.NewV4())},
+		applications:    make(map[string]appd.Application),
+		integrations:    make(map[string]appd.Integration),
+	}
+}
+
+func (ts *testStore) id() int64 {
+	ts.nextID++
+	return ts.nextID
+}
+
+func (ts *testStore) GetNetworkServers(ctx context.Context, filters nsd.NetworkServerFilters) ([]nsd.NetworkServer, error) {
+	return nil, nil
+}
+
+func (ts *testStore) GetDefaultNetworkServer(ctx context.Context) (nsd.NetworkServer, error) {
+	return nsd.NetworkServer{}, errHandler.ErrDoesNotExist
+}
+
+func (ts *testStore) GetDefaultGatewayConfig(ctx context.Context, cfg *gwd.DefaultGatewayConfig) error {
+	c, ok := ts.gatewayConfigs[cfg.Model+"/"+cfg.Region]
+	if !ok {
+		return errHandler.ErrDoesNotExist
+	}
+	cfg.DefaultConfig = c
+	return nil
+}
+
+func (ts *testStore) AddNewDefaultGatewayConfig(ctx context.Context, cfg *gwd.DefaultGatewayConfig) error {
+	ts.writes++
+	ts.gatewayConfigs[cfg.Model+"/"+cfg.Region] = cfg.DefaultConfig
+	return nil
+}
+
+func (ts *testStore) UpdateDefaultGatewayConfig(ctx context.Context, cfg *gwd.DefaultGatewayConfig) error {
+	return ts.AddNewDefaultGatewayConfig(ctx, cfg)
+}
+
+func (ts *testStore) GetOrganizationByName(ctx context.Context, name string) (organization.Organization, error) {
+	org, ok := ts.organizations[name]
+	if !ok {
+		return org, errHandler.ErrDoesNotExist
+	}
+	return org, nil
+}
+
+func (ts *testStore) CreateOrganization(ctx context.Context, org *organization.Organization) error {
+	org.ID = ts.id()
+	return ts.UpdateOrganization(ctx, org)
+}
+
+func (ts *testStore) UpdateOrganization(ctx context.Context, org *organization.Organization) error {
+	ts.writes++
+	ts.organizations[org.Name] = *org
+	return nil
+}
+
+func (ts *testStore) GetServiceProfileIDByName(ctx context.Context, orgID int64, name string) (uuid.UUID, error) {
+	id, ok := ts.serviceProfiles[name]
+	if !ok {
+		return id, errHandler.ErrDoesNotExist
+	}
+	return id, nil
+}
+
+func (ts *testStore) GetApplicationByName(ctx context.Context, orgID int64, name string) (appd.Application, error) {
+	app, ok := ts.applications[fmt.Sprintf("%d/%s", orgID, name)]
+	if !ok {
+		return app, errHandler.ErrDoesNotExist
+	}
+	return app, nil
+}
+
+func (ts *testStore) CreateApplication(ctx context.Context, app *appd.Application) error {
+	app.ID = ts.id()
+	return ts.UpdateApplication(ctx, *app)
+}
+
+func (ts *testStore) UpdateApplication(ctx context.Context, app appd.Application) error {
+	ts.writes++
+	ts.applications[fmt.Sprintf("%d/%s", app.OrganizationID, app.Name)] = app
+	return nil
+}
+
+func (ts *testStore) GetIntegrationByApplicationID(ctx context.Context, applicationID int64, kind string) (appd.Integration, error) {
+	i, ok := ts.integrations[fmt.Sprintf("%d/%s", applicationID, kind)]
+	if !ok {
+		return i, errHandler.ErrDoesNotExist
+	}
+	return i, nil
+}
+
+func (ts *testStore) CreateIntegration(ctx context.Context, i *appd.Integration) error {
+	i.ID = ts.id()
+	return ts.UpdateIntegration(ctx, i)
+}
+
+func (ts *testStore) UpdateIntegration(ctx context.Context, i *appd.Integration) error {
+	ts.writes++
+	ts.integrations[fmt.Sprintf("%d/%s", i.ApplicationID, i.Kind)] = *i
+	return nil
+}
+
+const testApplyYAML = `
+default_gateway_configs:
+  - model: MX1901
+    region: EU868
+    config: '{"SX1301_conf": {"radio_0": {"enable": true, "freq": 867500000, "tx_enable": true}, "chan_multiSF_0": {"enable": true, "radio": 0, "if": -200000}}, "gateway_conf": {"gateway_ID": "{{ .GatewayID }}", "server_address": "{{ .ServerAddr }}", "serv_port_up": 1700, "serv_port_down": 1700}}'
+organizations:
+  - name: acme
+    display_name: ACME
+    can_have_gateways: true
+    applications:
+      - name: sensors
+        service_profile: default
+        description: Sensors
+        integrations:
+          - kind: HTTP
+            settings:
+              uplinkDataURL: http://example.com/up
+  - name: beta
+    display_name: Beta
+    applications:
+      - name: meters
+        service_profile: default
+  - name: gamma
+    display_name: Gamma
+`
+
+// testApplyStore returns the store with some of the resources of
+// testApplyYAML, the other ones must be created or updated
+func testApplyStore() *testStore {
+	ts := newTestStore()
+	ts.gatewayConfigs["MX1901/EU868"] = `{"SX1301_conf": {}}`
+	ts.organizations["acme"] = organization.Organization{ID: ts.id(), Name: "acme", DisplayName: "Acme", CanHaveGateways: true}
+	ts.organizations["gamma"] = organization.Organization{ID: ts.id(), Name: "gamma", DisplayName: "Gamma"}
+	return ts
+}
+
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+	m, err := ParseYAML([]byte(testApplyYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Change{
+		{Action: Update, Kind: "default-gateway-config", Name: "MX1901/EU868", Fields: []string{"config"}},
+		{Action: Update, Kind: "organization", Name: "acme", Fields: []string{"display_name"}},
+		{Action: Create, Kind: "application", Name: "acme/sensors"},
+		{Action: Create, Kind: "integration", Name: "acme/sensors/HTTP"},
+		{Action: Create, Kind: "organization", Name: "beta"},
+		{Action: Create, Kind: "application", Name: "beta/meters"},
+		{Action: Unchanged, Kind: "organization", Name: "gamma"},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		ts := testApplyStore()
+		changes, err := New(ts, nil, uuid.Nil, "").Apply(ctx, m, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(changes, expected) {
+			t.Errorf("unexpected changes:\n%v\nexpected:\n%v", changes, expected)
+		}
+		if ts.writes != 0 {
+			t.Errorf("expected no writes, got %d", ts.writes)
+		}
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		ts := testApplyStore()
+		a := New(ts, nil, uuid.Nil, "")
+		changes, err := a.Apply(ctx, m, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(changes, expected) {
+			t.Errorf("unexpected changes:\n%v\nexpected:\n%v", changes, expected)
+		}
+		if ts.writes != 6 {
+			t.Errorf("expected 6 writes, got %d", ts.writes)
+		}
+		if org := ts.organizations["acme"]; org.DisplayName != "ACME" {
+			t.Errorf("organization is not updated: %+v", org)
+		}
+		if cfg := ts.gatewayConfigs["MX1901/EU868"]; cfg != m.DefaultGatewayConfigs[0].Config {
+			t.Errorf("default gateway config is not updated: %s", cfg)
+		}
+		app := ts.applications[fmt.Sprintf("%d/sensors", ts.organizations["acme"].ID)]
+		if app.Description != "Sensors" || app.ServiceProfileID != ts.serviceProfiles["default"] {
+			t.Errorf("unexpected application: %+v", app)
+		}
+		if i := ts.integrations[fmt.Sprintf("%d/HTTP", app.ID)]; string(i.Settings) != `{"uplinkDataURL":"http://example.com/up"}` {
+			t.Errorf("unexpected integration settings: %s", i.Settings)
+		}
+
+		// applying the same manifest again changes nothing
+		ts.writes = 0
+		changes, err = a.Apply(ctx, m, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range changes {
+			if c.Action != Unchanged {
+				t.Errorf("unexpected change: %s", c)
+			}
+		}
+		if len(changes) != len(expected) {
+			t.Errorf("expected %d unchanged resources, got %v", len(expected), changes)
+		}
+		if ts.writes != 0 {
+			t.Errorf("expected no writes, got %d", ts.writes)
+		}
+	})
+}
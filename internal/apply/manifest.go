@@ -0,0 +1,393 @@
+// Package apply creates and updates the organizations, network-servers,
+// profiles, applications, integrations and default gateway configs of the
+// supernode to match the manifest
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"gopkg.in/yaml.v2"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/integration"
+	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway"
+)
+
+// Manifest is the desired configuration of the supernode. The resources are
+// identified by the names, the resources that exist in the database but not
+// in the manifest are left untouched. The omitted settings of the resources
+// in the manifest are set to the zero values.
+type Manifest struct {
+	NetworkServers        []NetworkServer        `json:"network_servers"`
+	DefaultGatewayConfigs []DefaultGatewayConfig `json:"default_gateway_configs"`
+	Organizations         []Organization         `json:"organizations"`
+}
+
+// NetworkServer is the network-server
+type NetworkServer struct {
+	Name string `json:"name"`
+	NetworkServerSettings
+}
+
+// NetworkServerSettings are the settings of the network-server
+type NetworkServerSettings struct {
+	Server                      string `json:"server"`
+	CACert                      string `json:"ca_cert"`
+	TLSCert                     string `json:"tls_cert"`
+	TLSKey                      string `json:"tls_key"`
+	RoutingProfileCACert        string `json:"routing_profile_ca_cert"`
+	RoutingProfileTLSCert       string `json:"routing_profile_tls_cert"`
+	RoutingProfileTLSKey        string `json:"routing_profile_tls_key"`
+	GatewayDiscoveryEnabled     bool   `json:"gateway_discovery_enabled"`
+	GatewayDiscoveryInterval    int    `json:"gateway_discovery_interval"`
+	GatewayDiscoveryTXFrequency int    `json:"gateway_discovery_tx_frequency"`
+	GatewayDiscoveryDR          int    `json:"gateway_discovery_dr"`
+}
+
+// DefaultGatewayConfig is the default config of the gateways of the model in
+// the region
+type DefaultGatewayConfig struct {
+	Model  string `json:"model"`
+	Region string `json:"region"`
+	Config string `json:"config"`
+}
+
+// Organization is the organization with its profiles and applications
+type Organization struct {
+	Name string `json:"name"`
+	OrganizationSettings
+	ServiceProfiles []ServiceProfile `json:"service_profiles"`
+	DeviceProfiles  []DeviceProfile  `json:"device_profiles"`
+	Applications    []Application    `json:"applications"`
+}
+
+// OrganizationSettings are the settings of the organization
+type OrganizationSettings struct {
+	DisplayName     string `json:"display_name"`
+	CanHaveGateways bool   `json:"can_have_gateways"`
+	MaxDeviceCount  int    `json:"max_device_count"`
+	MaxGatewayCount int    `json:"max_gateway_count"`
+}
+
+// ServiceProfile is the service-profile of the organization
+type ServiceProfile struct {
+	Name string `json:"name"`
+	// NetworkServer is the name of the network-server, it can't be changed
+	// once the service-profile is created
+	NetworkServer string `json:"network_server"`
+	ServiceProfileSettings
+}
+
+// ServiceProfileSettings are the network-server settings of the
+// service-profile
+type ServiceProfileSettings struct {
+	ULRate                 uint32 `json:"ul_rate"`
+	ULBucketSize           uint32 `json:"ul_bucket_size"`
+	DLRate                 uint32 `json:"dl_rate"`
+	DLBucketSize           uint32 `json:"dl_bucket_size"`
+	AddGWMetadata          bool   `json:"add_gw_metadata"`
+	DevStatusReqFreq       uint32 `json:"dev_status_req_freq"`
+	ReportDevStatusBattery bool   `json:"report_dev_status_battery"`
+	ReportDevStatusMargin  bool   `json:"report_dev_status_margin"`
+	DRMin                  uint32 `json:"dr_min"`
+	DRMax                  uint32 `json:"dr_max"`
+	PRAllowed              bool   `json:"pr_allowed"`
+	HRAllowed              bool   `json:"hr_allowed"`
+	RAAllowed              bool   `json:"ra_allowed"`
+	NwkGeoLoc              bool   `json:"nwk_geo_loc"`
+	TargetPER              uint32 `json:"target_per"`
+	MinGWDiversity         uint32 `json:"min_gw_diversity"`
+}
+
+// DeviceProfile is the device-profile of the organization
+type DeviceProfile struct {
+	Name string `json:"name"`
+	// NetworkServer is the name of the network-server, it can't be changed
+	// once the device-profile is created
+	NetworkServer string `json:"network_server"`
+	DeviceProfileSettings
+}
+
+// DeviceProfileSettings are the settings of the device-profile
+type DeviceProfileSettings struct {
+	MACVersion           string            `json:"mac_version"`
+	RegParamsRevision    string            `json:"reg_params_revision"`
+	SupportsJoin         bool              `json:"supports_join"`
+	SupportsClassB       bool              `json:"supports_class_b"`
+	ClassBTimeout        uint32            `json:"class_b_timeout"`
+	PingSlotPeriod       uint32            `json:"ping_slot_period"`
+	PingSlotDR           uint32            `json:"ping_slot_dr"`
+	PingSlotFreq         uint32            `json:"ping_slot_freq"`
+	SupportsClassC       bool              `json:"supports_class_c"`
+	ClassCTimeout        uint32            `json:"class_c_timeout"`
+	RXDelay1             uint32            `json:"rx_delay_1"`
+	RXDROffset1          uint32            `json:"rx_dr_offset_1"`
+	RXDatarate2          uint32            `json:"rx_datarate_2"`
+	RXFreq2              uint32            `json:"rx_freq_2"`
+	FactoryPresetFreqs   []uint32          `json:"factory_preset_freqs"`
+	MaxEIRP              uint32            `json:"max_eirp"`
+	MaxDutyCycle         uint32            `json:"max_duty_cycle"`
+	RFRegion             string            `json:"rf_region"`
+	Supports32BitFCnt    bool              `json:"supports_32bit_fcnt"`
+	PayloadCodec         string            `json:"payload_codec"`
+	PayloadEncoderScript string            `json:"payload_encoder_script"`
+	PayloadDecoderScript string            `json:"payload_decoder_script"`
+	UplinkInterval       Duration          `json:"uplink_interval"`
+	Tags                 map[string]string `json:"tags"`
+}
+
+// Application is the application of the organization with its
+// integrations
+type Application struct {
+	Name string `json:"name"`
+	// ServiceProfile is the name of the service-profile of the organization
+	ServiceProfile string `json:"service_profile"`
+	ApplicationSettings
+	Integrations []Integration `json:"integrations"`
+}
+
+// ApplicationSettings are the settings of the application
+type ApplicationSettings struct {
+	Description          string `json:"description"`
+	PayloadCodec         string `json:"payload_codec"`
+	PayloadEncoderScript string `json:"payload_encoder_script"`
+	PayloadDecoderScript string `json:"payload_decoder_script"`
+}
+
+// Integration is the integration of the application, there may be only one
+// integration of each kind
+type Integration struct {
+	Kind     string          `json:"kind"`
+	Settings json.RawMessage `json:"settings"`
+}
+
+// Duration is the duration written as "1h30m" in the manifest
+type Duration time.Duration
+
+// UnmarshalJSON parses the duration from the string or the number of
+// nanoseconds
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case string:
+		dur, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*d = Duration(dur)
+	case float64:
+		*d = Duration(v)
+	case nil:
+		*d = 0
+	default:
+		return fmt.Errorf("invalid duration: %s", b)
+	}
+	return nil
+}
+
+// MarshalJSON writes the duration as the string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Load reads the manifest from the YAML or TOML file, the format is chosen
+// by the file extension
+func Load(path string) (*Manifest, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParseYAML(b)
+	case ".toml":
+		return ParseTOML(b)
+	default:
+		return nil, fmt.Errorf("unknown manifest format %q, use .yaml or .toml file", filepath.Ext(path))
+	}
+}
+
+// ParseYAML parses the manifest in YAML format
+func ParseYAML(b []byte) (*Manifest, error) {
+	var v interface{}
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("couldn't parse manifest: %w", err)
+	}
+	v, err := stringKeys(v)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse manifest: %w", err)
+	}
+	return decode(v)
+}
+
+// ParseTOML parses the manifest in TOML format
+func ParseTOML(b []byte) (*Manifest, error) {
+	tree, err := toml.LoadBytes(b)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse manifest: %w", err)
+	}
+	return decode(tree.ToMap())
+}
+
+// decode decodes the manifest from the generic representation of YAML or
+// TOML document. It goes through JSON so that the integration settings keep
+// the keys as they are written. The unknown keys are rejected, a misspelled
+// key would otherwise be read as the zero value and applied.
+func decode(v interface{}) (*Manifest, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decode manifest: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	var m Manifest
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("couldn't decode manifest: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// stringKeys converts the maps decoded from YAML to the maps with string
+// keys that can be encoded to JSON
+func stringKeys(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			ks, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("key %v is not a string", k)
+			}
+			sv, err := stringKeys(val)
+			if err != nil {
+				return nil, err
+			}
+			m[ks] = sv
+		}
+		return m, nil
+	case []interface{}:
+		for i := range v {
+			sv, err := stringKeys(v[i])
+			if err != nil {
+				return nil, err
+			}
+			v[i] = sv
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+// Validate checks that the resources have names that are unique, the default
+// gateway configs are valid and the references to the service-profiles in the
+// same manifest are valid
+func (m *Manifest) Validate() error {
+	names := make(map[string]bool)
+	for _, n := range m.NetworkServers {
+		if n.Name == "" || n.Server == "" {
+			return fmt.Errorf("network server must have name and server")
+		}
+		if names[n.Name] {
+			return fmt.Errorf("duplicate network server %q", n.Name)
+		}
+		names[n.Name] = true
+	}
+
+	configs := make(map[string]bool)
+	for _, c := range m.DefaultGatewayConfigs {
+		if c.Model == "" || c.Region == "" {
+			return fmt.Errorf("default gateway config must have model and region")
+		}
+		key := c.Model + "/" + c.Region
+		if configs[key] {
+			return fmt.Errorf("duplicate default gateway config %q", key)
+		}
+		configs[key] = true
+		if err := gw.ValidateConfig(c.Config); err != nil {
+			return fmt.Errorf("default gateway config %q: %w", key, err)
+		}
+	}
+
+	orgs := make(map[string]bool)
+	for _, o := range m.Organizations {
+		if o.Name == "" {
+			return fmt.Errorf("organization must have name")
+		}
+		if orgs[o.Name] {
+			return fmt.Errorf("duplicate organization %q", o.Name)
+		}
+		orgs[o.Name] = true
+		if err := o.validate(); err != nil {
+			return fmt.Errorf("organization %q: %w", o.Name, err)
+		}
+	}
+	return nil
+}
+
+var integrationKinds = map[string]bool{
+	integration.HTTP:            true,
+	integration.InfluxDB:        true,
+	integration.ThingsBoard:     true,
+	integration.MyDevices:       true,
+	integration.LoRaCloud:       true,
+	integration.GCPPubSub:       true,
+	integration.AWSSNS:          true,
+	integration.AzureServiceBus: true,
+}
+
+func (o Organization) validate() error {
+	sps := make(map[string]bool)
+	for _, sp := range o.ServiceProfiles {
+		if sp.Name == "" || sp.NetworkServer == "" {
+			return fmt.Errorf("service profile must have name and network_server")
+		}
+		if sps[sp.Name] {
+			return fmt.Errorf("duplicate service profile %q", sp.Name)
+		}
+		sps[sp.Name] = true
+	}
+	dps := make(map[string]bool)
+	for _, dp := range o.DeviceProfiles {
+		if dp.Name == "" || dp.NetworkServer == "" {
+			return fmt.Errorf("device profile must have name and network_server")
+		}
+		if dps[dp.Name] {
+			return fmt.Errorf("duplicate device profile %q", dp.Name)
+		}
+		dps[dp.Name] = true
+	}
+	apps := make(map[string]bool)
+	for _, app := range o.Applications {
+		if app.Name == "" || app.ServiceProfile == "" {
+			return fmt.Errorf("application must have name and service_profile")
+		}
+		if apps[app.Name] {
+			return fmt.Errorf("duplicate application %q", app.Name)
+		}
+		apps[app.Name] = true
+		kinds := make(map[string]bool)
+		for _, i := range app.Integrations {
+			if !integrationKinds[i.Kind] {
+				return fmt.Errorf("unknown integration kind %q of application %q", i.Kind, app.Name)
+			}
+			if kinds[i.Kind] {
+				return fmt.Errorf("duplicate %s integration of application %q", i.Kind, app.Name)
+			}
+			kinds[i.Kind] = true
+		}
+	}
+	return nil
+}
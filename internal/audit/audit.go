@@ -0,0 +1,245 @@
+// Package audit records the administrative actions performed through the
+// external API in the append-only audit log
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+)
+
+// Entry is the record of the action in the audit log
+type Entry struct {
+	ID        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	// UserID and Username identify the user who performed the action, the
+	// username is removed when the data of the user is erased
+	UserID   int64  `db:"user_id"`
+	Username string `db:"username"`
+	// OrganizationID is the organization affected by the action, 0 if none
+	OrganizationID int64 `db:"organization_id"`
+	// Action is the full name of the API method
+	Action string `db:"action"`
+	// Target is the object of the action, e.g. gateway:0102030405060708
+	Target string `db:"target"`
+	// Before and After are the state of the target before and after the
+	// action as JSON, nil if not known
+	Before   json.RawMessage `db:"before"`
+	After    json.RawMessage `db:"after"`
+	SourceIP string          `db:"source_ip"`
+}
+
+// Filter selects the entries to return
+type Filter struct {
+	// OrganizationID selects the entries of the organization if set
+	OrganizationID int64
+	// UserID selects the entries of the user if set
+	UserID int64
+	// Action selects the entries of the action if set
+	Action string
+	// Start and End select the entries created in [Start, End), the bounds
+	// are not applied if zero
+	Start  time.Time
+	End    time.Time
+	Limit  int64
+	Offset int64
+}
+
+// Store provides access to the audit log
+type Store interface {
+	// InsertAuditEntry appends the entry to the audit log
+	InsertAuditEntry(ctx context.Context, e *Entry) error
+	// GetAuditEntriesCount returns the number of the entries matching the
+	// filter
+	GetAuditEntriesCount(ctx context.Context, filter Filter) (int64, error)
+	// GetAuditEntries returns the entries matching the filter, the newest
+	// entries first
+	GetAuditEntries(ctx context.Context, filter Filter) ([]Entry, error)
+}
+
+// auditedMethods are the API methods recorded in the audit log even if the
+// handler doesn't describe the action
+var auditedMethods = map[string]bool{
+	"/extapi.OrganizationService/Create":                    true,
+	"/extapi.OrganizationService/Update":                    true,
+	"/extapi.OrganizationService/Delete":                    true,
+	"/extapi.OrganizationService/AddUser":                   true,
+	"/extapi.OrganizationService/UpdateUser":                true,
+	"/extapi.OrganizationService/DeleteUser":                true,
+	"/extapi.GatewayService/Create":                         true,
+	"/extapi.GatewayService/Update":                         true,
+	"/extapi.GatewayService/Delete":                         true,
+	"/extapi.GatewayService/InsertNewDefaultGatewayConfig":  true,
+	"/extapi.GatewayService/UpdateDefaultGatewayConfig":     true,
+	"/extapi.GatewayService/BatchResetDefaultGatewatConfig": true,
+	"/extapi.GatewayService/ResetDefaultGatewatConfigByID":  true,
+	"/extapi.UserService/Create":                            true,
+	"/extapi.UserService/Update":                            true,
+	"/extapi.UserService/Delete":                            true,
+	"/extapi.NetworkServerService/Create":                   true,
+	"/extapi.NetworkServerService/Update":                   true,
+	"/extapi.NetworkServerService/Delete":                   true,
+	"/extapi.WithdrawService/ModifyWithdrawFee":             true,
+	"/extapi.SettingsService/ModifySettings":                true,
+}
+
+// action is the description of the action set by the handler
+type action struct {
+	described      bool
+	organizationID int64
+	target         string
+	before         interface{}
+	after          interface{}
+}
+
+type actionKey struct{}
+
+// Describe sets the organization affected by the action performed by the
+// handler, the object of the action and its state before and after the
+// action. The states are written to the audit log as JSON, they must not
+// contain any secrets. Describe does nothing if the request is not audited.
+func Describe(ctx context.Context, organizationID int64, target string, before, after interface{}) {
+	a, ok := ctx.Value(actionKey{}).(*action)
+	if !ok {
+		return
+	}
+	a.described = true
+	a.organizationID = organizationID
+	a.target = target
+	a.before = before
+	a.after = after
+}
+
+// Auditor writes the successfully performed administrative actions to the
+// audit log
+type Auditor struct {
+	store Store
+	auth  auth.Authenticator
+	// trustedProxies is the number of the reverse proxies in front of the
+	// HTTP gateway, their addresses are skipped in x-forwarded-for
+	trustedProxies int
+}
+
+// NewAuditor creates a new auditor
+func NewAuditor(store Store, auth auth.Authenticator, trustedProxies int) *Auditor {
+	return &Auditor{
+		store:          store,
+		auth:           auth,
+		trustedProxies: trustedProxies,
+	}
+}
+
+// UnaryServerInterceptor writes the entry to the audit log if the method is
+// audited or if the handler has described the action. Failed requests are
+// not recorded.
+func (a *Auditor) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	act := &action{}
+	resp, err := handler(context.WithValue(ctx, actionKey{}, act), req)
+	if err != nil || (!act.described && !auditedMethods[info.FullMethod]) {
+		return resp, err
+	}
+
+	if err := a.record(ctx, info.FullMethod, act); err != nil {
+		logrus.WithError(err).WithField("action", info.FullMethod).Error("audit: couldn't write audit log entry")
+	}
+	return resp, nil
+}
+
+// StreamServerInterceptor writes the entry to the audit log if the method is
+// audited or if the handler has described the action, the same as
+// UnaryServerInterceptor does for the unary methods
+func (a *Auditor) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	act := &action{}
+	ws := grpc_middleware.WrapServerStream(ss)
+	ws.WrappedContext = context.WithValue(ss.Context(), actionKey{}, act)
+	err := handler(srv, ws)
+	if err != nil || (!act.described && !auditedMethods[info.FullMethod]) {
+		return err
+	}
+
+	if err := a.record(ss.Context(), info.FullMethod, act); err != nil {
+		logrus.WithError(err).WithField("action", info.FullMethod).Error("audit: couldn't write audit log entry")
+	}
+	return nil
+}
+
+func (a *Auditor) record(ctx context.Context, method string, act *action) error {
+	e := &Entry{
+		CreatedAt:      time.Now(),
+		OrganizationID: act.organizationID,
+		Action:         method,
+		Target:         act.target,
+		SourceIP:       sourceIP(ctx, a.trustedProxies),
+	}
+	// some actions, e.g. accepting the invitation, don't require the user to
+	// be logged in, they are recorded without the actor
+	if cred, err := a.auth.GetCredentials(ctx, auth.NewOptions()); err == nil {
+		e.UserID = cred.UserID
+		e.Username = cred.Username
+	}
+	var err error
+	if e.Before, err = marshalState(act.before); err != nil {
+		return err
+	}
+	if e.After, err = marshalState(act.after); err != nil {
+		return err
+	}
+	return a.store.InsertAuditEntry(ctx, e)
+}
+
+func marshalState(state interface{}) (json.RawMessage, error) {
+	if state == nil {
+		return nil, nil
+	}
+	return json.Marshal(state)
+}
+
+// sourceIP returns the IP address of the client, the requests coming through
+// the HTTP gateway carry the address in the x-forwarded-for header. The header
+// is used only if the request comes from the gateway, which connects to the
+// API over the loopback interface, otherwise any gRPC client could set it.
+// The client can put any addresses in the header, only the hops appended by
+// the gateway and by the trusted proxies are used.
+func sourceIP(ctx context.Context, trustedProxies int) string {
+	var peerIP string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(peerIP); err == nil {
+			peerIP = host
+		}
+	}
+	if ip := net.ParseIP(peerIP); ip == nil || !ip.IsLoopback() {
+		return peerIP
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		var hops []string
+		for _, v := range md.Get("x-forwarded-for") {
+			for _, hop := range strings.Split(v, ",") {
+				if hop = strings.TrimSpace(hop); hop != "" {
+					hops = append(hops, hop)
+				}
+			}
+		}
+		if len(hops) > 0 {
+			i := len(hops) - 1 - trustedProxies
+			if i < 0 {
+				i = 0
+			}
+			return hops[i]
+		}
+	}
+	return peerIP
+}
@@ -0,0 +1,235 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+)
+
+type testStore struct {
+	entries []Entry
+	filters []Filter
+}
+
+func (ts *testStore) InsertAuditEntry(ctx context.Context, e *Entry) error {
+	e.ID = int64(len(ts.entries) + 1)
+	ts.entries = append(ts.entries, *e)
+	return nil
+}
+
+func (ts *testStore) GetAuditEntriesCount(ctx context.Context, filter Filter) (int64, error) {
+	return int64(len(ts.entries)), nil
+}
+
+func (ts *testStore) GetAuditEntries(ctx context.Context, filter Filter) ([]Entry, error) {
+	ts.filters = append(ts.filters, filter)
+	return ts.entries, nil
+}
+
+type testAuth struct {
+	globalAdmin bool
+	orgAdminOf  int64
+}
+
+func (ta *testAuth) GetCredentials(ctx context.Context, opts *auth.Options) (*auth.Credentials, error) {
+	return &auth.Credentials{
+		UserID:        7,
+		Username:      "admin@example.com",
+		IsGlobalAdmin: ta.globalAdmin,
+		OrgID:         opts.OrgID,
+		IsOrgAdmin:    opts.OrgID != 0 && opts.OrgID == ta.orgAdminOf,
+	}, nil
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	st := &testStore{}
+	auditor := NewAuditor(st, &testAuth{globalAdmin: true}, 1)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "192.0.2.1, 10.0.0.1"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 8080}})
+
+	// the audited method without the description
+	_, err := auditor.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: "/extapi.OrganizationService/AddUser",
+	}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the described action
+	_, err = auditor.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: "/extapi.GatewayService/Delete",
+	}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		Describe(ctx, 3, "gateway:0102030405060708", map[string]string{"name": "gw"}, nil)
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// failed requests and the methods that are not audited are not recorded
+	_, err = auditor.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: "/extapi.GatewayService/Delete",
+	}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		Describe(ctx, 3, "gateway:0102030405060708", nil, nil)
+		return nil, errors.New("failed")
+	})
+	if err == nil {
+		t.Errorf("expected the error of the handler")
+	}
+	_, err = auditor.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: "/extapi.GatewayService/Get",
+	}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(st.entries) != 2 {
+		t.Fatalf("expected 2 entries, got %+v", st.entries)
+	}
+	e := st.entries[0]
+	if e.Action != "/extapi.OrganizationService/AddUser" || e.UserID != 7 || e.Username != "admin@example.com" ||
+		e.SourceIP != "192.0.2.1" || e.Before != nil || e.After != nil {
+		t.Errorf("unexpected entry: %+v", e)
+	}
+	e = st.entries[1]
+	if e.OrganizationID != 3 || e.Target != "gateway:0102030405060708" || string(e.Before) != `{"name":"gw"}` ||
+		e.After != nil {
+		t.Errorf("unexpected entry: %+v", e)
+	}
+
+	// Describe does nothing outside of the interceptor
+	Describe(context.Background(), 1, "", nil, nil)
+}
+
+// testServerStream is the server stream with the given context
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *testServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	st := &testStore{}
+	auditor := NewAuditor(st, &testAuth{globalAdmin: true}, 0)
+	ss := &testServerStream{ctx: context.Background()}
+
+	// the described action
+	err := auditor.StreamServerInterceptor(nil, ss, &grpc.StreamServerInfo{
+		FullMethod: "/extapi.OrganizationService/Export",
+	}, func(srv interface{}, stream grpc.ServerStream) error {
+		Describe(stream.Context(), 3, "organization:3", nil, map[string]int{"devices": 2})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// failed requests and the actions that are not described are not
+	// recorded
+	err = auditor.StreamServerInterceptor(nil, ss, &grpc.StreamServerInfo{
+		FullMethod: "/extapi.OrganizationService/Import",
+	}, func(srv interface{}, stream grpc.ServerStream) error {
+		Describe(stream.Context(), 4, "organization:4", nil, nil)
+		return errors.New("failed")
+	})
+	if err == nil {
+		t.Errorf("expected the error of the handler")
+	}
+	err = auditor.StreamServerInterceptor(nil, ss, &grpc.StreamServerInfo{
+		FullMethod: "/extapi.OrganizationService/Import",
+	}, func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(st.entries) != 1 {
+		t.Fatalf("expected 1 entry, got %+v", st.entries)
+	}
+	e := st.entries[0]
+	if e.Action != "/extapi.OrganizationService/Export" || e.OrganizationID != 3 || e.UserID != 7 ||
+		string(e.After) != `{"devices":2}` {
+		t.Errorf("unexpected entry: %+v", e)
+	}
+}
+
+func TestSourceIP(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(198, 51, 100, 2), Port: 1}})
+	if ip := sourceIP(ctx, 0); ip != "198.51.100.2" {
+		t.Errorf("unexpected source ip: %s", ip)
+	}
+	if ip := sourceIP(context.Background(), 0); ip != "" {
+		t.Errorf("unexpected source ip: %s", ip)
+	}
+
+	// the header set by the client connected directly is not used
+	md := metadata.Pairs("x-forwarded-for", "203.0.113.9, 192.0.2.1, 10.0.0.1")
+	if ip := sourceIP(metadata.NewIncomingContext(ctx, md), 0); ip != "198.51.100.2" {
+		t.Errorf("unexpected source ip: %s", ip)
+	}
+	if ip := sourceIP(metadata.NewIncomingContext(context.Background(), md), 0); ip != "" {
+		t.Errorf("unexpected source ip: %s", ip)
+	}
+
+	// the client can forge the first hops, only the hops appended by the
+	// gateway and by the trusted proxies are used
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv6loopback, Port: 1}})
+	if ip := sourceIP(ctx, 0); ip != "::1" {
+		t.Errorf("unexpected source ip: %s", ip)
+	}
+	ctx = metadata.NewIncomingContext(ctx, md)
+	for proxies, expected := range map[int]string{0: "10.0.0.1", 1: "192.0.2.1", 2: "203.0.113.9", 5: "203.0.113.9"} {
+		if ip := sourceIP(ctx, proxies); ip != expected {
+			t.Errorf("%d trusted proxies: expected source ip %s, got %s", proxies, expected, ip)
+		}
+	}
+}
+
+func TestList(t *testing.T) {
+	st := &testStore{entries: []Entry{{ID: 1, OrganizationID: 3, Action: "/extapi.GatewayService/Delete"}}}
+
+	srv := NewServer(st, &testAuth{orgAdminOf: 3})
+	resp, err := srv.List(context.Background(), &api.ListAuditLogRequest{OrganizationId: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.TotalCount != 1 || len(resp.Result) != 1 || resp.Result[0].Action != "/extapi.GatewayService/Delete" {
+		t.Errorf("unexpected response: %v", resp)
+	}
+	if f := st.filters[0]; f.OrganizationID != 3 || f.Limit != defaultLimit {
+		t.Errorf("unexpected filter: %+v", f)
+	}
+
+	// only the global admin may list the entries of all the organizations
+	_, err = srv.List(context.Background(), &api.ListAuditLogRequest{})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected permission denied, got %v", err)
+	}
+	_, err = srv.List(context.Background(), &api.ListAuditLogRequest{OrganizationId: 4})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected permission denied, got %v", err)
+	}
+
+	srv = NewServer(st, &testAuth{globalAdmin: true})
+	if _, err := srv.List(context.Background(), &api.ListAuditLogRequest{Limit: 5000}); err != nil {
+		t.Fatal(err)
+	}
+	if f := st.filters[1]; f.OrganizationID != 0 || f.Limit != maxLimit {
+		t.Errorf("unexpected filter: %+v", f)
+	}
+}
@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+// Server implements the audit service API
+type Server struct {
+	store Store
+	auth  auth.Authenticator
+}
+
+// NewServer creates a new audit service server
+func NewServer(store Store, auth auth.Authenticator) *Server {
+	return &Server{
+		store: store,
+		auth:  auth,
+	}
+}
+
+// List returns the entries of the audit log, the newest entries first. The
+// global admin may list all the entries, the organization admin may only
+// list the entries of the organization.
+func (a *Server) List(ctx context.Context, req *api.ListAuditLogRequest) (*api.ListAuditLogResponse, error) {
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(req.OrganizationId))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.IsGlobalAdmin && (req.OrganizationId == 0 || !cred.Has(auth.PermOrganizationAdmin)) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	filter := Filter{
+		OrganizationID: req.OrganizationId,
+		UserID:         req.UserId,
+		Action:         req.Action,
+		Limit:          req.Limit,
+		Offset:         req.Offset,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultLimit
+	}
+	if filter.Limit > maxLimit {
+		filter.Limit = maxLimit
+	}
+	if req.Start != nil {
+		if err := req.Start.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start: %v", err)
+		}
+		filter.Start = req.Start.AsTime()
+	}
+	if req.End != nil {
+		if err := req.End.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end: %v", err)
+		}
+		filter.End = req.End.AsTime()
+	}
+
+	count, err := a.store.GetAuditEntriesCount(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "couldn't get audit log: %v", err)
+	}
+	entries, err := a.store.GetAuditEntries(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "couldn't get audit log: %v", err)
+	}
+	resp := &api.ListAuditLogResponse{TotalCount: count}
+	for _, e := range entries {
+		resp.Result = append(resp.Result, &api.AuditLogEntry{
+			Id:             e.ID,
+			CreatedAt:      timestamppb.New(e.CreatedAt),
+			UserId:         e.UserID,
+			Username:       e.Username,
+			OrganizationId: e.OrganizationID,
+			Action:         e.Action,
+			Target:         e.Target,
+			BeforeJson:     string(e.Before),
+			AfterJson:      string(e.After),
+			SourceIp:       e.SourceIP,
+		})
+	}
+	return resp, nil
+}
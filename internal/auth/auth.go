@@ -87,6 +87,11 @@ type Credentials struct {
 	IsDeviceAdmin bool
 	// IsGatewayAdmin is true if the user is device admin for the org
 	IsGatewayAdmin bool
+	// RoleID is the ID of the custom role of the user in the org, 0 if the
+	// user has only the built-in roles
+	RoleID int64
+	// RolePermissions are the permissions granted by the custom role
+	RolePermissions Permissions
 	// ExternalUserID is the id of external user
 	ExternalUserID string
 	// Service is the name of external user's service
@@ -108,6 +113,10 @@ type OrgUser struct {
 	IsOrgAdmin     bool
 	IsDeviceAdmin  bool
 	IsGatewayAdmin bool
+	// RoleID and RolePermissions describe the custom role of the user, if
+	// the role is set the built-in roles are ignored
+	RoleID          int64
+	RolePermissions Permissions
 }
 
 // Store provides access to information about users and their roles
@@ -139,9 +148,41 @@ func NewCredentials(ctx context.Context, st Store, username string, orgID int64,
 		}
 		c.OrgID = orgID
 		c.IsOrgUser = orgUser.IsOrgUser || c.IsGlobalAdmin
+		if orgUser.RoleID != 0 && !c.IsGlobalAdmin {
+			c.setRole(orgUser.RoleID, orgUser.RolePermissions)
+			return c, nil
+		}
 		c.IsOrgAdmin = orgUser.IsOrgAdmin || c.IsGlobalAdmin
 		c.IsDeviceAdmin = orgUser.IsDeviceAdmin || c.IsOrgAdmin
 		c.IsGatewayAdmin = orgUser.IsGatewayAdmin || c.IsOrgAdmin
 	}
 	return c, nil
 }
+
+// setRole sets the custom role, the flags of the role are derived from its
+// permissions
+func (c *Credentials) setRole(roleID int64, perms Permissions) {
+	c.RoleID = roleID
+	c.RolePermissions = perms
+	c.IsOrgAdmin = perms.Has(PermOrganizationAdmin)
+	c.IsDeviceAdmin = perms.Has(PermDeviceWrite)
+	c.IsGatewayAdmin = perms.Has(PermGatewayWrite)
+}
+
+// Permissions returns the permissions of the user in the organization for
+// which the credentials were checked
+func (c *Credentials) Permissions() Permissions {
+	if c.IsGlobalAdmin {
+		return AllPermissions
+	}
+	if c.RoleID != 0 {
+		return c.RolePermissions
+	}
+	return BuiltinPermissions(c.IsOrgUser, c.IsOrgAdmin, c.IsDeviceAdmin, c.IsGatewayAdmin)
+}
+
+// Has returns true if the user has the permission in the organization for
+// which the credentials were checked
+func (c *Credentials) Has(p Permission) bool {
+	return c.Permissions().Has(p)
+}
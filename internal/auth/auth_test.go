@@ -54,6 +54,12 @@ func TestCredentials(t *testing.T) {
 			13: {
 				5: OrgUser{IsOrgUser: true, IsOrgAdmin: true},
 				7: OrgUser{IsOrgUser: true, IsDeviceAdmin: true},
+				9: OrgUser{
+					IsOrgUser:       true,
+					IsOrgAdmin:      true,
+					RoleID:          3,
+					RolePermissions: Permissions(0).With(PermDeviceRead, PermDeviceQueue, PermGatewayWrite),
+				},
 			},
 			17: {
 				5: OrgUser{IsOrgUser: true},
@@ -117,6 +123,22 @@ func TestCredentials(t *testing.T) {
 				Service:       EMAIL,
 			},
 		},
+		{
+			name:     "if user has a custom role, the flags must follow the role",
+			username: "alice@example.com",
+			orgid:    9,
+			expected: Credentials{
+				UserID:          13,
+				Username:        "alice@example.com",
+				IsExisting:      true,
+				OrgID:           9,
+				IsOrgUser:       true,
+				IsGatewayAdmin:  true,
+				RoleID:          3,
+				RolePermissions: Permissions(0).With(PermDeviceRead, PermDeviceQueue, PermGatewayWrite),
+				Service:         EMAIL,
+			},
+		},
 		{
 			name:     "admin user is a member and admin for every org",
 			username: "bob@example.com",
@@ -164,6 +186,60 @@ func TestCredentials(t *testing.T) {
 	}
 }
 
+func TestCredentialsPermissions(t *testing.T) {
+	orgUser := &Credentials{OrgID: 5, IsOrgUser: true}
+	deviceAdmin := &Credentials{OrgID: 5, IsOrgUser: true, IsDeviceAdmin: true}
+	orgAdmin := &Credentials{OrgID: 5, IsOrgUser: true, IsOrgAdmin: true, IsDeviceAdmin: true, IsGatewayAdmin: true}
+	financeViewer := &Credentials{OrgID: 5, IsOrgUser: true, RoleID: 2, RolePermissions: Permissions(PermFinanceRead)}
+	globalAdmin := &Credentials{IsGlobalAdmin: true}
+	for _, tc := range []struct {
+		name     string
+		cred     *Credentials
+		perm     Permission
+		expected bool
+	}{
+		{"org user can read devices", orgUser, PermDeviceRead, true},
+		{"org user can enqueue downlinks", orgUser, PermDeviceQueue, true},
+		{"org user can't read device keys", orgUser, PermDeviceKeysRead, false},
+		{"org user can't read the wallet", orgUser, PermFinanceRead, false},
+		{"device admin can read device keys", deviceAdmin, PermDeviceKeysRead, true},
+		{"device admin can't modify gateways", deviceAdmin, PermGatewayWrite, false},
+		{"org admin can stake", orgAdmin, PermFinanceWrite, true},
+		{"finance viewer can read the wallet", financeViewer, PermFinanceRead, true},
+		{"finance viewer can't read devices", financeViewer, PermDeviceRead, false},
+		{"global admin can do anything", globalAdmin, PermOrganizationAdmin, true},
+		{"nobody without org", &Credentials{}, PermDeviceRead, false},
+	} {
+		if got := tc.cred.Has(tc.perm); got != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestParsePermissions(t *testing.T) {
+	ps, err := ParsePermissions([]string{"device:read", "finance:read"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ps.Has(PermDeviceRead) || !ps.Has(PermFinanceRead) || ps.Has(PermDeviceWrite) {
+		t.Errorf("unexpected permissions: %v", ps.Names())
+	}
+	names := AllPermissions.Names()
+	if len(names) != len(PermissionNames()) || len(names) != 9 {
+		t.Errorf("expected 9 permissions, got %v", names)
+	}
+	all, err := ParsePermissions(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all != AllPermissions {
+		t.Errorf("expected all permissions, got %v", all.Names())
+	}
+	if _, err := ParsePermissions([]string{"device:delete"}); err == nil {
+		t.Errorf("expected an error for the unknown permission")
+	}
+}
+
 func TestOptions(t *testing.T) {
 	defaults := NewOptions()
 	expDefaults := Options{
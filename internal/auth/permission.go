@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Permission is the right to perform a class of actions in the organization
+type Permission uint32
+
+// Permissions that may be granted by the organization roles
+const (
+	// PermOrganizationAdmin allows to manage the organization, its users,
+	// roles and service profiles
+	PermOrganizationAdmin Permission = 1 << iota
+	// PermDeviceRead allows to read applications, devices and their data
+	PermDeviceRead
+	// PermDeviceWrite allows to create, update and delete applications,
+	// device profiles, devices and multicast groups
+	PermDeviceWrite
+	// PermDeviceKeysRead allows to read the keys of the devices
+	PermDeviceKeysRead
+	// PermDeviceQueue allows to enqueue downlinks for the devices
+	PermDeviceQueue
+	// PermGatewayRead allows to read gateways and their statistics
+	PermGatewayRead
+	// PermGatewayWrite allows to create, update and delete gateways
+	PermGatewayWrite
+	// PermFinanceRead allows to read the wallet, staking and mining reports
+	PermFinanceRead
+	// PermFinanceWrite allows to top up, withdraw and stake
+	PermFinanceWrite
+)
+
+var permissionNames = map[Permission]string{
+	PermOrganizationAdmin: "organization:admin",
+	PermDeviceRead:        "device:read",
+	PermDeviceWrite:       "device:write",
+	PermDeviceKeysRead:    "device:keys:read",
+	PermDeviceQueue:       "device:queue",
+	PermGatewayRead:       "gateway:read",
+	PermGatewayWrite:      "gateway:write",
+	PermFinanceRead:       "finance:read",
+	PermFinanceWrite:      "finance:write",
+}
+
+// String returns the name of the permission, e.g. device:read
+func (p Permission) String() string {
+	if name, ok := permissionNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("Permission(%d)", uint32(p))
+}
+
+// Permissions is a set of permissions
+type Permissions uint32
+
+// AllPermissions contains every permission
+const AllPermissions = Permissions(PermFinanceWrite<<1 - 1)
+
+// Has returns true if the set contains the permission
+func (ps Permissions) Has(p Permission) bool {
+	return ps&Permissions(p) != 0
+}
+
+// With returns the set with the given permissions added
+func (ps Permissions) With(perms ...Permission) Permissions {
+	for _, p := range perms {
+		ps |= Permissions(p)
+	}
+	return ps
+}
+
+// Names returns the sorted names of the permissions in the set
+func (ps Permissions) Names() []string {
+	var res []string
+	for p, name := range permissionNames {
+		if ps.Has(p) {
+			res = append(res, name)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// PermissionNames returns the sorted names of all permissions
+func PermissionNames() []string {
+	return AllPermissions.Names()
+}
+
+// ParsePermissions returns the set of the permissions with the given names.
+// It returns an error if any of the names is unknown.
+func ParsePermissions(names []string) (Permissions, error) {
+	var ps Permissions
+	for _, name := range names {
+		found := false
+		for p, n := range permissionNames {
+			if n == name {
+				ps = ps.With(p)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown permission: %s", name)
+		}
+	}
+	return ps, nil
+}
+
+// BuiltinPermissions returns the permissions implied by the built-in roles
+// of the organization user
+func BuiltinPermissions(isOrgUser, isOrgAdmin, isDeviceAdmin, isGatewayAdmin bool) Permissions {
+	var ps Permissions
+	if isOrgUser {
+		ps = ps.With(PermDeviceRead, PermDeviceQueue, PermGatewayRead)
+	}
+	if isDeviceAdmin {
+		ps = ps.With(PermDeviceRead, PermDeviceWrite, PermDeviceKeysRead, PermDeviceQueue)
+	}
+	if isGatewayAdmin {
+		ps = ps.With(PermGatewayRead, PermGatewayWrite)
+	}
+	if isOrgAdmin {
+		ps = AllPermissions
+	}
+	return ps
+}
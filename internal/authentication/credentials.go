@@ -21,14 +21,6 @@ type User struct {
 	IsGlobalAdmin bool
 }
 
-// OrgUser contains information about the role of the user in organisation
-type OrgUser struct {
-	IsOrgUser      bool
-	IsOrgAdmin     bool
-	IsDeviceAdmin  bool
-	IsGatewayAdmin bool
-}
-
 // Flag defines the authorization flag.
 type Flag int
 
@@ -62,8 +54,7 @@ type Credentials struct {
 	// init when service starts
 	h *handler
 	// change based on ctx
-	user    User
-	orgUser OrgUser
+	user User
 }
 
 func NewCredentials() *Credentials {
@@ -76,7 +67,6 @@ type options struct {
 	audience    string
 	requireOTP  bool
 	limitedCred bool
-	orgID       int64
 }
 
 // Option is used to configure validator checks
@@ -106,12 +96,6 @@ func WithLimitedCredentials() Option {
 	}
 }
 
-func WithOrganizationID(orgID int64) Option {
-	return func(opts *options) {
-		opts.orgID = orgID
-	}
-}
-
 // getCredentials returns a new Credentials object for the user, assuming that
 // the user exists and active
 func (c *Credentials) getCredentials(ctx context.Context, opts ...Option) (Credentials, error) {
@@ -173,25 +157,6 @@ func (c *Credentials) getCredentials(ctx context.Context, opts ...Option) (Crede
 	cred.user.Email = jwtClaims.Username
 	cred.user.IsGlobalAdmin = u.IsGlobalAdmin
 
-	if cred.user.IsGlobalAdmin {
-		return cred, nil
-	}
-
-	if cfg.orgID != 0 {
-		orgUser, err := c.h.st.AuthGetOrgUser(ctx, u.ID, cfg.orgID)
-		if err != nil {
-			return cred, errors.Wrap(err, "getCredentials")
-		}
-		if !orgUser.IsOrgUser {
-			return cred, fmt.Errorf("not an org user")
-		}
-
-		cred.orgUser.IsGatewayAdmin = orgUser.IsGatewayAdmin
-		cred.orgUser.IsDeviceAdmin = orgUser.IsDeviceAdmin
-		cred.orgUser.IsOrgAdmin = orgUser.IsOrgAdmin
-		cred.orgUser.IsOrgUser = orgUser.IsOrgUser
-	}
-
 	return cred, nil
 }
 
@@ -232,16 +197,6 @@ func (c *Credentials) GetUser(ctx context.Context, opts ...Option) (User, error)
 	return cred.user, nil
 }
 
-func (c *Credentials) GetUserPermissionWithOrgID(ctx context.Context, orgID int64, opts ...Option) (OrgUser, error) {
-	opts = append(opts, WithOrganizationID(orgID))
-	cred, err := c.getCredentials(ctx, opts...)
-	if err != nil {
-		return OrgUser{}, errors.Wrap(err, "failed to get credentials")
-	}
-
-	return cred.orgUser, nil
-}
-
 // Username returns the name of the user
 func (c *Credentials) Username(ctx context.Context, opts ...Option) (string, error) {
 	cred, err := c.getCredentials(ctx, opts...)
@@ -264,79 +219,47 @@ func (c *Credentials) UserID(ctx context.Context, opts ...Option) (int64, error)
 // IsGlobalAdmin checks that the user is a global admin and returns an error if
 // he's not
 func (c *Credentials) IsGlobalAdmin(ctx context.Context, opts ...Option) error {
-	cred, err := c.getCredentials(ctx, opts...)
+	cred, err := c.OrgCredentials(ctx, 0, opts...)
 	if err != nil {
 		return errors.Wrap(err, "failed to get credentials")
 	}
 
-	if !cred.user.IsGlobalAdmin {
+	if !cred.IsGlobalAdmin {
 		return errors.New("user is not global admin")
 	}
 
 	return nil
 }
 
-// IsOrgUser checks that the user belongs to the organisation, if not it
-// returns an error
-func (c *Credentials) IsOrgUser(ctx context.Context, orgID int64, opts ...Option) error {
-	opts = append(opts, WithOrganizationID(orgID))
-	cred, err := c.getCredentials(ctx, opts...)
-	if err != nil {
-		return errors.Wrap(err, "failed to get credentials")
-	}
-
-	if !cred.user.IsGlobalAdmin && !cred.orgUser.IsOrgUser {
-		return errors.New("user is neither org user nor global admin")
+// OrgCredentials returns the credentials of the user in the organization
+func (c *Credentials) OrgCredentials(ctx context.Context, orgID int64, opts ...Option) (*auth.Credentials, error) {
+	cfg := options{audience: "lora-app-server"}
+	for _, o := range opts {
+		o(&cfg)
 	}
-
-	return nil
-}
-
-// IsOrgAdmin checks that the user is admin for the organisation, if not it
-// returns an error
-func (c *Credentials) IsOrgAdmin(ctx context.Context, orgID int64, opts ...Option) error {
-	opts = append(opts, WithOrganizationID(orgID))
-	cred, err := c.getCredentials(ctx, opts...)
+	token, err := getTokenFromContext(ctx)
 	if err != nil {
-		return errors.Wrap(err, "failed to get credentials")
+		return nil, err
 	}
-
-	if !cred.user.IsGlobalAdmin && !cred.orgUser.IsOrgAdmin {
-		return errors.New("user is neither org admin nor global admin")
-	}
-
-	return nil
-}
-
-// IsDeviceAdmin checks that the user is device admin for the organisation, if
-// not it returns an error
-func (c *Credentials) IsDeviceAdmin(ctx context.Context, orgID int64, opts ...Option) error {
-	opts = append(opts, WithOrganizationID(orgID))
-	cred, err := c.getCredentials(ctx, opts...)
+	claims, err := c.h.jwtValidator.GetClaims(token, cfg.audience)
 	if err != nil {
-		return errors.Wrap(err, "failed to get credentials")
+		return nil, errors.Wrap(err, "OrgCredentials")
 	}
-
-	if !cred.user.IsGlobalAdmin && !cred.orgUser.IsDeviceAdmin {
-		return errors.New("user is neither device admin nor global admin")
+	if _, err := c.getCredentials(ctx, opts...); err != nil {
+		return nil, err
 	}
-
-	return nil
+	return auth.NewCredentials(ctx, c.h.st, claims.Username, orgID, claims.Service)
 }
 
-// IsGatewayAdmin checks that the user is gateway admin for the organisation,
-// if not it returns an error
-func (c *Credentials) IsGatewayAdmin(ctx context.Context, orgID int64, opts ...Option) error {
-	opts = append(opts, WithOrganizationID(orgID))
-	cred, err := c.getCredentials(ctx, opts...)
+// HasPermission returns true if the user has the permission in the
+// organization
+func (c *Credentials) HasPermission(ctx context.Context, orgID int64, p auth.Permission, opts ...Option) (bool, error) {
+	cred, err := c.OrgCredentials(ctx, orgID, opts...)
 	if err != nil {
-		return errors.Wrap(err, "failed to get credentials")
-	}
-	if !cred.user.IsGlobalAdmin && !cred.orgUser.IsGatewayAdmin {
-		return errors.New("user is neither gateway admin nor global admin")
+		return false, errors.Wrap(err, "failed to get credentials")
 	}
 
-	return nil
+	return cred.Has(p), nil
 }
 
 // Is2FAEnabled requires username, since ctx does not contain user info at this point
@@ -5,15 +5,19 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
 	bapi "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
 )
 
 // Config contains configuration of the service
@@ -26,6 +30,37 @@ type Config struct {
 	CheckInterval int64 `mapstructure:"check_interval_sec"`
 	// the identificator for this supernode used by remote side
 	SNID string `mapstructure:"supernode_id"`
+	// If DryRun is set the airdrops are recorded with the amount that would
+	// be paid, but nothing is paid and the remote side is not updated
+	DryRun bool `mapstructure:"dry_run"`
+}
+
+// ErrNotFailed is returned when trying to re-run the airdrop that hasn't
+// failed
+var ErrNotFailed = errors.New("airdrop has not failed")
+
+// Statuses of the processed airdrops
+const (
+	AirdropPaid   = "paid"
+	AirdropFailed = "failed"
+	AirdropDryRun = "dry_run"
+)
+
+// Airdrop is the local record of the processed airdrop
+type Airdrop struct {
+	AirdropID      int64      `db:"airdrop_id"`
+	Email          string     `db:"email"`
+	Token          string     `db:"token"`
+	AmountUSD      float64    `db:"amount_usd"`
+	Purpose        string     `db:"purpose"`
+	OrganizationID int64      `db:"organization_id"`
+	Currency       string     `db:"currency"`
+	Status         string     `db:"status"`
+	Error          string     `db:"error"`
+	Attempts       int        `db:"attempts"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+	PaidAt         *time.Time `db:"paid_at"`
 }
 
 // Store is the DB interface
@@ -34,17 +69,44 @@ type Store interface {
 	// organization to which the bonuses awarded to the user should be paid. If
 	// user does not exist returns 0, nil
 	GetUserBonusOrgID(ctx context.Context, email string) (int64, error)
+	// GetAirdrop returns the record of the processed airdrop
+	GetAirdrop(ctx context.Context, airdropID int64) (Airdrop, error)
+	// UpsertAirdrop creates or updates the record of the processed airdrop,
+	// the record of the paid airdrop is never updated
+	UpsertAirdrop(ctx context.Context, ad *Airdrop) error
+	// GetAirdropCount returns the number of processed airdrops with the
+	// given status, or of all the airdrops if status is empty
+	GetAirdropCount(ctx context.Context, status string) (int64, error)
+	// GetAirdrops returns the processed airdrops with the given status, or
+	// all the airdrops if status is empty, the latest first
+	GetAirdrops(ctx context.Context, status string, limit, offset int64) ([]Airdrop, error)
 }
 
 // Service represents an instance of the running service
 type Service struct {
 	httpCli  *http.Client
 	cfg      Config
+	jwtMu    sync.Mutex
 	jwt      string
 	interval time.Duration
 	store    Store
 	dbCli    bapi.DistributeBonusServiceClient
-	done     chan struct{}
+	// reruns of the failed airdrops requested by the admin, they are
+	// processed by the run loop so they never run concurrently with the
+	// regular processing of the airdrops
+	reruns chan rerunRequest
+	done   chan struct{}
+}
+
+type rerunRequest struct {
+	ctx       context.Context
+	airdropID int64
+	result    chan rerunResult
+}
+
+type rerunResult struct {
+	airdrop Airdrop
+	err     error
 }
 
 // Start starts the service
@@ -59,6 +121,7 @@ func Start(ctx context.Context, cfg Config, store Store, dbCli bapi.DistributeBo
 		interval: time.Duration(cfg.CheckInterval) * time.Second,
 		store:    store,
 		dbCli:    dbCli,
+		reruns:   make(chan rerunRequest),
 		done:     make(chan struct{}),
 	}
 	go srv.run()
@@ -73,14 +136,23 @@ func (srv *Service) Stop() {
 	}
 }
 
+// leaderJob is the name of the job in the leader election
+const leaderJob = "bonus-airdrop"
+
 func (srv *Service) run() {
 	for {
 		wait := time.Until(time.Now().Truncate(srv.interval).Add(srv.interval))
 		select {
 		case <-time.After(wait):
+			if !leader.IsLeader(leaderJob) {
+				continue
+			}
 			if err := srv.processAirdrops(); err != nil {
 				logrus.Errorf("failed to process airdrops: %v", err)
 			}
+		case req := <-srv.reruns:
+			ad, err := srv.rerunAirdrop(req.ctx, req.airdropID)
+			req.result <- rerunResult{airdrop: ad, err: err}
 		case <-srv.done:
 			return
 		}
@@ -123,10 +195,20 @@ func (srv *Service) authenticate() error {
 	if authInfo.JWT == "" {
 		return fmt.Errorf("JWT token is empty")
 	}
+	srv.jwtMu.Lock()
 	srv.jwt = authInfo.JWT
+	srv.jwtMu.Unlock()
 	return nil
 }
 
+// authorization returns the value of the authorization header of the
+// requests to the remote side
+func (srv *Service) authorization() string {
+	srv.jwtMu.Lock()
+	defer srv.jwtMu.Unlock()
+	return "Bearer " + srv.jwt
+}
+
 type airdrop struct {
 	ID          int64   `json:"id"`
 	Email       string  `json:"email"`
@@ -147,7 +229,7 @@ func (srv *Service) getListOfAirdrops() ([]airdrop, error) {
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Add("Authorization", "Bearer "+srv.jwt)
+	req.Header.Add("Authorization", srv.authorization())
 	resp, err := srv.httpCli.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't send request for airdrops: %v", err)
@@ -183,40 +265,148 @@ func (srv *Service) processAirdrops() error {
 	}
 	ads, err := srv.getListOfAirdrops()
 	if err != nil {
-		return nil
+		return err
 	}
 	ctx := context.Background()
+	var wouldPay int
+	var wouldPayUSD float64
 	for _, ad := range ads {
-		orgID, err := srv.store.GetUserBonusOrgID(ctx, ad.Email)
-		if err != nil {
-			return fmt.Errorf("couldn't get user's orgId: %v", err)
+		rec := Airdrop{
+			AirdropID: ad.ID,
+			Email:     ad.Email,
+			Token:     ad.Token,
+			AmountUSD: ad.AmountUSD,
+			Purpose:   ad.Purpose,
+		}
+		if err := srv.processAirdrop(ctx, &rec); err != nil {
+			return err
 		}
-		if orgID == 0 {
-			srv.updateAirdropError(ad, "the user does not exist or doesn't have an organization")
-			continue
+		if rec.Status == AirdropDryRun && rec.Error == "" {
+			wouldPay++
+			wouldPayUSD += rec.AmountUSD
+		}
+	}
+	if srv.cfg.DryRun {
+		logrus.Infof("dry run: %d airdrops worth %g USD would be paid", wouldPay, wouldPayUSD)
+	}
+	return nil
+}
+
+// processAirdrop pays the airdrop and records the outcome both locally and
+// on the remote side. In dry run mode only the local record is updated.
+func (srv *Service) processAirdrop(ctx context.Context, rec *Airdrop) error {
+	prev, err := srv.store.GetAirdrop(ctx, rec.AirdropID)
+	if err != nil && err != errHandler.ErrDoesNotExist {
+		return fmt.Errorf("couldn't get airdrop record: %v", err)
+	}
+	if err == nil {
+		rec.Attempts = prev.Attempts
+		if prev.Status == AirdropPaid {
+			// the remote side hasn't been updated the last time
+			srv.updateAirdropPaid(airdrop{ID: rec.AirdropID})
+			return nil
 		}
-		currency, err := mapToken(ad.Token)
-		if err != nil {
-			srv.updateAirdropError(ad, err.Error())
-			continue
+	}
+
+	orgID, err := srv.store.GetUserBonusOrgID(ctx, rec.Email)
+	if err != nil {
+		return fmt.Errorf("couldn't get user's orgId: %v", err)
+	}
+	rec.OrganizationID = orgID
+	rec.Error = ""
+	if orgID == 0 {
+		rec.Error = "the user does not exist or doesn't have an organization"
+	} else if rec.Currency, err = mapToken(rec.Token); err != nil {
+		rec.Error = err.Error()
+	}
+
+	if srv.cfg.DryRun {
+		logrus.Infof("dry run: airdrop %d of %g USD to org %d in %s, error: %q",
+			rec.AirdropID, rec.AmountUSD, rec.OrganizationID, rec.Currency, rec.Error)
+		if prev.Status != "" && prev.Status != AirdropDryRun {
+			// keep the outcome of the airdrop processed before
+			rec.Status = prev.Status
+			return nil
 		}
+		rec.Status = AirdropDryRun
+		return srv.store.UpsertAirdrop(ctx, rec)
+	}
+
+	if rec.Error == "" {
+		rec.Attempts++
 		req := &bapi.AddBonusRequest{
-			OrgId:       orgID,
-			Currency:    currency,
-			AmountUsd:   fmt.Sprintf("%g", ad.AmountUSD),
-			Description: ad.Purpose,
-			ExternalRef: fmt.Sprintf("airdrop-%d", ad.ID),
+			OrgId:       rec.OrganizationID,
+			Currency:    rec.Currency,
+			AmountUsd:   fmt.Sprintf("%g", rec.AmountUSD),
+			Description: rec.Purpose,
+			ExternalRef: fmt.Sprintf("airdrop-%d", rec.AirdropID),
 		}
-		_, err = srv.dbCli.AddBonus(ctx, req)
-		if err != nil {
-			srv.updateAirdropError(ad, err.Error())
-			continue
+		if _, err := srv.dbCli.AddBonus(ctx, req); err != nil {
+			rec.Error = err.Error()
 		}
-		srv.updateAirdropPaid(ad)
+	}
+
+	if rec.Error != "" {
+		rec.Status = AirdropFailed
+	} else {
+		now := time.Now()
+		rec.Status = AirdropPaid
+		rec.PaidAt = &now
+	}
+	if err := srv.store.UpsertAirdrop(ctx, rec); err != nil {
+		// don't update the remote side, so the airdrop is processed again
+		return fmt.Errorf("couldn't store airdrop record: %v", err)
+	}
+
+	if rec.Status == AirdropPaid {
+		srv.updateAirdropPaid(airdrop{ID: rec.AirdropID})
+	} else {
+		srv.updateAirdropError(airdrop{ID: rec.AirdropID}, rec.Error)
 	}
 	return nil
 }
 
+// RerunAirdrop tries again to pay the airdrop which has failed. The airdrop
+// is paid by the run loop of the service, so it is not processed at the same
+// time by the regular processing of the airdrops.
+func (srv *Service) RerunAirdrop(ctx context.Context, airdropID int64) (Airdrop, error) {
+	req := rerunRequest{
+		ctx:       ctx,
+		airdropID: airdropID,
+		result:    make(chan rerunResult, 1),
+	}
+	select {
+	case srv.reruns <- req:
+	case <-ctx.Done():
+		return Airdrop{}, ctx.Err()
+	}
+	select {
+	case res := <-req.result:
+		return res.airdrop, res.err
+	case <-ctx.Done():
+		return Airdrop{}, ctx.Err()
+	}
+}
+
+func (srv *Service) rerunAirdrop(ctx context.Context, airdropID int64) (Airdrop, error) {
+	rec, err := srv.store.GetAirdrop(ctx, airdropID)
+	if err != nil {
+		return rec, err
+	}
+	if rec.Status != AirdropFailed {
+		return rec, fmt.Errorf("%w: airdrop %d is %s", ErrNotFailed, airdropID, rec.Status)
+	}
+	if !srv.cfg.DryRun {
+		if err := srv.authenticate(); err != nil {
+			return rec, err
+		}
+	}
+	if err := srv.processAirdrop(ctx, &rec); err != nil {
+		return rec, err
+	}
+	return rec, nil
+}
+
 func (srv *Service) updateAirdrop(airdropID int64, update interface{}) error {
 	b, err := json.Marshal(update)
 	if err != nil {
@@ -230,7 +420,7 @@ func (srv *Service) updateAirdrop(airdropID int64, update interface{}) error {
 	if err != nil {
 		return fmt.Errorf("couldn't create update request: %v", err)
 	}
-	req.Header.Add("Authorization", "Bearer "+srv.jwt)
+	req.Header.Add("Authorization", srv.authorization())
 	req.Header.Add("Content-Type", "application/json")
 	resp, err := srv.httpCli.Do(req)
 	if err != nil {
@@ -256,6 +446,8 @@ func (srv *Service) updateAirdropError(ad airdrop, errStr string) {
 
 type paidUpdate struct {
 	Distributed bool `json:"distributed"`
+	// clears the error of the airdrop that failed before
+	Error string `json:"error"`
 }
 
 func (srv *Service) updateAirdropPaid(ad airdrop) {
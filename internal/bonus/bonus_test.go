@@ -3,6 +3,7 @@ package bonus
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 	"google.golang.org/grpc"
 
 	bapi "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
 )
 
 func handleAuth(rw http.ResponseWriter, r *http.Request) {
@@ -132,7 +134,13 @@ func handleUpdate(rw http.ResponseWriter, r *http.Request) {
 	_, _ = rw.Write(b)
 }
 
-type testStore struct{}
+type testStore struct {
+	airdrops map[int64]Airdrop
+}
+
+func newTestStore() *testStore {
+	return &testStore{airdrops: make(map[int64]Airdrop)}
+}
 
 func (ts *testStore) GetUserBonusOrgID(ctx context.Context, email string) (int64, error) {
 	if email == "foo@example.com" {
@@ -141,34 +149,90 @@ func (ts *testStore) GetUserBonusOrgID(ctx context.Context, email string) (int64
 	return 0, nil
 }
 
-type testM2M struct{}
+func (ts *testStore) GetAirdrop(ctx context.Context, airdropID int64) (Airdrop, error) {
+	ad, ok := ts.airdrops[airdropID]
+	if !ok {
+		return ad, errHandler.ErrDoesNotExist
+	}
+	return ad, nil
+}
+
+func (ts *testStore) UpsertAirdrop(ctx context.Context, ad *Airdrop) error {
+	if prev, ok := ts.airdrops[ad.AirdropID]; ok && prev.Status == AirdropPaid {
+		return nil
+	}
+	ts.airdrops[ad.AirdropID] = *ad
+	return nil
+}
+
+func (ts *testStore) GetAirdropCount(ctx context.Context, status string) (int64, error) {
+	ads, _ := ts.GetAirdrops(ctx, status, 0, 0)
+	return int64(len(ads)), nil
+}
+
+func (ts *testStore) GetAirdrops(ctx context.Context, status string, limit, offset int64) ([]Airdrop, error) {
+	var res []Airdrop
+	for _, ad := range ts.airdrops {
+		if status == "" || ad.Status == status {
+			res = append(res, ad)
+		}
+	}
+	return res, nil
+}
+
+type testM2M struct {
+	paid []*bapi.AddBonusRequest
+	err  error
+}
 
 func (tm *testM2M) AddBonus(ctx context.Context, in *bapi.AddBonusRequest, opts ...grpc.CallOption) (*bapi.AddBonusResponse, error) {
+	if tm.err != nil {
+		return nil, tm.err
+	}
+	tm.paid = append(tm.paid, in)
 	return &bapi.AddBonusResponse{}, nil
 }
 
-func TestProcessAirdrops(t *testing.T) {
-	m2m := &testM2M{}
-	ts := &testStore{}
+// resetAirdrops marks all the airdrops on the stand-in server as not
+// distributed
+func resetAirdrops() {
+	for _, ad := range airdrops {
+		ad.Distributed = false
+		ad.Error = ""
+	}
+}
+
+func newTestService(t *testing.T, ts Store, m2m bapi.DistributeBonusServiceClient, dryRun bool) *Service {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/auth/local", handleAuth)
 	mux.HandleFunc("/airdrops/", handleAirdrops)
+	mux.HandleFunc("/airdrops", handleAirdrops)
 	hs := httptest.NewServer(mux)
-	defer hs.Close()
+	t.Cleanup(hs.Close)
+	resetAirdrops()
 
-	srv := &Service{
+	return &Service{
 		httpCli: &http.Client{},
 		cfg: Config{
 			URL:      hs.URL,
 			User:     "foo",
 			Password: "boo",
 			SNID:     "unit",
+			DryRun:   dryRun,
 		},
 		interval: 3600 * time.Second,
 		store:    ts,
 		dbCli:    m2m,
+		reruns:   make(chan rerunRequest),
 		done:     make(chan struct{}),
 	}
+}
+
+func TestProcessAirdrops(t *testing.T) {
+	m2m := &testM2M{}
+	ts := newTestStore()
+	srv := newTestService(t, ts, m2m, false)
+
 	if err := srv.processAirdrops(); err != nil {
 		t.Errorf("processAirdrops returned an error: %v", err)
 	}
@@ -178,4 +242,86 @@ func TestProcessAirdrops(t *testing.T) {
 	if airdrops[1].Distributed || airdrops[1].Error == "" {
 		t.Errorf("airdrop 2 is not as expected %#v", airdrops[1])
 	}
+	if len(m2m.paid) != 1 || m2m.paid[0].OrgId != 4 || m2m.paid[0].ExternalRef != "airdrop-1" {
+		t.Errorf("unexpected bonuses paid: %v", m2m.paid)
+	}
+
+	if ad := ts.airdrops[1]; ad.Status != AirdropPaid || ad.OrganizationID != 4 ||
+		ad.Currency != "ETH_MXC" || ad.Attempts != 1 || ad.PaidAt == nil {
+		t.Errorf("airdrop 1 record is not as expected %#v", ad)
+	}
+	if ad := ts.airdrops[2]; ad.Status != AirdropFailed || ad.Error == "" || ad.Attempts != 0 {
+		t.Errorf("airdrop 2 record is not as expected %#v", ad)
+	}
+}
+
+func TestProcessAirdropsDryRun(t *testing.T) {
+	m2m := &testM2M{}
+	ts := newTestStore()
+	srv := newTestService(t, ts, m2m, true)
+
+	if err := srv.processAirdrops(); err != nil {
+		t.Errorf("processAirdrops returned an error: %v", err)
+	}
+	if len(m2m.paid) != 0 {
+		t.Errorf("nothing should be paid in dry run, got %v", m2m.paid)
+	}
+	for _, ad := range airdrops {
+		if ad.Distributed || ad.Error != "" {
+			t.Errorf("airdrop %d should not be updated in dry run %#v", ad.ID, ad)
+		}
+	}
+	if ad := ts.airdrops[1]; ad.Status != AirdropDryRun || ad.OrganizationID != 4 || ad.Error != "" {
+		t.Errorf("airdrop 1 record is not as expected %#v", ad)
+	}
+	if ad := ts.airdrops[2]; ad.Status != AirdropDryRun || ad.Error == "" {
+		t.Errorf("airdrop 2 record is not as expected %#v", ad)
+	}
+}
+
+func TestProcessAirdropsListError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/local", handleAuth)
+	mux.HandleFunc("/airdrops", func(rw http.ResponseWriter, r *http.Request) {
+		http.Error(rw, "unavailable", http.StatusServiceUnavailable)
+	})
+	hs := httptest.NewServer(mux)
+	defer hs.Close()
+
+	srv := newTestService(t, newTestStore(), &testM2M{}, false)
+	srv.cfg.URL = hs.URL
+	if err := srv.processAirdrops(); err == nil {
+		t.Errorf("expected an error when the list of airdrops is not available")
+	}
+}
+
+func TestRerunAirdrop(t *testing.T) {
+	m2m := &testM2M{err: fmt.Errorf("m2m is not available")}
+	ts := newTestStore()
+	srv := newTestService(t, ts, m2m, false)
+	go srv.run()
+	defer srv.Stop()
+
+	if err := srv.processAirdrops(); err != nil {
+		t.Fatalf("processAirdrops returned an error: %v", err)
+	}
+	if ad := ts.airdrops[1]; ad.Status != AirdropFailed || ad.Error != "m2m is not available" {
+		t.Fatalf("airdrop 1 record is not as expected %#v", ad)
+	}
+
+	m2m.err = nil
+	ad, err := srv.RerunAirdrop(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("RerunAirdrop returned an error: %v", err)
+	}
+	if ad.Status != AirdropPaid || ad.Attempts != 2 || len(m2m.paid) != 1 {
+		t.Errorf("airdrop 1 is not as expected %#v", ad)
+	}
+	if !airdrops[0].Distributed || airdrops[0].Error != "" {
+		t.Errorf("airdrop 1 is not updated on the server %#v", airdrops[0])
+	}
+
+	if _, err := srv.RerunAirdrop(context.Background(), 1); !errors.Is(err, ErrNotFailed) {
+		t.Errorf("expected ErrNotFailed, got %v", err)
+	}
 }
@@ -0,0 +1,116 @@
+package bonus
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+)
+
+// Server implements the airdrop service API
+type Server struct {
+	srv   *Service
+	store Store
+	auth  auth.Authenticator
+}
+
+// NewServer creates a new airdrop service server. srv is nil if the bonus
+// service is not running
+func NewServer(srv *Service, store Store, auth auth.Authenticator) *Server {
+	return &Server{
+		srv:   srv,
+		store: store,
+		auth:  auth,
+	}
+}
+
+func (a *Server) ensureGlobalAdmin(ctx context.Context) error {
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions())
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.IsGlobalAdmin {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// ListAirdrops lists the airdrops processed by the supernode
+func (a *Server) ListAirdrops(ctx context.Context, req *api.ListAirdropsRequest) (*api.ListAirdropsResponse, error) {
+	if err := a.ensureGlobalAdmin(ctx); err != nil {
+		return nil, err
+	}
+	switch req.Status {
+	case "", AirdropPaid, AirdropFailed, AirdropDryRun:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status: %s", req.Status)
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	count, err := a.store.GetAirdropCount(ctx, req.Status)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	ads, err := a.store.GetAirdrops(ctx, req.Status, limit, req.Offset)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	resp := &api.ListAirdropsResponse{TotalCount: count}
+	for _, ad := range ads {
+		resp.Airdrops = append(resp.Airdrops, airdropToPB(ad))
+	}
+	return resp, nil
+}
+
+// RerunFailedAirdrops tries again to pay the failed airdrops
+func (a *Server) RerunFailedAirdrops(ctx context.Context, req *api.RerunFailedAirdropsRequest) (*api.RerunFailedAirdropsResponse, error) {
+	if err := a.ensureGlobalAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if a.srv == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "bonus service is not running")
+	}
+
+	resp := &api.RerunFailedAirdropsResponse{}
+	for _, id := range req.AirdropIds {
+		ad, err := a.srv.RerunAirdrop(ctx, id)
+		if err != nil {
+			if errors.Is(err, ErrNotFailed) {
+				return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+			}
+			return nil, helpers.ErrToRPCError(err)
+		}
+		resp.Airdrops = append(resp.Airdrops, airdropToPB(ad))
+	}
+	return resp, nil
+}
+
+func airdropToPB(ad Airdrop) *api.Airdrop {
+	res := &api.Airdrop{
+		AirdropId:      ad.AirdropID,
+		Email:          ad.Email,
+		Token:          ad.Token,
+		AmountUsd:      ad.AmountUSD,
+		Purpose:        ad.Purpose,
+		OrganizationId: ad.OrganizationID,
+		Currency:       ad.Currency,
+		Status:         ad.Status,
+		Error:          ad.Error,
+		Attempts:       int64(ad.Attempts),
+		UpdatedAt:      timestamppb.New(ad.UpdatedAt),
+	}
+	if ad.PaidAt != nil {
+		res.PaidAt = timestamppb.New(*ad.PaidAt)
+	}
+	return res
+}
@@ -79,8 +79,12 @@ func (c *Client) updatePrice(p pair) (float64, error) {
 	v.Set("vs_currencies", p.fiat)
 	u += v.Encode()
 	resp, err := c.c.Get(u)
-	if err != nil || resp.StatusCode != 200 {
-		return 0, fmt.Errorf("couldn't get price error: %v status: %s", err, resp.Status)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't get price: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("couldn't get price, status: %s", resp.Status)
 	}
 	dec := json.NewDecoder(resp.Body)
 	var sr simplePriceResponse
@@ -93,3 +97,39 @@ func (c *Client) updatePrice(p pair) (float64, error) {
 	}
 	return price, nil
 }
+
+type coinHistoryResponse struct {
+	MarketData struct {
+		CurrentPrice map[string]float64 `json:"current_price"`
+	} `json:"market_data"`
+}
+
+// GetClosePrice returns the close price of the specified crypto currency in
+// the units of the specified fiat currency on the given day. Coin gecko
+// returns the price at 00:00 UTC of the requested date, so the close price
+// of the day is the price at the start of the next day
+func (c *Client) GetClosePrice(crypto, fiat string, day time.Time) (float64, error) {
+	next := day.UTC().AddDate(0, 0, 1)
+	u := c.baseURL + "/coins/" + url.PathEscape(crypto) + "/history?"
+	v := url.Values{}
+	v.Set("date", next.Format("02-01-2006"))
+	v.Set("localization", "false")
+	u += v.Encode()
+	resp, err := c.c.Get(u)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't get price history: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("couldn't get price history, status: %s", resp.Status)
+	}
+	var hr coinHistoryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&hr); err != nil {
+		return 0, fmt.Errorf("couldn't decode response: %v", err)
+	}
+	price, ok := hr.MarketData.CurrentPrice[fiat]
+	if !ok {
+		return 0, fmt.Errorf("price is missing from response: %#v", hr)
+	}
+	return price, nil
+}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestGetPrice(t *testing.T) {
@@ -55,3 +56,35 @@ func TestGetPrice(t *testing.T) {
 		t.Errorf("unexpected error for mxc/eur: %v", err)
 	}
 }
+
+func TestGetClosePrice(t *testing.T) {
+	m := http.NewServeMux()
+	m.HandleFunc("/coins/mxc/history", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("date") != "02-03-2021" {
+			fmt.Fprintf(w, `{"id":"mxc"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"id":"mxc","market_data":{"current_price":{"usd":0.0231,"eur":0.0192}}}`)
+	})
+	s := httptest.NewServer(m)
+	defer s.Close()
+
+	c := New()
+	c.baseURL = s.URL
+
+	day := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	price, err := c.GetClosePrice("mxc", "eur", day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(price-0.0192) > 1e-9 {
+		t.Errorf("wrong mxc/eur close price: %f", price)
+	}
+
+	if _, err := c.GetClosePrice("mxc", "eur", day.AddDate(0, 0, 1)); err == nil {
+		t.Errorf("expected error for missing price")
+	}
+	if _, err := c.GetClosePrice("btc", "usd", day); err == nil {
+		t.Errorf("expected error for unknown coin")
+	}
+}
@@ -7,11 +7,15 @@ import (
 	multicastsetup "github.com/mxc-foundation/lpwan-app-server/internal/applayer/multicastsetup/data"
 	"github.com/mxc-foundation/lpwan-app-server/internal/bonus"
 	js "github.com/mxc-foundation/lpwan-app-server/internal/codec/js/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/devicedata"
+	"github.com/mxc-foundation/lpwan-app-server/internal/devprovision"
 	"github.com/mxc-foundation/lpwan-app-server/internal/email"
 	fuota "github.com/mxc-foundation/lpwan-app-server/internal/fuota/data"
 	"github.com/mxc-foundation/lpwan-app-server/internal/grpccli"
+	gwping "github.com/mxc-foundation/lpwan-app-server/internal/gwping/data"
 	integration "github.com/mxc-foundation/lpwan-app-server/internal/integration/types"
 	joinserver "github.com/mxc-foundation/lpwan-app-server/internal/js/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
 	as "github.com/mxc-foundation/lpwan-app-server/internal/modules/as/data"
 	gws "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
 	metrics "github.com/mxc-foundation/lpwan-app-server/internal/modules/metrics/data"
@@ -22,6 +26,8 @@ import (
 	"github.com/mxc-foundation/lpwan-app-server/internal/mxpapisrv"
 	oidc "github.com/mxc-foundation/lpwan-app-server/internal/oidc/data"
 	pprof "github.com/mxc-foundation/lpwan-app-server/internal/pprof/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/price"
+	"github.com/mxc-foundation/lpwan-app-server/internal/statement"
 	"github.com/mxc-foundation/lpwan-app-server/internal/storage/pgstore"
 	psconn "github.com/mxc-foundation/lpwan-app-server/internal/types"
 )
@@ -76,6 +82,20 @@ type Config struct {
 		FUOTADeployment fuota.FuotaStruct `mapstructure:"fuota_deployment"`
 
 		MiningSetUp mining.Config `mapstructure:"mining_setup"`
+
+		GatewayPing gwping.Config `mapstructure:"gateway_ping"`
+
+		LeaderElection leader.Config `mapstructure:"leader_election"`
+
+		Statement statement.Config `mapstructure:"statement"`
+
+		Price price.Config `mapstructure:"price"`
+
+		DeviceData devicedata.Config `mapstructure:"device_data"`
+
+		DeviceProvisioning devprovision.Config `mapstructure:"device_provisioning"`
+
+		EmailOutbox email.OutboxConfig `mapstructure:"email_outbox"`
 	} `mapstructure:"application_server"`
 
 	JoinServer joinserver.JoinServerStruct `mapstructure:"join_server"`
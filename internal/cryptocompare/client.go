@@ -0,0 +1,154 @@
+// Package cryptocompare implements a client to CryptoCompare API, see details
+// at https://min-api.cryptocompare.com/documentation
+package cryptocompare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultTTL = 5 * time.Minute
+
+type pair struct {
+	crypto string
+	fiat   string
+}
+
+type cachedPrice struct {
+	price   float64
+	expires time.Time
+}
+
+// Client is the object that provides access to API
+type Client struct {
+	c       *http.Client
+	baseURL string
+	apiKey  string
+	cache   map[pair]cachedPrice
+	muCache sync.RWMutex
+	ttl     time.Duration
+}
+
+// New creates a new Client, apiKey is optional but the requests without the
+// key are limited more strictly
+func New(apiKey string) *Client {
+	return &Client{
+		c:       http.DefaultClient,
+		baseURL: "https://min-api.cryptocompare.com",
+		apiKey:  apiKey,
+		cache:   make(map[pair]cachedPrice),
+		ttl:     defaultTTL,
+	}
+}
+
+// errorResponse is returned by API instead of the data if the request failed
+type errorResponse struct {
+	Response string `json:"Response"`
+	Message  string `json:"Message"`
+}
+
+// GetPrice returns price of the specified crypto currency in the units of the
+// specified fiat currency. CryptoCompare identifies currencies by their
+// symbols, e.g. MXC and USD
+func (c *Client) GetPrice(crypto, fiat string) (float64, error) {
+	p := pair{crypto: strings.ToUpper(crypto), fiat: strings.ToUpper(fiat)}
+	c.muCache.RLock()
+	cached, ok := c.cache[p]
+	c.muCache.RUnlock()
+	if ok && cached.expires.After(time.Now()) {
+		return cached.price, nil
+	}
+
+	v := url.Values{}
+	v.Set("fsym", p.crypto)
+	v.Set("tsyms", p.fiat)
+	var res map[string]json.RawMessage
+	if err := c.get("/data/price", v, &res); err != nil {
+		return 0, err
+	}
+	var price float64
+	if err := json.Unmarshal(res[p.fiat], &price); err != nil {
+		return 0, fmt.Errorf("price is missing from response: %s", res[p.fiat])
+	}
+
+	c.muCache.Lock()
+	c.cache[p] = cachedPrice{
+		price:   price,
+		expires: time.Now().Add(c.ttl),
+	}
+	c.muCache.Unlock()
+	return price, nil
+}
+
+type histoDayResponse struct {
+	Response string `json:"Response"`
+	Message  string `json:"Message"`
+	Data     struct {
+		Data []struct {
+			Time  int64   `json:"time"`
+			Close float64 `json:"close"`
+		} `json:"Data"`
+	} `json:"Data"`
+}
+
+// GetClosePrice returns the close price of the specified crypto currency in
+// the units of the specified fiat currency on the given UTC day
+func (c *Client) GetClosePrice(crypto, fiat string, day time.Time) (float64, error) {
+	day = day.UTC()
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	v := url.Values{}
+	v.Set("fsym", strings.ToUpper(crypto))
+	v.Set("tsym", strings.ToUpper(fiat))
+	v.Set("limit", "1")
+	v.Set("toTs", strconv.FormatInt(start.Unix(), 10))
+	var res histoDayResponse
+	if err := c.get("/data/v2/histoday", v, &res); err != nil {
+		return 0, err
+	}
+	if res.Response != "Success" {
+		return 0, fmt.Errorf("couldn't get price history: %s", res.Message)
+	}
+	for _, d := range res.Data.Data {
+		if d.Time == start.Unix() {
+			return d.Close, nil
+		}
+	}
+	return 0, fmt.Errorf("close price for %s is missing from response", start.Format("2006-01-02"))
+}
+
+func (c *Client) get(path string, v url.Values, res interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+path+"?"+v.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Apikey "+c.apiKey)
+	}
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return fmt.Errorf("couldn't get %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("couldn't get %s, status: %s", path, resp.Status)
+	}
+	var raw json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return fmt.Errorf("couldn't decode response: %v", err)
+	}
+	// errors are returned with status 200
+	var er errorResponse
+	if err := json.Unmarshal(raw, &er); err == nil && er.Response == "Error" {
+		return fmt.Errorf("couldn't get %s: %s", path, er.Message)
+	}
+	if err := json.Unmarshal(raw, res); err != nil {
+		return fmt.Errorf("couldn't decode response: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package cryptocompare
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetPrice(t *testing.T) {
+	var requestsCnt int
+	m := http.NewServeMux()
+	m.HandleFunc("/data/price", func(w http.ResponseWriter, r *http.Request) {
+		requestsCnt++
+		if r.Header.Get("Authorization") != "Apikey secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("fsym") != "MXC" {
+			fmt.Fprintf(w, `{"Response":"Error","Message":"There is no data for the symbol %s ."}`,
+				r.URL.Query().Get("fsym"))
+			return
+		}
+		fmt.Fprintf(w, `{"%s":0.0123}`, r.URL.Query().Get("tsyms"))
+	})
+	s := httptest.NewServer(m)
+	defer s.Close()
+
+	c := New("secret")
+	c.baseURL = s.URL
+
+	price, err := c.GetPrice("mxc", "usd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(price-0.0123) > 1e-9 {
+		t.Errorf("wrong mxc/usd price: %f", price)
+	}
+	// this request should use cached price
+	_, _ = c.GetPrice("mxc", "usd")
+	if requestsCnt > 1 {
+		t.Errorf("expected second mxc/usd request to use cached data")
+	}
+
+	_, err = c.GetPrice("xyz", "usd")
+	if err == nil || !strings.Contains(err.Error(), "no data for the symbol") {
+		t.Errorf("unexpected error for xyz/usd: %v", err)
+	}
+}
+
+func TestGetClosePrice(t *testing.T) {
+	day := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC).Unix()
+	m := http.NewServeMux()
+	m.HandleFunc("/data/v2/histoday", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("toTs") != fmt.Sprintf("%d", start) {
+			fmt.Fprintf(w, `{"Response":"Success","Data":{"Data":[]}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"Response":"Success","Data":{"Data":[{"time":%d,"close":0.021},{"time":%d,"close":0.023}]}}`,
+			start-86400, start)
+	})
+	s := httptest.NewServer(m)
+	defer s.Close()
+
+	c := New("")
+	c.baseURL = s.URL
+
+	price, err := c.GetClosePrice("mxc", "usd", day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(price-0.023) > 1e-9 {
+		t.Errorf("wrong close price: %f", price)
+	}
+	if _, err := c.GetClosePrice("mxc", "usd", day.AddDate(0, 0, 1)); err == nil {
+		t.Errorf("expected error for missing close price")
+	}
+}
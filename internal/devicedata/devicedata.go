@@ -0,0 +1,185 @@
+// Package devicedata provides access to the device events stored by the
+// PostgreSQL integration and removes the events older than the configured TTL
+package devicedata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
+)
+
+// Config contains configuration of the device data service
+type Config struct {
+	// TTL is the time after which the stored events are removed, if not set
+	// the events are kept forever
+	TTL time.Duration `mapstructure:"ttl"`
+}
+
+// Event types stored by the PostgreSQL integration, the names of the tables
+const (
+	TypeUp       = "device_up"
+	TypeStatus   = "device_status"
+	TypeJoin     = "device_join"
+	TypeAck      = "device_ack"
+	TypeError    = "device_error"
+	TypeLocation = "device_location"
+)
+
+// Types is the list of all the event types
+var Types = []string{TypeUp, TypeStatus, TypeJoin, TypeAck, TypeError, TypeLocation}
+
+// Fields are the names of the fields specific to the event types
+var Fields = map[string][]string{
+	TypeUp:       {"frequency", "dr", "adr", "f_cnt", "f_port", "data", "rx_info", "object"},
+	TypeStatus:   {"margin", "external_power_source", "battery_level_unavailable", "battery_level"},
+	TypeJoin:     {"dev_addr"},
+	TypeAck:      {"acknowledged", "f_cnt"},
+	TypeError:    {"type", "error", "f_cnt"},
+	TypeLocation: {"altitude", "latitude", "longitude", "geohash", "accuracy"},
+}
+
+// Filter selects the events to return
+type Filter struct {
+	Type string
+	// ApplicationID selects the events of the application, if DevEUI is set
+	// only the events of the device recorded in this application are
+	// selected
+	DevEUI        *lorawan.EUI64
+	ApplicationID int64
+	// Start and End select the events received in [Start, End), the bounds
+	// are not applied if zero
+	Start time.Time
+	End   time.Time
+	// Ascending returns the oldest events first
+	Ascending bool
+	Limit     int64
+	Offset    int64
+}
+
+// Record is the event stored by the integration
+type Record struct {
+	ID              uuid.UUID
+	ReceivedAt      time.Time
+	DevEUI          lorawan.EUI64
+	DeviceName      string
+	ApplicationID   int64
+	ApplicationName string
+	Tags            map[string]string
+	// Fields is JSON object with the fields specific to the event type, the
+	// payload is base64 and the device address hex encoded
+	Fields json.RawMessage
+}
+
+// DeleteFilter selects the events to delete
+type DeleteFilter struct {
+	// ApplicationIDs and DevEUIs select the events of the applications and
+	// of the devices, at least one of them must be set
+	ApplicationIDs []int64
+	DevEUIs        []lorawan.EUI64
+	// Before selects the events received before the time, all the events
+	// are selected if zero
+	Before time.Time
+}
+
+// Store provides access to the events stored by the PostgreSQL integration
+type Store interface {
+	// GetDeviceDataCount returns the number of the events matching the
+	// filter
+	GetDeviceDataCount(ctx context.Context, filter Filter) (int64, error)
+	// GetDeviceData returns the events matching the filter
+	GetDeviceData(ctx context.Context, filter Filter) ([]Record, error)
+	// DeleteDeviceDataBefore deletes the events of the type received
+	// before the given time and returns the number of deleted events
+	DeleteDeviceDataBefore(ctx context.Context, eventType string, before time.Time) (int64, error)
+	// DeleteDeviceData deletes the events of the type matching the filter
+	// and returns the number of deleted events
+	DeleteDeviceData(ctx context.Context, eventType string, filter DeleteFilter) (int64, error)
+}
+
+// DeleteAllTypes deletes the events of all the types matching the filter and
+// returns the number of deleted events of each type
+func DeleteAllTypes(ctx context.Context, store Store, filter DeleteFilter) (map[string]int64, error) {
+	deleted := make(map[string]int64)
+	if len(filter.ApplicationIDs) == 0 && len(filter.DevEUIs) == 0 {
+		return deleted, nil
+	}
+	for _, t := range Types {
+		n, err := store.DeleteDeviceData(ctx, t, filter)
+		if err != nil {
+			return deleted, fmt.Errorf("couldn't delete events from %s: %w", t, err)
+		}
+		deleted[t] = n
+	}
+	return deleted, nil
+}
+
+// Service removes the events older than TTL
+type Service struct {
+	cfg   Config
+	store Store
+	done  chan struct{}
+}
+
+// Start starts the removal of the old events, if TTL is not set or the store
+// is not available it returns nil
+func Start(cfg Config, store Store) *Service {
+	if cfg.TTL <= 0 || store == nil {
+		return nil
+	}
+	srv := &Service{
+		cfg:   cfg,
+		store: store,
+		done:  make(chan struct{}),
+	}
+	go srv.run()
+	return srv
+}
+
+// Stop stops the service
+func (srv *Service) Stop() {
+	if srv == nil {
+		return
+	}
+	srv.done <- struct{}{}
+	close(srv.done)
+}
+
+// leaderJob is the name of the job in the leader election
+const leaderJob = "device-data-retention"
+
+func (srv *Service) run() {
+	for {
+		wait := time.Until(time.Now().Truncate(time.Hour).Add(time.Hour))
+		select {
+		case <-time.After(wait):
+			if !leader.IsLeader(leaderJob) {
+				continue
+			}
+			srv.removeExpired(context.Background(), time.Now())
+		case <-srv.done:
+			return
+		}
+	}
+}
+
+// removeExpired removes the events of all types older than TTL
+func (srv *Service) removeExpired(ctx context.Context, now time.Time) {
+	before := now.Add(-srv.cfg.TTL)
+	for _, t := range Types {
+		n, err := srv.store.DeleteDeviceDataBefore(ctx, t, before)
+		if err != nil {
+			logrus.WithError(err).Errorf("devicedata: couldn't remove expired events from %s", t)
+			continue
+		}
+		if n > 0 {
+			logrus.Infof("devicedata: removed %d expired events from %s", n, t)
+		}
+	}
+}
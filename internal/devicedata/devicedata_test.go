@@ -0,0 +1,222 @@
+package devicedata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	appd "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
+	device "github.com/mxc-foundation/lpwan-app-server/internal/modules/device/data"
+)
+
+type testStore struct {
+	records []Record
+	filters []Filter
+	deleted map[string]time.Time
+	// deletedBy are the filters of the deleted events
+	deletedBy map[string]DeleteFilter
+}
+
+func (ts *testStore) GetDeviceDataCount(ctx context.Context, filter Filter) (int64, error) {
+	return int64(len(ts.records)), nil
+}
+
+func (ts *testStore) GetDeviceData(ctx context.Context, filter Filter) ([]Record, error) {
+	ts.filters = append(ts.filters, filter)
+	if filter.Offset >= int64(len(ts.records)) {
+		return nil, nil
+	}
+	end := filter.Offset + filter.Limit
+	if end > int64(len(ts.records)) {
+		end = int64(len(ts.records))
+	}
+	return ts.records[filter.Offset:end], nil
+}
+
+func (ts *testStore) DeleteDeviceDataBefore(ctx context.Context, eventType string, before time.Time) (int64, error) {
+	if eventType == TypeJoin {
+		return 0, fmt.Errorf("table doesn't exist")
+	}
+	ts.deleted[eventType] = before
+	return 1, nil
+}
+
+func (ts *testStore) DeleteDeviceData(ctx context.Context, eventType string, filter DeleteFilter) (int64, error) {
+	ts.deletedBy[eventType] = filter
+	return 2, nil
+}
+
+var testDevEUI = lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+type testAppStore struct{}
+
+func (tas *testAppStore) GetDevice(ctx context.Context, devEUI lorawan.EUI64, forUpdate bool) (device.Device, error) {
+	if devEUI != testDevEUI {
+		return device.Device{}, errHandler.ErrDoesNotExist
+	}
+	return device.Device{DevEUI: devEUI, ApplicationID: 3}, nil
+}
+
+func (tas *testAppStore) GetApplication(ctx context.Context, id int64) (appd.Application, error) {
+	return appd.Application{ID: id, OrganizationID: id * 10}, nil
+}
+
+type testAuth struct {
+	orgID int64
+	// rolePermissions are the permissions of the custom role of the user,
+	// if not set the user has the built-in role of the organization user
+	rolePermissions auth.Permissions
+}
+
+func (ta *testAuth) GetCredentials(ctx context.Context, opts *auth.Options) (*auth.Credentials, error) {
+	cred := &auth.Credentials{IsOrgUser: opts.OrgID == ta.orgID}
+	if cred.IsOrgUser && ta.rolePermissions != 0 {
+		cred.RoleID = 1
+		cred.RolePermissions = ta.rolePermissions
+	}
+	return cred, nil
+}
+
+type testExportServer struct {
+	grpc.ServerStream
+	data bytes.Buffer
+}
+
+func (s *testExportServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *testExportServer) Send(resp *api.ExportDeviceDataResponse) error {
+	s.data.Write(resp.Data)
+	return nil
+}
+
+func TestRemoveExpired(t *testing.T) {
+	st := &testStore{deleted: make(map[string]time.Time)}
+	srv := &Service{cfg: Config{TTL: 24 * time.Hour}, store: st}
+	now := time.Date(2021, 3, 3, 15, 0, 0, 0, time.UTC)
+	srv.removeExpired(context.Background(), now)
+	// failure for one type doesn't prevent removal of the others
+	if len(st.deleted) != len(Types)-1 {
+		t.Errorf("expected events of %d types removed, got %v", len(Types)-1, st.deleted)
+	}
+	if !st.deleted[TypeUp].Equal(now.Add(-24 * time.Hour)) {
+		t.Errorf("unexpected time: %s", st.deleted[TypeUp])
+	}
+
+	if Start(Config{}, st) != nil {
+		t.Errorf("service should not be started without ttl")
+	}
+}
+
+func TestDeleteAllTypes(t *testing.T) {
+	st := &testStore{deletedBy: make(map[string]DeleteFilter)}
+	deleted, err := DeleteAllTypes(context.Background(), st, DeleteFilter{})
+	if err != nil || len(deleted) != 0 || len(st.deletedBy) != 0 {
+		t.Errorf("nothing should be deleted without applications and devices: %v, %v", deleted, err)
+	}
+	filter := DeleteFilter{DevEUIs: []lorawan.EUI64{testDevEUI}}
+	deleted, err = DeleteAllTypes(context.Background(), st, filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != len(Types) || deleted[TypeLocation] != 2 {
+		t.Errorf("unexpected deleted events: %v", deleted)
+	}
+	if st.deletedBy[TypeUp].DevEUIs[0] != testDevEUI {
+		t.Errorf("unexpected filter: %+v", st.deletedBy[TypeUp])
+	}
+}
+
+func TestList(t *testing.T) {
+	st := &testStore{records: []Record{{ID: uuid.Must(uuid.NewV4()), DevEUI: testDevEUI, Fields: json.RawMessage(`{}`)}}}
+	srv := NewServer(st, &testAppStore{}, &testAuth{orgID: 30})
+
+	resp, err := srv.List(context.Background(), &api.ListDeviceDataRequest{
+		Type:   api.DeviceDataType_DEVICE_DATA_STATUS,
+		DevEui: testDevEUI.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.TotalCount != 1 || len(resp.Result) != 1 || resp.Result[0].DevEui != testDevEUI.String() {
+		t.Errorf("unexpected response: %v", resp)
+	}
+	filter := st.filters[0]
+	if filter.Type != TypeStatus || filter.DevEUI == nil || *filter.DevEUI != testDevEUI ||
+		filter.ApplicationID != 3 || filter.Limit != defaultLimit {
+		t.Errorf("unexpected filter: %+v", filter)
+	}
+
+	// the application belongs to another organization
+	_, err = srv.List(context.Background(), &api.ListDeviceDataRequest{ApplicationId: 4})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected permission denied, got %v", err)
+	}
+
+	// the role of the user doesn't allow to read the device data
+	srv = NewServer(st, &testAppStore{}, &testAuth{orgID: 30, rolePermissions: auth.Permissions(0).With(auth.PermFinanceRead)})
+	_, err = srv.List(context.Background(), &api.ListDeviceDataRequest{DevEui: testDevEUI.String()})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected permission denied, got %v", err)
+	}
+
+	srv = NewServer(nil, &testAppStore{}, &testAuth{orgID: 30})
+	_, err = srv.List(context.Background(), &api.ListDeviceDataRequest{ApplicationId: 3})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected failed precondition, got %v", err)
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	receivedAt := time.Date(2021, 3, 3, 15, 4, 5, 0, time.UTC)
+	st := &testStore{}
+	for i := 0; i < exportBatchSize+1; i++ {
+		st.records = append(st.records, Record{
+			ID:              uuid.Must(uuid.FromString("5d3b4b2d-3c2e-4a56-8a6f-4f4e7a2b9c1d")),
+			ReceivedAt:      receivedAt,
+			DevEUI:          testDevEUI,
+			DeviceName:      "sensor",
+			ApplicationID:   3,
+			ApplicationName: "app",
+			Tags:            map[string]string{"b": "2", "a": "1"},
+			Fields: json.RawMessage(`{"frequency": 868100000, "dr": 5, "adr": true, "f_cnt": 10, "f_port": 2,
+				"data": "AQI=", "rx_info": null, "object": {"temperature": 21.5, "note": "a,b"}}`),
+		})
+	}
+	srv := NewServer(st, &testAppStore{}, &testAuth{orgID: 30})
+	stream := &testExportServer{}
+	if err := srv.ExportCSV(&api.ExportDeviceDataRequest{ApplicationId: 3}, stream); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := bytes.Split(bytes.TrimSpace(stream.data.Bytes()), []byte("\n"))
+	if len(lines) != exportBatchSize+2 {
+		t.Fatalf("expected %d lines, got %d", exportBatchSize+2, len(lines))
+	}
+	expectedHeader := "id,received_at,dev_eui,device_name,application_id,application_name," +
+		"frequency,dr,adr,f_cnt,f_port,data,rx_info,object,tags"
+	if string(lines[0]) != expectedHeader {
+		t.Errorf("unexpected header: %s", lines[0])
+	}
+	expectedRow := `5d3b4b2d-3c2e-4a56-8a6f-4f4e7a2b9c1d,2021-03-03T15:04:05Z,0102030405060708,sensor,3,app,` +
+		`868100000,5,true,10,2,AQI=,,"{""temperature"":21.5,""note"":""a,b""}",a=1;b=2`
+	if string(lines[1]) != expectedRow {
+		t.Errorf("unexpected row:\n%s\nexpected:\n%s", lines[1], expectedRow)
+	}
+	if len(st.filters) != 2 || !st.filters[0].Ascending || st.filters[0].End.IsZero() {
+		t.Errorf("unexpected filters: %+v", st.filters)
+	}
+}
@@ -0,0 +1,269 @@
+package devicedata
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	appd "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
+	device "github.com/mxc-foundation/lpwan-app-server/internal/modules/device/data"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+	// exportBatchSize is the number of events read from the DB at once
+	// during the export
+	exportBatchSize = 1000
+	// chunkSize is the size of the data sent in one message of the stream
+	chunkSize = 65535
+)
+
+// AppStore provides the applications and devices the events belong to
+type AppStore interface {
+	GetDevice(ctx context.Context, devEUI lorawan.EUI64, forUpdate bool) (device.Device, error)
+	GetApplication(ctx context.Context, id int64) (appd.Application, error)
+}
+
+// Server implements the device data service API
+type Server struct {
+	store    Store
+	appStore AppStore
+	auth     auth.Authenticator
+}
+
+// NewServer creates a new device data service server. If the store is nil,
+// i.e. the PostgreSQL integration is not enabled, all the requests fail
+func NewServer(store Store, appStore AppStore, auth auth.Authenticator) *Server {
+	return &Server{
+		store:    store,
+		appStore: appStore,
+		auth:     auth,
+	}
+}
+
+var eventTypes = map[api.DeviceDataType]string{
+	api.DeviceDataType_DEVICE_DATA_UP:       TypeUp,
+	api.DeviceDataType_DEVICE_DATA_STATUS:   TypeStatus,
+	api.DeviceDataType_DEVICE_DATA_JOIN:     TypeJoin,
+	api.DeviceDataType_DEVICE_DATA_ACK:      TypeAck,
+	api.DeviceDataType_DEVICE_DATA_ERROR:    TypeError,
+	api.DeviceDataType_DEVICE_DATA_LOCATION: TypeLocation,
+}
+
+// getFilter checks that the user is allowed to access the events of the
+// device or the application and returns the filter selecting them
+func (a *Server) getFilter(ctx context.Context, dataType api.DeviceDataType, devEUI string,
+	applicationID int64, start, end *timestamppb.Timestamp) (Filter, error) {
+	if a.store == nil {
+		return Filter{}, status.Errorf(codes.FailedPrecondition, "postgresql integration is not enabled")
+	}
+	filter := Filter{Type: eventTypes[dataType]}
+	if filter.Type == "" {
+		return filter, status.Errorf(codes.InvalidArgument, "unknown type: %v", dataType)
+	}
+	if devEUI != "" {
+		var eui lorawan.EUI64
+		if err := eui.UnmarshalText([]byte(devEUI)); err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid dev eui: %v", err)
+		}
+		d, err := a.appStore.GetDevice(ctx, eui, false)
+		if err != nil {
+			return filter, helpers.ErrToRPCError(err)
+		}
+		// the events recorded while the device belonged to another
+		// application must not be returned
+		filter.DevEUI = &eui
+		applicationID = d.ApplicationID
+	}
+	filter.ApplicationID = applicationID
+	app, err := a.appStore.GetApplication(ctx, applicationID)
+	if err != nil {
+		return filter, helpers.ErrToRPCError(err)
+	}
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(app.OrganizationID))
+	if err != nil {
+		return filter, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.Has(auth.PermDeviceRead) {
+		return filter, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	if start != nil {
+		if err := start.CheckValid(); err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid start: %v", err)
+		}
+		filter.Start = start.AsTime()
+	}
+	if end != nil {
+		if err := end.CheckValid(); err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid end: %v", err)
+		}
+		filter.End = end.AsTime()
+	}
+	return filter, nil
+}
+
+// List returns the events of the device or the application, the newest
+// events first
+func (a *Server) List(ctx context.Context, req *api.ListDeviceDataRequest) (*api.ListDeviceDataResponse, error) {
+	filter, err := a.getFilter(ctx, req.Type, req.DevEui, req.ApplicationId, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	filter.Limit = req.Limit
+	if filter.Limit == 0 {
+		filter.Limit = defaultLimit
+	}
+	if filter.Limit > maxLimit {
+		filter.Limit = maxLimit
+	}
+	filter.Offset = req.Offset
+
+	count, err := a.store.GetDeviceDataCount(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "couldn't get events: %v", err)
+	}
+	records, err := a.store.GetDeviceData(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "couldn't get events: %v", err)
+	}
+	resp := &api.ListDeviceDataResponse{TotalCount: count}
+	for _, r := range records {
+		resp.Result = append(resp.Result, &api.DeviceDataRecord{
+			Id:              r.ID.String(),
+			ReceivedAt:      timestamppb.New(r.ReceivedAt),
+			DevEui:          r.DevEUI.String(),
+			DeviceName:      r.DeviceName,
+			ApplicationId:   r.ApplicationID,
+			ApplicationName: r.ApplicationName,
+			Tags:            r.Tags,
+			FieldsJson:      string(r.Fields),
+		})
+	}
+	return resp, nil
+}
+
+// ExportCSV returns the events of the device or the application in CSV
+// format, the oldest events first
+func (a *Server) ExportCSV(req *api.ExportDeviceDataRequest, srv api.DeviceDataService_ExportCSVServer) error {
+	ctx := srv.Context()
+	filter, err := a.getFilter(ctx, req.Type, req.DevEui, req.ApplicationId, req.Start, req.End)
+	if err != nil {
+		return err
+	}
+	filter.Ascending = true
+	filter.Limit = exportBatchSize
+	// the events received after the export has started are not exported
+	if filter.End.IsZero() {
+		filter.End = time.Now()
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(csvHeader(filter.Type)); err != nil {
+		return status.Errorf(codes.Internal, "couldn't write csv: %v", err)
+	}
+	for {
+		records, err := a.store.GetDeviceData(ctx, filter)
+		if err != nil {
+			return status.Errorf(codes.Internal, "couldn't get events: %v", err)
+		}
+		for _, r := range records {
+			row, err := csvRow(filter.Type, r)
+			if err != nil {
+				return status.Errorf(codes.Internal, "couldn't format event %s: %v", r.ID, err)
+			}
+			if err := w.Write(row); err != nil {
+				return status.Errorf(codes.Internal, "couldn't write csv: %v", err)
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return status.Errorf(codes.Internal, "couldn't write csv: %v", err)
+		}
+		for buf.Len() >= chunkSize {
+			if err := srv.Send(&api.ExportDeviceDataResponse{Data: buf.Next(chunkSize)}); err != nil {
+				return err
+			}
+		}
+		if int64(len(records)) < filter.Limit {
+			break
+		}
+		filter.Offset += filter.Limit
+	}
+	if buf.Len() > 0 {
+		return srv.Send(&api.ExportDeviceDataResponse{Data: buf.Bytes()})
+	}
+	return nil
+}
+
+var csvCommonHeader = []string{"id", "received_at", "dev_eui", "device_name", "application_id", "application_name"}
+
+func csvHeader(eventType string) []string {
+	header := append([]string{}, csvCommonHeader...)
+	header = append(header, Fields[eventType]...)
+	return append(header, "tags")
+}
+
+// csvRow returns the event as a CSV row, the objects such as the decoded
+// uplink object are written as JSON and the tags as key=value pairs separated
+// by semicolons
+func csvRow(eventType string, r Record) ([]string, error) {
+	row := []string{
+		r.ID.String(),
+		r.ReceivedAt.UTC().Format(time.RFC3339Nano),
+		r.DevEUI.String(),
+		r.DeviceName,
+		strconv.FormatInt(r.ApplicationID, 10),
+		r.ApplicationName,
+	}
+	fields := make(map[string]json.RawMessage)
+	if len(r.Fields) > 0 {
+		if err := json.Unmarshal(r.Fields, &fields); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range Fields[eventType] {
+		row = append(row, csvValue(fields[name]))
+	}
+
+	var tags []string
+	for k, v := range r.Tags {
+		tags = append(tags, k+"="+v)
+	}
+	sort.Strings(tags)
+	return append(row, strings.Join(tags, ";")), nil
+}
+
+// csvValue returns JSON strings unquoted and the other values as JSON
+func csvValue(v json.RawMessage) string {
+	if len(v) == 0 || string(v) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return s
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, v); err != nil {
+		return string(v)
+	}
+	return buf.String()
+}
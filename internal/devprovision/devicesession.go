@@ -3,6 +3,7 @@ package devprovision
 import (
 	"crypto/aes"
 	cryptorand "crypto/rand"
+	"encoding/json"
 	"time"
 
 	"github.com/jacobsa/crypto/cmac"
@@ -27,7 +28,6 @@ type deviceSession struct {
 	nwkKey           []byte
 	provKey          []byte
 	expireTime       time.Time
-	lastGwContext    []byte
 
 	ecdhK223 ecdh.K233
 }
@@ -52,6 +52,64 @@ func makeDeviceSession(sessionLifecycle time.Duration) deviceSession {
 	return session
 }
 
+// storedSession is the device session as saved in the session store
+type storedSession struct {
+	RDevEui          []byte    `json:"r_dev_eui"`
+	ServerNonce      []byte    `json:"server_nonce"`
+	DevNonce         []byte    `json:"dev_nonce"`
+	DevicePublicKey  []byte    `json:"device_public_key"`
+	ServerPublicKey  []byte    `json:"server_public_key"`
+	ServerPrivateKey []byte    `json:"server_private_key"`
+	SharedKey        []byte    `json:"shared_key"`
+	AssignedDevEui   []byte    `json:"assigned_dev_eui"`
+	AssignedAppEui   []byte    `json:"assigned_app_eui"`
+	AppKey           []byte    `json:"app_key"`
+	NwkKey           []byte    `json:"nwk_key"`
+	ProvKey          []byte    `json:"prov_key"`
+	ExpireTime       time.Time `json:"expire_time"`
+}
+
+func (d *deviceSession) marshal() ([]byte, error) {
+	return json.Marshal(storedSession{
+		RDevEui:          d.rDevEui,
+		ServerNonce:      d.serverNonce,
+		DevNonce:         d.devNonce,
+		DevicePublicKey:  d.devicePublicKey,
+		ServerPublicKey:  d.serverPublicKey,
+		ServerPrivateKey: d.serverPrivateKey,
+		SharedKey:        d.sharedKey,
+		AssignedDevEui:   d.assignedDevEui,
+		AssignedAppEui:   d.assignedAppEui,
+		AppKey:           d.appKey,
+		NwkKey:           d.nwkKey,
+		ProvKey:          d.provKey,
+		ExpireTime:       d.expireTime,
+	})
+}
+
+func unmarshalDeviceSession(data []byte) (deviceSession, error) {
+	var s storedSession
+	if err := json.Unmarshal(data, &s); err != nil {
+		return deviceSession{}, err
+	}
+	return deviceSession{
+		rDevEui:          s.RDevEui,
+		serverNonce:      s.ServerNonce,
+		devNonce:         s.DevNonce,
+		devicePublicKey:  s.DevicePublicKey,
+		serverPublicKey:  s.ServerPublicKey,
+		serverPrivateKey: s.ServerPrivateKey,
+		sharedKey:        s.SharedKey,
+		assignedDevEui:   s.AssignedDevEui,
+		assignedAppEui:   s.AssignedAppEui,
+		appKey:           s.AppKey,
+		nwkKey:           s.NwkKey,
+		provKey:          s.ProvKey,
+		expireTime:       s.ExpireTime,
+		ecdhK223:         ecdh.K233{},
+	}, nil
+}
+
 // Gen 128 bytes of random numbers
 func gen128Rand() ([]byte, error) {
 	randbuf := make([]byte, 128)
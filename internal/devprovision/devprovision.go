@@ -3,9 +3,11 @@ package devprovision
 import (
 	"bytes"
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	cryptorand "crypto/rand"
 	"encoding/binary"
 	"encoding/hex"
-	"sync"
 	"time"
 
 	"github.com/jacobsa/crypto/cmac"
@@ -22,8 +24,6 @@ import (
 	nsd "github.com/mxc-foundation/lpwan-app-server/internal/api/external/ns"
 	"github.com/mxc-foundation/lpwan-app-server/internal/devprovision/ecdh"
 	gwd "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
-	"github.com/mxc-foundation/lpwan-app-server/internal/nscli"
-	"github.com/mxc-foundation/lpwan-app-server/internal/pscli"
 )
 
 // LoRa Frame Message and Response Type
@@ -53,12 +53,37 @@ type proprietaryPayload struct {
 	Mic             []byte
 }
 
+// Session store backends
+const (
+	SessionStoreMemory = "memory"
+	SessionStoreRedis  = "redis"
+)
+
+// Config contains configuration of the device provisioning
+type Config struct {
+	// SessionStore is the backend keeping the provisioning sessions, either
+	// memory or redis. The memory store can be used only when running a
+	// single replica
+	SessionStore string `mapstructure:"session_store"`
+	// SessionEncryptionKey is the hex encoded AES key (16, 24 or 32 bytes)
+	// used to encrypt the sessions in the store, it is required when the
+	// sessions are stored in redis
+	SessionEncryptionKey string `mapstructure:"session_encryption_key"`
+	// MaxSessions is the max number of the active sessions, 5000 if not set
+	MaxSessions int `mapstructure:"max_sessions"`
+	// SessionLifetime is the time after which the session expires, 5
+	// minutes if not set
+	SessionLifetime time.Duration `mapstructure:"session_lifetime"`
+}
+
 // DeviceSessionList defines a struct maintaining device session information, this data shall be stored in app package
 // on start and shared between as and devprovision packages
 type DeviceSessionList struct {
-	maxNumberOfDevSession  int //5000
-	sessionlist            map[uint64]deviceSession
-	mutexSessionList       sync.RWMutex
+	store SessionStore
+	// aead encrypts the sessions in the store, if nil the sessions are
+	// stored unencrypted
+	aead                   cipher.AEAD
+	maxNumberOfDevSession  int           //5000
 	deviceSessionLifeCycle time.Duration //time.Minute * 5
 }
 
@@ -85,31 +110,56 @@ func (c *controller) sendToNs(networkServerID int64, req *nsPb.SendDelayedPropri
 	return nil
 }
 
-// Start prepares device provisioning service module
-func Start(psCli *pscli.Client, nsCli *nscli.Client) (*DeviceSessionList, error) {
+// Start prepares device provisioning service module. The sessions are kept
+// in the given store, if the store is nil they are kept in memory.
+func Start(cfg Config, store SessionStore) (*DeviceSessionList, error) {
 	devSessionList := &DeviceSessionList{
-		sessionlist:            make(map[uint64]deviceSession),
-		mutexSessionList:       sync.RWMutex{},
-		maxNumberOfDevSession:  5000,
-		deviceSessionLifeCycle: time.Minute * 5,
+		store:                  store,
+		maxNumberOfDevSession:  cfg.MaxSessions,
+		deviceSessionLifeCycle: cfg.SessionLifetime,
 	}
-	ctrl := &controller{
-		nsCli:          nsCli,
-		psCli:          psCli.GetDeviceProvisionServiceClient(),
-		devSessionList: devSessionList,
+	if devSessionList.maxNumberOfDevSession <= 0 {
+		devSessionList.maxNumberOfDevSession = 5000
+	}
+	if devSessionList.deviceSessionLifeCycle <= 0 {
+		devSessionList.deviceSessionLifeCycle = time.Minute * 5
+	}
+	if cfg.SessionEncryptionKey != "" {
+		aead, err := newSessionAEAD(cfg.SessionEncryptionKey)
+		if err != nil {
+			return nil, err
+		}
+		devSessionList.aead = aead
+	} else if store != nil {
+		return nil, errors.New("session_encryption_key must be set to store device provisioning sessions outside of the process")
+	}
+	if store == nil {
+		memStore := newMemorySessionStore()
+		devSessionList.store = memStore
+		go cleanUpLoop(memStore)
 	}
-
-	ctrl.devSessionList.clearDeviceSessionList()
-
-	go ctrl.cleanUpLoop()
 
 	return devSessionList, nil
 }
 
-// cleanUpLoop is a never returning function, performing cleanup
-func (c *controller) cleanUpLoop() {
+// newSessionAEAD returns AES-GCM cipher using the hex encoded key
+func newSessionAEAD(hexKey string) (cipher.AEAD, error) {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid session_encryption_key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid session_encryption_key")
+	}
+	return cipher.NewGCM(block)
+}
+
+// cleanUpLoop is a never returning function, removing the expired sessions
+// from the memory store
+func cleanUpLoop(store *memorySessionStore) {
 	for {
-		c.devSessionList.clearExpiredDevSession()
+		store.removeExpired(time.Now())
 		time.Sleep(time.Second * 10)
 	}
 }
@@ -266,7 +316,6 @@ func (c *controller) handleHello(ctx context.Context, nID int64, req *as.HandleP
 	targetgateway *gwV3.UplinkRXInfo) error {
 	logrus.Debug("  HELLO Message.")
 
-	var err error
 	var frameversion byte
 
 	rdeveui := make([]byte, 8)
@@ -275,7 +324,10 @@ func (c *controller) handleHello(ctx context.Context, nID int64, req *as.HandleP
 	logrus.Debugf("  sessionid=%X", sessionid)
 	frameversion = req.MacPayload[73]
 
-	ok, currentsession := c.devSessionList.searchDeviceSession(sessionid)
+	ok, currentsession, err := c.devSessionList.searchDeviceSession(ctx, sessionid)
+	if err != nil {
+		return err
+	}
 	if !ok {
 		rdeveui := make([]byte, 8)
 		devicepublickey := make([]byte, ecdh.K233PubKeySize)
@@ -283,7 +335,10 @@ func (c *controller) handleHello(ctx context.Context, nID int64, req *as.HandleP
 		logrus.Debugf("  Creating new session")
 		copy(rdeveui[0:], req.MacPayload[1:])
 		copy(devicepublickey[0:], req.MacPayload[9:])
-		ok, currentsession = c.devSessionList.createDeviceSession(sessionid, rdeveui, devicepublickey)
+		ok, currentsession, err = c.devSessionList.createDeviceSession(ctx, sessionid, rdeveui, devicepublickey)
+		if err != nil {
+			return err
+		}
 		if !ok {
 			// Create session failed. drop this frame. return true to mark is processed.
 			logrus.Errorf("create session failed")
@@ -292,7 +347,10 @@ func (c *controller) handleHello(ctx context.Context, nID int64, req *as.HandleP
 	}
 
 	// Drop if already sent to the same Gateway context
-	ok, currentsession = c.devSessionList.checkDeviceSession(sessionid, targetgateway.Context)
+	ok, err = c.devSessionList.checkDeviceSession(ctx, sessionid, targetgateway.Context)
+	if err != nil {
+		return err
+	}
 	if !ok {
 		return nil
 	}
@@ -361,14 +419,20 @@ func (c *controller) handleAuth(ctx context.Context, nID int64, req *as.HandlePr
 	logrus.Debugf("  sessionid=%X", sessionid)
 
 	//
-	ok, currentsession := c.devSessionList.searchDeviceSession(sessionid)
+	ok, currentsession, err := c.devSessionList.searchDeviceSession(ctx, sessionid)
+	if err != nil {
+		return err
+	}
 	if !ok {
 		logrus.Debugf("  Auth message without active session. Frame dropped.")
 		return nil
 	}
 
 	// Drop if already sent to the same Gateway context
-	ok, currentsession = c.devSessionList.checkDeviceSession(sessionid, targetgateway.Context)
+	ok, err = c.devSessionList.checkDeviceSession(ctx, sessionid, targetgateway.Context)
+	if err != nil {
+		return err
+	}
 	if !ok {
 		return nil
 	}
@@ -418,7 +482,9 @@ func (c *controller) handleAuth(ctx context.Context, nID int64, req *as.HandlePr
 		if err != nil {
 			return errors.Wrap(err, "updateDevice error")
 		}
-		c.devSessionList.updateDeviceSession(sessionid, currentsession)
+		if err := c.devSessionList.updateDeviceSession(ctx, sessionid, currentsession); err != nil {
+			return err
+		}
 
 		err = saveDevice(ctx, deviceinfo, c.psCli)
 		if err != nil {
@@ -448,7 +514,7 @@ func (c *controller) handleAuth(ctx context.Context, nID int64, req *as.HandlePr
 	}
 	// logrus.Debugf("Tx MacPayload:\n%s", hex.Dump(payload.MacPayload))
 
-	err := c.sendProprietary(nID, payload)
+	err = c.sendProprietary(nID, payload)
 	if err != nil {
 		return err
 	}
@@ -457,58 +523,83 @@ func (c *controller) handleAuth(ctx context.Context, nID int64, req *as.HandlePr
 }
 
 // Device session handling
-func (l *DeviceSessionList) searchDeviceSession(sessionid uint64) (bool, deviceSession) {
-	l.mutexSessionList.Lock()
-	currentsession, sessionfound := l.sessionlist[sessionid]
-	l.mutexSessionList.Unlock()
 
-	if !sessionfound {
-		return false, deviceSession{}
-	}
-	return true, currentsession
+// sessionAdditionalData binds the encrypted session to its id, so the data of
+// one session can't be used for another one
+func sessionAdditionalData(sessionid uint64) []byte {
+	ad := make([]byte, 8)
+	binary.BigEndian.PutUint64(ad, sessionid)
+	return ad
 }
 
-func (l *DeviceSessionList) updateDeviceSession(sessionid uint64, newsession deviceSession) {
-	l.mutexSessionList.Lock()
-	_, sessionfound := l.sessionlist[sessionid]
-	l.mutexSessionList.Unlock()
-
-	if sessionfound {
-		l.sessionlist[sessionid] = newsession
+func (l *DeviceSessionList) encodeSession(sessionid uint64, session deviceSession) ([]byte, error) {
+	data, err := session.marshal()
+	if err != nil {
+		return nil, err
+	}
+	if l.aead == nil {
+		return data, nil
 	}
+	nonce := make([]byte, l.aead.NonceSize())
+	if _, err := cryptorand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return l.aead.Seal(nonce, nonce, data, sessionAdditionalData(sessionid)), nil
 }
 
-func (l *DeviceSessionList) checkDeviceSession(sessionid uint64, gwcontext []byte) (bool, deviceSession) {
-	l.mutexSessionList.Lock()
-	defer l.mutexSessionList.Unlock()
-
-	currentsession, sessionfound := l.sessionlist[sessionid]
-	if !sessionfound {
-		return false, deviceSession{}
+func (l *DeviceSessionList) decodeSession(sessionid uint64, data []byte) (deviceSession, error) {
+	if l.aead != nil {
+		if len(data) < l.aead.NonceSize() {
+			return deviceSession{}, errors.New("encrypted session is too short")
+		}
+		nonce, ciphertext := data[:l.aead.NonceSize()], data[l.aead.NonceSize():]
+		var err error
+		data, err = l.aead.Open(nil, nonce, ciphertext, sessionAdditionalData(sessionid))
+		if err != nil {
+			return deviceSession{}, errors.Wrap(err, "decrypt session error")
+		}
 	}
+	return unmarshalDeviceSession(data)
+}
 
-	if bytes.Equal(currentsession.lastGwContext, gwcontext) {
-		// Same gateway context already handled, retrun false to cause the frame being drop
-		return false, deviceSession{}
+func (l *DeviceSessionList) searchDeviceSession(ctx context.Context, sessionid uint64) (bool, deviceSession, error) {
+	data, err := l.store.GetSession(ctx, sessionid)
+	if err != nil {
+		return false, deviceSession{}, errors.Wrap(err, "get session error")
 	}
-
-	// Save gateway context
-	currentsession.lastGwContext = make([]byte, len(gwcontext))
-	copy(currentsession.lastGwContext[:], gwcontext)
-	l.sessionlist[sessionid] = currentsession
-
-	return true, currentsession
+	if data == nil {
+		return false, deviceSession{}, nil
+	}
+	currentsession, err := l.decodeSession(sessionid, data)
+	if err != nil {
+		return false, deviceSession{}, err
+	}
+	return true, currentsession, nil
 }
 
-func (l *DeviceSessionList) createDeviceSession(sessionid uint64, rdeveui []byte, devicepublickey []byte) (bool, deviceSession) {
-	l.mutexSessionList.Lock()
-	defer l.mutexSessionList.Unlock()
+func (l *DeviceSessionList) updateDeviceSession(ctx context.Context, sessionid uint64, newsession deviceSession) error {
+	data, err := l.encodeSession(sessionid, newsession)
+	if err != nil {
+		return err
+	}
+	if err := l.store.UpdateSession(ctx, sessionid, data); err != nil {
+		return errors.Wrap(err, "update session error")
+	}
+	return nil
+}
 
-	if len(l.sessionlist) >= l.maxNumberOfDevSession {
-		logrus.Warnf("Maximum number (%d) of device provisioning session reached. Request dropped.", l.maxNumberOfDevSession)
-		return false, deviceSession{}
+// checkDeviceSession returns false if the frame received through the gateway
+// context has been handled already
+func (l *DeviceSessionList) checkDeviceSession(ctx context.Context, sessionid uint64, gwcontext []byte) (bool, error) {
+	ok, err := l.store.SetSessionGatewayContext(ctx, sessionid, gwcontext)
+	if err != nil {
+		return false, errors.Wrap(err, "set session gateway context error")
 	}
+	return ok, nil
+}
 
+func (l *DeviceSessionList) createDeviceSession(ctx context.Context, sessionid uint64, rdeveui []byte,
+	devicepublickey []byte) (bool, deviceSession, error) {
 	// New session
 	currentsession := makeDeviceSession(l.deviceSessionLifeCycle)
 	copy(currentsession.rDevEui[0:], rdeveui)
@@ -516,33 +607,31 @@ func (l *DeviceSessionList) createDeviceSession(sessionid uint64, rdeveui []byte
 
 	err := currentsession.genServerKeys()
 	if err != nil {
-		return false, deviceSession{}
+		return false, deviceSession{}, nil
 	}
 
 	currentsession.genSharedKey()
 	currentsession.deriveKeys()
-	l.sessionlist[sessionid] = currentsession
 
-	return true, currentsession
-}
-
-func (l *DeviceSessionList) clearExpiredDevSession() {
-	l.mutexSessionList.Lock()
-	now := time.Now()
-	for key, session := range l.sessionlist {
-		if now.After(session.expireTime) {
-			delete(l.sessionlist, key)
+	data, err := l.encodeSession(sessionid, currentsession)
+	if err != nil {
+		return false, deviceSession{}, err
+	}
+	created, err := l.store.CreateSession(ctx, sessionid, data, l.deviceSessionLifeCycle, l.maxNumberOfDevSession)
+	if err != nil {
+		return false, deviceSession{}, errors.Wrap(err, "create session error")
+	}
+	if !created {
+		// the session may have been created by another replica meanwhile
+		found, existingsession, err := l.searchDeviceSession(ctx, sessionid)
+		if err != nil || found {
+			return found, existingsession, err
 		}
+		logrus.Warnf("Maximum number (%d) of device provisioning session reached. Request dropped.", l.maxNumberOfDevSession)
+		return false, deviceSession{}, nil
 	}
-	l.mutexSessionList.Unlock()
-}
 
-func (l *DeviceSessionList) clearDeviceSessionList() {
-	l.mutexSessionList.Lock()
-	for k := range l.sessionlist {
-		delete(l.sessionlist, k)
-	}
-	l.mutexSessionList.Unlock()
+	return true, currentsession, nil
 }
 
 func (c *controller) updateDevice(ctx context.Context, session deviceSession, deviceinfo deviceInfo) (deviceSession, deviceInfo, error) {
@@ -6,7 +6,6 @@ import (
 	cryptorand "crypto/rand"
 	"encoding/binary"
 	"encoding/hex"
-	"sync"
 	"testing"
 	"time"
 
@@ -113,8 +112,7 @@ func testSetup() (Store, *controller) {
 		psCli: newTestPsCli(testPsCli{}),
 		nsCli: newTestNsCli(testNsCli{}),
 		devSessionList: &DeviceSessionList{
-			sessionlist:            make(map[uint64]deviceSession),
-			mutexSessionList:       sync.RWMutex{},
+			store:                  newMemorySessionStore(),
 			maxNumberOfDevSession:  10,
 			deviceSessionLifeCycle: time.Second * 10,
 		},
@@ -122,6 +120,22 @@ func testSetup() (Store, *controller) {
 	return handler, testCtrl
 }
 
+func sessionCount(ctrl *controller) int {
+	return ctrl.devSessionList.store.(*memorySessionStore).count()
+}
+
+func addTestSession(t *testing.T, ctrl *controller, sessionid uint64, session deviceSession) {
+	data, err := ctrl.devSessionList.encodeSession(sessionid, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := ctrl.devSessionList.store.CreateSession(context.Background(), sessionid, data,
+		ctrl.devSessionList.deviceSessionLifeCycle, ctrl.devSessionList.maxNumberOfDevSession)
+	if err != nil || !ok {
+		t.Fatalf("couldn't create session: %v", err)
+	}
+}
+
 func TestDeviceSessionHandling(t *testing.T) {
 	ctx := context.Background()
 	handler, ctrl := testSetup()
@@ -144,8 +158,8 @@ func TestDeviceSessionHandling(t *testing.T) {
 	if !processed {
 		t.Fatalf("Request not processed.")
 	}
-	if len(ctrl.devSessionList.sessionlist) != 1 {
-		t.Fatalf("Number of active device session is wrong, expected 1, got %d", len(ctrl.devSessionList.sessionlist))
+	if sessionCount(ctrl) != 1 {
+		t.Fatalf("Number of active device session is wrong, expected 1, got %d", sessionCount(ctrl))
 	}
 
 	// Send 2nd frame, same rDevEui
@@ -156,8 +170,8 @@ func TestDeviceSessionHandling(t *testing.T) {
 	if !processed {
 		t.Fatalf("Request not processed.")
 	}
-	if len(ctrl.devSessionList.sessionlist) != 1 {
-		t.Fatalf("Expected only 1 device session, got %d", len(ctrl.devSessionList.sessionlist))
+	if sessionCount(ctrl) != 1 {
+		t.Fatalf("Expected only 1 device session, got %d", sessionCount(ctrl))
 	}
 
 	// Send 3nd frame, different rDevEui
@@ -172,8 +186,8 @@ func TestDeviceSessionHandling(t *testing.T) {
 	if !processed {
 		t.Fatalf("Request not processed.")
 	}
-	if len(ctrl.devSessionList.sessionlist) != 2 {
-		t.Fatalf("Expected there is 2 device session, got %d", len(ctrl.devSessionList.sessionlist))
+	if sessionCount(ctrl) != 2 {
+		t.Fatalf("Expected there is 2 device session, got %d", sessionCount(ctrl))
 	}
 }
 
@@ -204,9 +218,9 @@ func TestDeviceSessionExpire(t *testing.T) {
 			t.Fatalf("HandleReceivedFrame failed. %s", err)
 		}
 	}
-	if len(ctrl.devSessionList.sessionlist) != ctrl.devSessionList.maxNumberOfDevSession {
+	if sessionCount(ctrl) != ctrl.devSessionList.maxNumberOfDevSession {
 		t.Fatalf("Expected number device session is %d, got %d",
-			ctrl.devSessionList.maxNumberOfDevSession, len(ctrl.devSessionList.sessionlist))
+			ctrl.devSessionList.maxNumberOfDevSession, sessionCount(ctrl))
 	}
 
 	// Queue one more
@@ -216,32 +230,34 @@ func TestDeviceSessionExpire(t *testing.T) {
 	if err != nil {
 		t.Fatalf("HandleReceivedFrame failed. %s", err)
 	}
-	if len(ctrl.devSessionList.sessionlist) != ctrl.devSessionList.maxNumberOfDevSession {
+	if sessionCount(ctrl) != ctrl.devSessionList.maxNumberOfDevSession {
 		t.Fatalf("Expected number device session is %d, got %d",
-			ctrl.devSessionList.maxNumberOfDevSession, len(ctrl.devSessionList.sessionlist))
+			ctrl.devSessionList.maxNumberOfDevSession, sessionCount(ctrl))
 	}
 
 	// Just before expire
-	ctrl.devSessionList.clearExpiredDevSession()
-	if len(ctrl.devSessionList.sessionlist) != ctrl.devSessionList.maxNumberOfDevSession {
+	ctrl.devSessionList.store.(*memorySessionStore).removeExpired(time.Now())
+	if sessionCount(ctrl) != ctrl.devSessionList.maxNumberOfDevSession {
 		t.Fatalf("Expected number device session is %d, got %d", ctrl.devSessionList.maxNumberOfDevSession,
-			len(ctrl.devSessionList.sessionlist))
+			sessionCount(ctrl))
 	}
 
 	count := 4
 	// age 4 session by 12 seconds
-	for k := range ctrl.devSessionList.sessionlist {
-		if count == 0 {
+	memStore := ctrl.devSessionList.store.(*memorySessionStore)
+	aged := 0
+	for _, s := range memStore.sessions {
+		if aged == count {
 			break
 		}
-		ctrl.devSessionList.sessionlist[k].expireTime.Add(time.Second * (-12))
+		s.expireTime = s.expireTime.Add(time.Second * (-12))
 
-		count--
+		aged++
 	}
-	ctrl.devSessionList.clearExpiredDevSession()
-	if len(ctrl.devSessionList.sessionlist) != ctrl.devSessionList.maxNumberOfDevSession-count {
+	memStore.removeExpired(time.Now())
+	if sessionCount(ctrl) != ctrl.devSessionList.maxNumberOfDevSession-count {
 		t.Fatalf("Expected number device session is %d, got %d",
-			ctrl.devSessionList.maxNumberOfDevSession-count, len(ctrl.devSessionList.sessionlist))
+			ctrl.devSessionList.maxNumberOfDevSession-count, sessionCount(ctrl))
 	}
 }
 
@@ -408,7 +424,7 @@ func TestHandleReceivedFrameValidAuth(t *testing.T) {
 	copy(session.devNonce[:], devicenonce[:])
 	session.deriveKeys()
 	sessionid := binary.BigEndian.Uint64(rDevEui)
-	ctrl.devSessionList.sessionlist[sessionid] = session
+	addTestSession(t, ctrl, sessionid, session)
 
 	verifycode := session.calVerifyCode(privisionid, true)
 
@@ -500,7 +516,7 @@ func TestHandleReceivedFrameAuthReject(t *testing.T) {
 	copy(session.devNonce[:], devicenonce[:])
 	session.deriveKeys()
 	sessionid := binary.BigEndian.Uint64(rDevEui)
-	ctrl.devSessionList.sessionlist[sessionid] = session
+	addTestSession(t, ctrl, sessionid, session)
 
 	verifycode := session.calVerifyCode(privisionid, true)
 
@@ -588,7 +604,7 @@ func TestHandleReceivedFrameWrongAuth(t *testing.T) {
 	copy(session.devNonce[:], devicenonce[:])
 	session.deriveKeys()
 	sessionid := binary.BigEndian.Uint64(rDevEui)
-	ctrl.devSessionList.sessionlist[sessionid] = session
+	addTestSession(t, ctrl, sessionid, session)
 
 	verifycode := session.calVerifyCode(privisionid, true)
 
@@ -786,3 +802,63 @@ func TestCalProprietaryMic(t *testing.T) {
 		t.Error("Wrong MIC code")
 	}
 }
+
+func TestEncryptedSessionStore(t *testing.T) {
+	ctx := context.Background()
+	aead, err := newSessionAEAD("000102030405060708090a0b0c0d0e0f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := newMemorySessionStore()
+	l := &DeviceSessionList{
+		store:                  store,
+		aead:                   aead,
+		maxNumberOfDevSession:  10,
+		deviceSessionLifeCycle: time.Second * 10,
+	}
+	devicepublickey := make([]byte, ecdh.K233PubKeySize)
+	fillByteArray(devicepublickey, 0x01)
+	sessionid := binary.BigEndian.Uint64(rDevEui)
+
+	ok, session, err := l.createDeviceSession(ctx, sessionid, rDevEui, devicepublickey)
+	if err != nil || !ok {
+		t.Fatalf("create session failed: %v", err)
+	}
+	// the keys must not be stored in plain text
+	data := store.sessions[sessionid].data
+	if bytes.Contains(data, []byte(hex.EncodeToString(session.appKey))) ||
+		bytes.Contains(data, []byte("app_key")) {
+		t.Errorf("session is not encrypted")
+	}
+
+	ok, found, err := l.searchDeviceSession(ctx, sessionid)
+	if err != nil || !ok {
+		t.Fatalf("search session failed: %v", err)
+	}
+	if !bytes.Equal(found.appKey, session.appKey) || !bytes.Equal(found.serverPrivateKey, session.serverPrivateKey) {
+		t.Errorf("unexpected session: %+v", found)
+	}
+
+	// creating the session again returns the existing one
+	ok, again, err := l.createDeviceSession(ctx, sessionid, rDevEui, devicepublickey)
+	if err != nil || !ok || !bytes.Equal(again.serverPrivateKey, session.serverPrivateKey) {
+		t.Errorf("expected existing session, got %v %v", ok, err)
+	}
+
+	// the data of one session can't be used for another one
+	if _, err := l.decodeSession(sessionid+1, data); err == nil {
+		t.Errorf("expected decrypt error")
+	}
+
+	// the frame received with the same gateway context is dropped
+	for i, expected := range []bool{true, false} {
+		ok, err := l.checkDeviceSession(ctx, sessionid, []byte{1, 2})
+		if err != nil || ok != expected {
+			t.Errorf("check %d: expected %v, got %v %v", i, expected, ok, err)
+		}
+	}
+
+	if _, err := Start(Config{}, store); err == nil {
+		t.Errorf("expected error as the encryption key is not set")
+	}
+}
@@ -0,0 +1,119 @@
+package devprovision
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+)
+
+// SessionStore keeps the device provisioning sessions. When multiple
+// replicas are running, the store must be shared by all of them as the Hello
+// and Auth frames of the device may be handled by different replicas.
+type SessionStore interface {
+	// GetSession returns the data of the session, or nil if the session
+	// doesn't exist or has expired
+	GetSession(ctx context.Context, id uint64) ([]byte, error)
+	// CreateSession stores the session that expires after ttl. The session
+	// is not stored if it already exists or if the number of the sessions
+	// has reached maxSessions, in which case false is returned
+	CreateSession(ctx context.Context, id uint64, data []byte, ttl time.Duration, maxSessions int) (bool, error)
+	// UpdateSession replaces the data of the existing session, the session
+	// expires at the same time as before
+	UpdateSession(ctx context.Context, id uint64, data []byte) error
+	// SetSessionGatewayContext saves the context of the gateway that has
+	// received the last frame of the session. It returns false if the session
+	// doesn't exist or the context is the same as the saved one, i.e. the
+	// frame has been handled already
+	SetSessionGatewayContext(ctx context.Context, id uint64, gwContext []byte) (bool, error)
+}
+
+type memorySession struct {
+	data          []byte
+	lastGwContext []byte
+	expireTime    time.Time
+}
+
+// memorySessionStore keeps the sessions in the memory of the process, it
+// can only be used when running a single replica
+type memorySessionStore struct {
+	mutex    sync.Mutex
+	sessions map[uint64]*memorySession
+}
+
+func newMemorySessionStore() *memorySessionStore {
+	return &memorySessionStore{
+		sessions: make(map[uint64]*memorySession),
+	}
+}
+
+// getSession returns the session if it exists and has not expired, the
+// caller must hold the mutex
+func (ms *memorySessionStore) getSession(id uint64) *memorySession {
+	s, ok := ms.sessions[id]
+	if !ok || time.Now().After(s.expireTime) {
+		return nil
+	}
+	return s
+}
+
+func (ms *memorySessionStore) GetSession(ctx context.Context, id uint64) ([]byte, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	if s := ms.getSession(id); s != nil {
+		return s.data, nil
+	}
+	return nil, nil
+}
+
+func (ms *memorySessionStore) CreateSession(ctx context.Context, id uint64, data []byte, ttl time.Duration,
+	maxSessions int) (bool, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	if ms.getSession(id) != nil || len(ms.sessions) >= maxSessions {
+		return false, nil
+	}
+	ms.sessions[id] = &memorySession{
+		data:       data,
+		expireTime: time.Now().Add(ttl),
+	}
+	return true, nil
+}
+
+func (ms *memorySessionStore) UpdateSession(ctx context.Context, id uint64, data []byte) error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	if s := ms.getSession(id); s != nil {
+		s.data = data
+	}
+	return nil
+}
+
+func (ms *memorySessionStore) SetSessionGatewayContext(ctx context.Context, id uint64, gwContext []byte) (bool, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	s := ms.getSession(id)
+	if s == nil || (s.lastGwContext != nil && bytes.Equal(s.lastGwContext, gwContext)) {
+		return false, nil
+	}
+	s.lastGwContext = append([]byte{}, gwContext...)
+	return true, nil
+}
+
+// removeExpired removes the sessions expired before now
+func (ms *memorySessionStore) removeExpired(now time.Time) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	for id, s := range ms.sessions {
+		if now.After(s.expireTime) {
+			delete(ms.sessions, id)
+		}
+	}
+}
+
+// count returns the number of the sessions in the store
+func (ms *memorySessionStore) count() int {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	return len(ms.sessions)
+}
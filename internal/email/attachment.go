@@ -0,0 +1,72 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+)
+
+// Attachment is the file attached to the email
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// attach converts the message generated from the email template into the
+// multipart/mixed message containing the original message as the first part
+// followed by the attachments
+func attach(msg []byte, attachments []Attachment, boundary string) ([]byte, error) {
+	idx := bytes.Index(msg, []byte("\n\n"))
+	if idx < 0 {
+		return nil, fmt.Errorf("message has no body")
+	}
+	header, body := msg[:idx], msg[idx+2:]
+
+	var out, partHeader bytes.Buffer
+	for _, line := range bytes.Split(header, []byte("\n")) {
+		// headers describing the content belong to the first part
+		if bytes.HasPrefix(bytes.ToLower(line), []byte("content-")) {
+			partHeader.Write(line)
+			partHeader.WriteString("\n")
+			continue
+		}
+		out.Write(line)
+		out.WriteString("\n")
+	}
+	fmt.Fprintf(&out, "Content-Type: %s\n\n", mime.FormatMediaType("multipart/mixed",
+		map[string]string{"boundary": boundary}))
+
+	fmt.Fprintf(&out, "--%s\n", boundary)
+	out.Write(partHeader.Bytes())
+	out.WriteString("\n")
+	out.Write(body)
+	out.WriteString("\n")
+
+	for _, a := range attachments {
+		contentType := a.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		fmt.Fprintf(&out, "--%s\n", boundary)
+		fmt.Fprintf(&out, "Content-Type: %s\n", mime.FormatMediaType(contentType,
+			map[string]string{"name": a.Filename}))
+		out.WriteString("Content-Transfer-Encoding: base64\n")
+		fmt.Fprintf(&out, "Content-Disposition: %s\n\n", mime.FormatMediaType("attachment",
+			map[string]string{"filename": a.Filename}))
+
+		encoded := base64.StdEncoding.EncodeToString(a.Data)
+		// lines of base64 encoded data must not be longer than 76 characters
+		for len(encoded) > 76 {
+			out.WriteString(encoded[:76])
+			out.WriteString("\n")
+			encoded = encoded[76:]
+		}
+		out.WriteString(encoded)
+		out.WriteString("\n")
+	}
+	fmt.Fprintf(&out, "--%s--\n", boundary)
+
+	return out.Bytes(), nil
+}
@@ -0,0 +1,93 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"testing"
+)
+
+func TestAttach(t *testing.T) {
+	msg := "From: supernode@example.com\n" +
+		"To: user@example.com\n" +
+		"Subject: Mining statement\n" +
+		"MIME-Version: 1.0\n" +
+		"Content-Type: multipart/alternative;boundary= \"simple boundary\"\n" +
+		"\n" +
+		"--simple boundary\n" +
+		"Content-Type: text/plain; charset=UTF-8\n" +
+		"\n" +
+		"statement\n" +
+		"--simple boundary--\n"
+	pdf := bytes.Repeat([]byte("%PDF-1.3 report "), 20)
+
+	out, err := attach([]byte(msg), []Attachment{{
+		Filename:    "report 2021-03.pdf",
+		ContentType: "application/pdf",
+		Data:        pdf,
+	}}, "mixed boundary")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := mail.ReadMessage(bytes.NewReader(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Header.Get("Subject") != "Mining statement" {
+		t.Errorf("unexpected subject: %s", m.Header.Get("Subject"))
+	}
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mediaType != "multipart/mixed" {
+		t.Fatalf("expected multipart/mixed, got %s", mediaType)
+	}
+
+	mr := multipart.NewReader(m.Body, params["boundary"])
+	body, err := mr.NextPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct := body.Header.Get("Content-Type"); ct != "multipart/alternative;boundary= \"simple boundary\"" {
+		t.Errorf("unexpected content type of the body: %s", ct)
+	}
+
+	file, err := mr.NextPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.FileName() != "report 2021-03.pdf" {
+		t.Errorf("unexpected file name: %s", file.FileName())
+	}
+	if file.Header.Get("Content-Transfer-Encoding") != "base64" {
+		t.Errorf("expected base64 encoding")
+	}
+	// multipart reader decodes only quoted-printable parts
+	encoded, err := ioutil.ReadAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var joined []byte
+	for _, line := range bytes.Split(bytes.TrimSpace(encoded), []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) > 76 {
+			t.Errorf("line is too long: %d", len(line))
+		}
+		joined = append(joined, line...)
+	}
+	data, err := base64.StdEncoding.DecodeString(string(joined))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, pdf) {
+		t.Errorf("attached data doesn't match")
+	}
+	if _, err := mr.NextPart(); err == nil {
+		t.Errorf("expected no more parts")
+	}
+}
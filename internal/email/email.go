@@ -2,6 +2,7 @@ package email
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/base32"
 	"encoding/json"
@@ -138,6 +139,9 @@ type Mailer struct {
 	Operator Operator
 	SMTP     map[string]SMTPConfig
 	Cli      map[string]*client
+	// outbox queues the emails to be sent by the outbox worker, if not set
+	// the emails are sent immediately
+	outbox OutboxStore
 }
 
 // SendVerifyEmailConfirmation sends security token to given address for verifying the address
@@ -165,6 +169,18 @@ func (m *Mailer) SendStakeIncomeNotification(email, lang string, param Param) er
 	return m.sendInvite(email, param, EmailLanguage(lang), StakingIncome)
 }
 
+// SendMiningStatement sends periodic statement of mining and staking income
+// of the organization with the report attached
+func (m *Mailer) SendMiningStatement(email, lang string, param Param) error {
+	return m.sendInvite(email, param, EmailLanguage(lang), MiningStatement)
+}
+
+// SendOrganizationInvitation sends the invitation to join the organization
+// with the token accepting it
+func (m *Mailer) SendOrganizationInvitation(email, lang string, param Param) error {
+	return m.sendInvite(email, param, EmailLanguage(lang), OrganizationInvitation)
+}
+
 // SendInvite ...
 func (m *Mailer) sendInvite(user string, param Param, language EmailLanguage, option EmailOptions) error {
 	var err error
@@ -238,20 +254,49 @@ func (m *Mailer) sendInvite(user string, param Param, language EmailLanguage, op
 
 	str := strings.Replace(msg.String(), "=\"", "=3D\"", -1)
 	out := bytes.NewBufferString(str)
+	if len(param.Attachments) > 0 {
+		withAttachments, err := attach(out.Bytes(), param.Attachments, "mixed boundary "+param.messageID)
+		if err != nil {
+			log.WithError(err).Error("Failed to attach files to email")
+			return err
+		}
+		out = bytes.NewBuffer(withAttachments)
+	}
+
+	if m.outbox != nil {
+		now := time.Now()
+		return m.outbox.InsertOutboxEmail(context.Background(), &OutboxEmail{
+			Recipient:     user,
+			Kind:          string(option),
+			Message:       out.Bytes(),
+			Status:        OutboxStatusPending,
+			CreatedAt:     now,
+			NextAttemptAt: now,
+		})
+	}
 
+	return m.deliver(user, out.Bytes())
+}
+
+// deliver sends the message trying the configured SMTP servers one by one
+func (m *Mailer) deliver(user string, msg []byte) error {
+	var lastErr error
 	for k, v := range m.Cli {
 		if v != nil {
-			err = v.send(user, *out)
+			err := v.send(user, *bytes.NewBuffer(msg))
 			if err == nil {
 				return nil
 			}
 			log.WithError(err).Warnf("Failed to send email with %s, try with other provider", k)
+			lastErr = err
 		}
 	}
 
 	log.Error("Unable to send confirmation email")
+	if lastErr != nil {
+		return errors.Wrap(lastErr, "SMTP server failed")
+	}
 	return errors.New("SMTP server failed")
-
 }
 
 func (c *client) send(user string, msg bytes.Buffer) error {
@@ -0,0 +1,158 @@
+package email
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type miningStatementJSON struct {
+	FromText  string `json:"from"`
+	Subject   string `json:"subject"`
+	PlainText string `json:"plainText"`
+	Title     string `json:"title"`
+	Body1     string `json:"body1"`
+	Body2     string `json:"body2"`
+	Body3     string `json:"body3"`
+	Body4     string `json:"body4"`
+	Body5     string `json:"body5"`
+	Body6     string `json:"body6"`
+	Body7     string `json:"body7"`
+	Body8     string `json:"body8"`
+	Body9     string `json:"body9"`
+}
+
+type miningStatementParam struct {
+	// common
+	FromText           string
+	From               string
+	Host               string
+	To                 string
+	Subject            string
+	MsgID              string
+	PlainText          string
+	Title              string
+	OperatorLogo       string
+	DownloadAppStore   string
+	DownloadAPK        string
+	DownloadGoogle     string
+	DownloadTestFlight string
+	OperatorLegal      string
+	OperatorAddress    string
+	OperatorContact    string
+	// body
+	B1, B2, B3, B4, B5, MXCMined, B6, DHXMined, B7, BTCReceived, B8, StakingRevenue, B9 string
+	// footer
+	Str1, Str2, Str3, Str4, Str5, Str6 string
+}
+
+type miningStatementEmailInterface struct {
+	JSON miningStatementJSON
+}
+
+var miningStatementEmail miningStatementEmailInterface
+
+const (
+	StatementMXCMined       string = "statementMXCMined"
+	StatementDHXMined       string = "statementDHXMined"
+	StatementBTCReceived    string = "statementBTCReceived"
+	StatementStakingRevenue string = "statementStakingRevenue"
+
+	StatementOrgName string = "statementOrgName"
+
+	StatementPeriodStart string = "statementPeriodStart"
+	StatementPeriodEnd   string = "statementPeriodEnd"
+)
+
+func statementParamCheck(param Param) error {
+	for _, key := range []string{StatementMXCMined, StatementDHXMined, StatementBTCReceived, StatementStakingRevenue} {
+		if param.Amount[key] == "" {
+			return errors.New(key)
+		}
+	}
+	if param.ItemID[StatementOrgName] == "" {
+		return errors.New("StatementOrgName")
+	}
+	if param.Date[StatementPeriodStart] == "" {
+		return errors.New("StatementPeriodStart")
+	}
+	if param.Date[StatementPeriodEnd] == "" {
+		return errors.New("StatementPeriodEnd")
+	}
+
+	return nil
+}
+
+func (s *miningStatementEmailInterface) getEmailParam(user string, param Param, jsonData []byte) (interface{}, error) {
+	if err := statementParamCheck(param); err != nil {
+		return nil, errors.Wrap(err, "invalid parameter for miningStatementEmailInterface")
+	}
+
+	err := json.Unmarshal(jsonData, &s.JSON)
+	if err != nil {
+		log.WithError(err).Errorf("Parse json data error")
+		return nil, err
+	}
+
+	jsonStruct := miningStatementJSON{
+		FromText: fmt.Sprintf(s.JSON.FromText, email.operator.operatorName),
+		Subject:  fmt.Sprintf(s.JSON.Subject, param.ItemID[StatementOrgName]),
+		PlainText: fmt.Sprintf(s.JSON.PlainText, param.ItemID[StatementOrgName],
+			param.Date[StatementPeriodStart], param.Date[StatementPeriodEnd],
+			param.Amount[StatementMXCMined], param.Amount[StatementDHXMined],
+			param.Amount[StatementBTCReceived], param.Amount[StatementStakingRevenue]),
+		Title: fmt.Sprintf(s.JSON.Title, email.operator.operatorName),
+		Body1: s.JSON.Body1,
+		Body2: s.JSON.Body2,
+		Body3: param.ItemID[StatementOrgName],
+		Body4: fmt.Sprintf(s.JSON.Body4, param.Date[StatementPeriodStart], param.Date[StatementPeriodEnd]),
+		Body5: s.JSON.Body5,
+		Body6: s.JSON.Body6,
+		Body7: s.JSON.Body7,
+		Body8: s.JSON.Body8,
+		Body9: s.JSON.Body9,
+	}
+
+	emailData := miningStatementParam{
+		FromText:           jsonStruct.FromText,
+		From:               email.from,
+		Host:               email.host,
+		To:                 user,
+		Subject:            jsonStruct.Subject,
+		MsgID:              param.messageID,
+		PlainText:          jsonStruct.PlainText,
+		Title:              jsonStruct.Title,
+		OperatorLogo:       email.operator.operatorLogo,
+		DownloadAppStore:   email.operator.downloadAppStore,
+		DownloadGoogle:     email.operator.downloadGoogle,
+		DownloadTestFlight: email.operator.downloadTestFlight,
+		DownloadAPK:        email.operator.downloadAPK,
+		OperatorLegal:      email.operator.operatorLegal,
+		OperatorAddress:    email.operator.operatorAddress,
+		OperatorContact:    email.operator.operatorContact,
+		B1:                 jsonStruct.Body1,
+		B2:                 jsonStruct.Body2,
+		B3:                 jsonStruct.Body3,
+		B4:                 jsonStruct.Body4,
+		B5:                 jsonStruct.Body5,
+		MXCMined:           param.Amount[StatementMXCMined],
+		B6:                 jsonStruct.Body6,
+		DHXMined:           param.Amount[StatementDHXMined],
+		B7:                 jsonStruct.Body7,
+		BTCReceived:        param.Amount[StatementBTCReceived],
+		B8:                 jsonStruct.Body8,
+		StakingRevenue:     param.Amount[StatementStakingRevenue],
+		B9:                 jsonStruct.Body9,
+		Str1:               param.commonJSON.Str1,
+		Str2:               param.commonJSON.Str2,
+		Str3:               param.commonJSON.Str3,
+		Str4:               param.commonJSON.Str4,
+		Str5:               param.commonJSON.Str5,
+		Str6:               param.commonJSON.Str6,
+	}
+
+	return emailData, err
+}
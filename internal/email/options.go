@@ -25,6 +25,8 @@ const (
 	TwoFALogin               EmailOptions = "2fa-login"
 	TwoFAWithdraw            EmailOptions = "2fa-withdraw"
 	StakingIncome            EmailOptions = "staking-income"
+	MiningStatement          EmailOptions = "mining-statement"
+	OrganizationInvitation   EmailOptions = "organization-invitation"
 	TopupConfirmation        EmailOptions = "topup-confirm"
 	WithdrawDenied           EmailOptions = "withdraw-denied"
 	WithdrawSuccess          EmailOptions = "withdraw-success"
@@ -44,6 +46,8 @@ type Param struct {
 		Str5 string `json:"str5"`
 		Str6 string `json:"str6"`
 	}
+	// files attached to the email
+	Attachments []Attachment
 }
 
 // define interfaces for each email option
@@ -59,6 +63,8 @@ var emailOptionsList = map[EmailOptions]emailInterface{
 	PasswordReset:            emailInterface(&passwordResetEmail),
 	PasswordResetUnknown:     emailInterface(&passwordResetUnknownEmail),
 	StakingIncome:            emailInterface(&stakingIncomeEmail),
+	MiningStatement:          emailInterface(&miningStatementEmail),
+	OrganizationInvitation:   emailInterface(&organizationInvitationEmail),
 	/*		TopupConfirmation:        emailInterface(&topupConfirmEmail),
 			WithdrawDenied:           emailInterface(&withdrawDeniedEmail),
 			WithdrawSuccess:          emailInterface(&withdrawSuccessEmail),*/
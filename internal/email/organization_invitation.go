@@ -0,0 +1,143 @@
+package email
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type organizationInvitationJSON struct {
+	FromText  string `json:"from"`
+	Subject   string `json:"subject"`
+	PlainText string `json:"plainText"`
+	Title     string `json:"title"`
+	Body1     string `json:"body1"`
+	Body2     string `json:"body2"`
+	Body3     string `json:"body3"`
+	Body4     string `json:"body4"`
+	Body5     string `json:"body5"`
+	Body6     string `json:"body6"`
+}
+
+type organizationInvitationParam struct {
+	// common
+	FromText           string
+	From               string
+	Host               string
+	To                 string
+	Subject            string
+	MsgID              string
+	PlainText          string
+	Title              string
+	OperatorLogo       string
+	DownloadAppStore   string
+	DownloadAPK        string
+	DownloadGoogle     string
+	DownloadTestFlight string
+	OperatorLegal      string
+	OperatorAddress    string
+	OperatorContact    string
+	// body
+	B1, B2, OrgName, B3, B4, Link, B5, B6 string
+	// footer
+	Str1, Str2, Str3, Str4, Str5, Str6 string
+}
+
+type organizationInvitationEmailInterface struct {
+	JSON organizationInvitationJSON
+}
+
+var organizationInvitationEmail organizationInvitationEmailInterface
+
+const (
+	InvitationOrgName string = "invitationOrgName"
+	InvitationInviter string = "invitationInviter"
+
+	InvitationExpiresAt string = "invitationExpiresAt"
+)
+
+// invitationPath is the path of the web UI page accepting the invitation
+const invitationPath = "/#/organization-invitation/"
+
+func invitationParamCheck(param Param) error {
+	if param.Token == "" {
+		return errors.New("Token")
+	}
+	if param.ItemID[InvitationOrgName] == "" {
+		return errors.New("InvitationOrgName")
+	}
+	if param.ItemID[InvitationInviter] == "" {
+		return errors.New("InvitationInviter")
+	}
+	if param.Date[InvitationExpiresAt] == "" {
+		return errors.New("InvitationExpiresAt")
+	}
+
+	return nil
+}
+
+func (s *organizationInvitationEmailInterface) getEmailParam(user string, param Param, jsonData []byte) (interface{}, error) {
+	if err := invitationParamCheck(param); err != nil {
+		return nil, errors.Wrap(err, "invalid parameter for organizationInvitationEmailInterface")
+	}
+
+	err := json.Unmarshal(jsonData, &s.JSON)
+	if err != nil {
+		log.WithError(err).Errorf("Parse json data error")
+		return nil, err
+	}
+
+	orgName := param.ItemID[InvitationOrgName]
+	link := email.host + invitationPath + param.Token
+	jsonStruct := organizationInvitationJSON{
+		FromText: fmt.Sprintf(s.JSON.FromText, email.operator.operatorName),
+		Subject:  fmt.Sprintf(s.JSON.Subject, orgName, email.operator.operatorName),
+		PlainText: fmt.Sprintf(s.JSON.PlainText, param.ItemID[InvitationInviter], orgName,
+			email.operator.operatorName, link, param.Date[InvitationExpiresAt]),
+		Title: fmt.Sprintf(s.JSON.Title, email.operator.operatorName),
+		Body1: s.JSON.Body1,
+		Body2: fmt.Sprintf(s.JSON.Body2, param.ItemID[InvitationInviter]),
+		Body3: fmt.Sprintf(s.JSON.Body3, param.Date[InvitationExpiresAt]),
+		Body4: s.JSON.Body4,
+		Body5: s.JSON.Body5,
+		Body6: s.JSON.Body6,
+	}
+
+	emailData := organizationInvitationParam{
+		FromText:           jsonStruct.FromText,
+		From:               email.from,
+		Host:               email.host,
+		To:                 user,
+		Subject:            jsonStruct.Subject,
+		MsgID:              param.messageID,
+		PlainText:          jsonStruct.PlainText,
+		Title:              jsonStruct.Title,
+		OperatorLogo:       email.operator.operatorLogo,
+		DownloadAppStore:   email.operator.downloadAppStore,
+		DownloadGoogle:     email.operator.downloadGoogle,
+		DownloadTestFlight: email.operator.downloadTestFlight,
+		DownloadAPK:        email.operator.downloadAPK,
+		OperatorLegal:      email.operator.operatorLegal,
+		OperatorAddress:    email.operator.operatorAddress,
+		OperatorContact:    email.operator.operatorContact,
+		B1:                 jsonStruct.Body1,
+		B2:                 jsonStruct.Body2,
+		OrgName:            orgName,
+		B3:                 jsonStruct.Body3,
+		B4:                 jsonStruct.Body4,
+		Link:               link,
+		B5:                 jsonStruct.Body5,
+		B6:                 jsonStruct.Body6,
+		Str1:               param.commonJSON.Str1,
+		Str2:               param.commonJSON.Str2,
+		Str3:               param.commonJSON.Str3,
+		Str4:               param.commonJSON.Str4,
+		Str5:               param.commonJSON.Str5,
+		Str6:               param.commonJSON.Str6,
+	}
+
+	return emailData, err
+}
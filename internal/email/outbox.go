@@ -0,0 +1,250 @@
+package email
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
+)
+
+// OutboxConfig contains configuration of the email outbox
+type OutboxConfig struct {
+	// Enabled turns on queueing of the emails in the outbox, if not enabled
+	// the emails are sent immediately by the API handlers
+	Enabled bool `mapstructure:"enabled"`
+	// MaxAttempts is the number of attempts to send the email after which it
+	// is marked as failed, 10 if not set
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// InitialBackoff is the delay before the first retry, it doubles with
+	// every next attempt up to MaxBackoff. 1m and 6h if not set
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`
+	// RecipientRateLimit is the maximum number of the emails sent to the same
+	// recipient per hour, 20 if not set
+	RecipientRateLimit int `mapstructure:"recipient_rate_limit"`
+	// PollInterval is how often the outbox is checked for the emails to be
+	// sent, 5s if not set
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Retention is the time for which the sent and failed emails are kept,
+	// 720h if not set
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+// Statuses of the emails in the outbox
+const (
+	OutboxStatusPending = "pending"
+	OutboxStatusSent    = "sent"
+	OutboxStatusFailed  = "failed"
+)
+
+// OutboxEmail is the rendered email queued in the outbox
+type OutboxEmail struct {
+	ID        int64  `db:"id"`
+	Recipient string `db:"recipient"`
+	// Kind is the email option, e.g. registration-confirmation
+	Kind string `db:"kind"`
+	// Message is the complete message including the headers, it is cleared
+	// when the email is sent as it may contain security tokens
+	Message       []byte     `db:"message"`
+	Status        string     `db:"status"`
+	Attempts      int        `db:"attempts"`
+	LastError     string     `db:"last_error"`
+	CreatedAt     time.Time  `db:"created_at"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	SentAt        *time.Time `db:"sent_at"`
+}
+
+// OutboxFilter selects the emails to return
+type OutboxFilter struct {
+	Status string
+	// Recipient selects the emails sent to the address if set
+	Recipient string
+	Limit     int64
+	Offset    int64
+}
+
+// OutboxStore provides access to the email outbox
+type OutboxStore interface {
+	// InsertOutboxEmail queues the email
+	InsertOutboxEmail(ctx context.Context, e *OutboxEmail) error
+	// GetDueOutboxEmails returns up to limit pending emails which next
+	// attempt is due at the given time, the oldest first
+	GetDueOutboxEmails(ctx context.Context, now time.Time, limit int) ([]OutboxEmail, error)
+	// UpdateOutboxEmail updates the status, message and the attempts of the
+	// email
+	UpdateOutboxEmail(ctx context.Context, e *OutboxEmail) error
+	// GetOutboxEmailSentCount returns the number of the emails sent to the
+	// recipient since the given time
+	GetOutboxEmailSentCount(ctx context.Context, recipient string, since time.Time) (int64, error)
+	// DeleteOutboxEmails deletes the sent and failed emails created before
+	// the given time
+	DeleteOutboxEmails(ctx context.Context, before time.Time) (int64, error)
+	// GetOutboxEmailCount returns the number of the emails matching the
+	// filter
+	GetOutboxEmailCount(ctx context.Context, filter OutboxFilter) (int64, error)
+	// GetOutboxEmails returns the emails matching the filter without the
+	// messages, the newest first
+	GetOutboxEmails(ctx context.Context, filter OutboxFilter) ([]OutboxEmail, error)
+	// RetryOutboxEmail resets the attempts of the failed email and makes it
+	// pending again
+	RetryOutboxEmail(ctx context.Context, id int64, now time.Time) error
+}
+
+const (
+	// outboxBatchSize is the maximum number of the emails sent at once
+	outboxBatchSize = 100
+	// rateLimitWindow is the period of the recipient rate limit
+	rateLimitWindow = time.Hour
+	// outboxCleanupInterval is how often the old emails are deleted
+	outboxCleanupInterval = time.Hour
+)
+
+// Outbox represents an instance of the running outbox worker
+type Outbox struct {
+	cfg         OutboxConfig
+	store       OutboxStore
+	mailer      *Mailer
+	lastCleanup time.Time
+	done        chan struct{}
+}
+
+// StartOutbox makes the mailer queue the emails in the outbox and starts the
+// worker sending them. It must be called before the mailer is used.
+func StartOutbox(cfg OutboxConfig, store OutboxStore, mailer *Mailer) *Outbox {
+	if !cfg.Enabled {
+		log.Infof("email outbox is not enabled, the emails are sent immediately")
+		return nil
+	}
+	ob := newOutbox(cfg, store, mailer)
+	mailer.outbox = store
+	go ob.run()
+	return ob
+}
+
+func newOutbox(cfg OutboxConfig, store OutboxStore, mailer *Mailer) *Outbox {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 10
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = time.Minute
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = 6 * time.Hour
+	}
+	if cfg.RecipientRateLimit <= 0 {
+		cfg.RecipientRateLimit = 20
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 5 * time.Second
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = 30 * 24 * time.Hour
+	}
+	return &Outbox{
+		cfg:    cfg,
+		store:  store,
+		mailer: mailer,
+		done:   make(chan struct{}),
+	}
+}
+
+// Stop stops the worker. The outbox object is not usable after this call
+func (ob *Outbox) Stop() {
+	if ob != nil {
+		ob.done <- struct{}{}
+		close(ob.done)
+	}
+}
+
+// leaderJob is the name of the job in the leader election
+const leaderJob = "email-outbox"
+
+func (ob *Outbox) run() {
+	ticker := time.NewTicker(ob.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if !leader.IsLeader(leaderJob) {
+				continue
+			}
+			if err := ob.process(context.Background(), time.Now()); err != nil {
+				log.WithError(err).Error("email outbox: failed to send emails")
+			}
+		case <-ob.done:
+			return
+		}
+	}
+}
+
+// process sends the due emails and deletes the old ones
+func (ob *Outbox) process(ctx context.Context, now time.Time) error {
+	if now.Sub(ob.lastCleanup) >= outboxCleanupInterval {
+		n, err := ob.store.DeleteOutboxEmails(ctx, now.Add(-ob.cfg.Retention))
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			log.Infof("email outbox: deleted %d old emails", n)
+		}
+		ob.lastCleanup = now
+	}
+
+	emails, err := ob.store.GetDueOutboxEmails(ctx, now, outboxBatchSize)
+	if err != nil {
+		return err
+	}
+	for i := range emails {
+		if err := ob.send(ctx, &emails[i], now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// send makes an attempt to send the email and records the result. The email
+// is postponed without counting the attempt if the recipient has reached the
+// rate limit.
+func (ob *Outbox) send(ctx context.Context, e *OutboxEmail, now time.Time) error {
+	sent, err := ob.store.GetOutboxEmailSentCount(ctx, e.Recipient, now.Add(-rateLimitWindow))
+	if err != nil {
+		return err
+	}
+	if sent >= int64(ob.cfg.RecipientRateLimit) {
+		e.NextAttemptAt = now.Add(rateLimitWindow / time.Duration(ob.cfg.RecipientRateLimit))
+		return ob.store.UpdateOutboxEmail(ctx, e)
+	}
+
+	e.Attempts++
+	if err := ob.mailer.deliver(e.Recipient, e.Message); err != nil {
+		e.LastError = err.Error()
+		if e.Attempts >= ob.cfg.MaxAttempts {
+			log.WithError(err).Errorf("email outbox: giving up sending %s email %d to %s after %d attempts",
+				e.Kind, e.ID, e.Recipient, e.Attempts)
+			e.Status = OutboxStatusFailed
+		} else {
+			e.NextAttemptAt = now.Add(ob.backoff(e.Attempts))
+		}
+		return ob.store.UpdateOutboxEmail(ctx, e)
+	}
+	e.Status = OutboxStatusSent
+	e.SentAt = &now
+	e.LastError = ""
+	e.Message = []byte{}
+	return ob.store.UpdateOutboxEmail(ctx, e)
+}
+
+// backoff returns the delay before the next attempt after the given number of
+// failed attempts
+func (ob *Outbox) backoff(attempts int) time.Duration {
+	d := ob.cfg.InitialBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= ob.cfg.MaxBackoff {
+			return ob.cfg.MaxBackoff
+		}
+	}
+	return d
+}
@@ -0,0 +1,125 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
+	"github.com/mxc-foundation/lpwan-app-server/internal/audit"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+var outboxStatusToPB = map[string]api.EmailOutboxStatus{
+	OutboxStatusFailed:  api.EmailOutboxStatus_EMAIL_OUTBOX_FAILED,
+	OutboxStatusPending: api.EmailOutboxStatus_EMAIL_OUTBOX_PENDING,
+	OutboxStatusSent:    api.EmailOutboxStatus_EMAIL_OUTBOX_SENT,
+}
+
+var outboxStatusFromPB = map[api.EmailOutboxStatus]string{
+	api.EmailOutboxStatus_EMAIL_OUTBOX_FAILED:  OutboxStatusFailed,
+	api.EmailOutboxStatus_EMAIL_OUTBOX_PENDING: OutboxStatusPending,
+	api.EmailOutboxStatus_EMAIL_OUTBOX_SENT:    OutboxStatusSent,
+}
+
+// OutboxServer implements the email outbox service API
+type OutboxServer struct {
+	store OutboxStore
+	auth  auth.Authenticator
+}
+
+// NewOutboxServer creates a new email outbox service server
+func NewOutboxServer(store OutboxStore, auth auth.Authenticator) *OutboxServer {
+	return &OutboxServer{
+		store: store,
+		auth:  auth,
+	}
+}
+
+func (a *OutboxServer) checkGlobalAdmin(ctx context.Context) error {
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions())
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.IsGlobalAdmin {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// List returns the emails in the outbox, the newest first. Only the global
+// admin may list the emails.
+func (a *OutboxServer) List(ctx context.Context, req *api.ListEmailOutboxRequest) (*api.ListEmailOutboxResponse, error) {
+	if err := a.checkGlobalAdmin(ctx); err != nil {
+		return nil, err
+	}
+	st, ok := outboxStatusFromPB[req.Status]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status: %v", req.Status)
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	filter := OutboxFilter{
+		Status:    st,
+		Recipient: req.Recipient,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultLimit
+	}
+	if filter.Limit > maxLimit {
+		filter.Limit = maxLimit
+	}
+
+	count, err := a.store.GetOutboxEmailCount(ctx, filter)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	emails, err := a.store.GetOutboxEmails(ctx, filter)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	resp := &api.ListEmailOutboxResponse{TotalCount: count}
+	for _, e := range emails {
+		item := &api.EmailOutboxItem{
+			Id:            e.ID,
+			Recipient:     e.Recipient,
+			Kind:          e.Kind,
+			Status:        outboxStatusToPB[e.Status],
+			Attempts:      int32(e.Attempts),
+			LastError:     e.LastError,
+			CreatedAt:     timestamppb.New(e.CreatedAt),
+			NextAttemptAt: timestamppb.New(e.NextAttemptAt),
+		}
+		if e.SentAt != nil {
+			item.SentAt = timestamppb.New(*e.SentAt)
+		}
+		resp.Result = append(resp.Result, item)
+	}
+	return resp, nil
+}
+
+// Retry makes the failed email pending again so that the outbox worker sends
+// it with the next batch
+func (a *OutboxServer) Retry(ctx context.Context, req *api.RetryEmailOutboxRequest) (*api.RetryEmailOutboxResponse, error) {
+	if err := a.checkGlobalAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := a.store.RetryOutboxEmail(ctx, req.Id, time.Now()); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	audit.Describe(ctx, 0, fmt.Sprintf("email:%d", req.Id), nil, nil)
+	return &api.RetryEmailOutboxResponse{}, nil
+}
@@ -0,0 +1,376 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+)
+
+// smtpStandIn is the minimal SMTP server accepting the messages without
+// authentication
+type smtpStandIn struct {
+	ln net.Listener
+
+	mu sync.Mutex
+	// reject is the number of the next recipients to reject
+	reject   int
+	messages []string
+}
+
+func newSMTPStandIn(t *testing.T) *smtpStandIn {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpStandIn{ln: ln}
+	go s.serve()
+	t.Cleanup(func() { _ = ln.Close() })
+	return s
+}
+
+func (s *smtpStandIn) port() string {
+	return fmt.Sprintf("%d", s.ln.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *smtpStandIn) setReject(n int) {
+	s.mu.Lock()
+	s.reject = n
+	s.mu.Unlock()
+}
+
+func (s *smtpStandIn) received() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.messages...)
+}
+
+func (s *smtpStandIn) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpStandIn) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		fmt.Fprintf(conn, "%s\r\n", line)
+	}
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "RCPT TO"):
+			s.mu.Lock()
+			rejected := s.reject > 0
+			if rejected {
+				s.reject--
+			}
+			s.mu.Unlock()
+			if rejected {
+				reply("451 try again later")
+			} else {
+				reply("250 OK")
+			}
+		case cmd == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, data.String())
+			s.mu.Unlock()
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+type testOutboxStore struct {
+	emails []*OutboxEmail
+}
+
+func (ts *testOutboxStore) InsertOutboxEmail(ctx context.Context, e *OutboxEmail) error {
+	e.ID = int64(len(ts.emails) + 1)
+	c := *e
+	ts.emails = append(ts.emails, &c)
+	return nil
+}
+
+func (ts *testOutboxStore) GetDueOutboxEmails(ctx context.Context, now time.Time, limit int) ([]OutboxEmail, error) {
+	var res []OutboxEmail
+	for _, e := range ts.emails {
+		if e.Status == OutboxStatusPending && !e.NextAttemptAt.After(now) && len(res) < limit {
+			res = append(res, *e)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].NextAttemptAt.Before(res[j].NextAttemptAt) })
+	return res, nil
+}
+
+func (ts *testOutboxStore) UpdateOutboxEmail(ctx context.Context, e *OutboxEmail) error {
+	for i := range ts.emails {
+		if ts.emails[i].ID == e.ID {
+			c := *e
+			ts.emails[i] = &c
+			return nil
+		}
+	}
+	return errHandler.ErrDoesNotExist
+}
+
+func (ts *testOutboxStore) GetOutboxEmailSentCount(ctx context.Context, recipient string, since time.Time) (int64, error) {
+	var count int64
+	for _, e := range ts.emails {
+		if e.Recipient == recipient && e.SentAt != nil && !e.SentAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (ts *testOutboxStore) DeleteOutboxEmails(ctx context.Context, before time.Time) (int64, error) {
+	var kept []*OutboxEmail
+	for _, e := range ts.emails {
+		if e.Status == OutboxStatusPending || !e.CreatedAt.Before(before) {
+			kept = append(kept, e)
+		}
+	}
+	n := int64(len(ts.emails) - len(kept))
+	ts.emails = kept
+	return n, nil
+}
+
+func (ts *testOutboxStore) GetOutboxEmailCount(ctx context.Context, filter OutboxFilter) (int64, error) {
+	emails, _ := ts.GetOutboxEmails(ctx, OutboxFilter{Status: filter.Status, Recipient: filter.Recipient})
+	return int64(len(emails)), nil
+}
+
+func (ts *testOutboxStore) GetOutboxEmails(ctx context.Context, filter OutboxFilter) ([]OutboxEmail, error) {
+	var res []OutboxEmail
+	for _, e := range ts.emails {
+		if (filter.Status == "" || e.Status == filter.Status) &&
+			(filter.Recipient == "" || e.Recipient == filter.Recipient) {
+			c := *e
+			c.Message = nil
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
+func (ts *testOutboxStore) RetryOutboxEmail(ctx context.Context, id int64, now time.Time) error {
+	for _, e := range ts.emails {
+		if e.ID == id && e.Status == OutboxStatusFailed {
+			e.Status = OutboxStatusPending
+			e.Attempts = 0
+			e.NextAttemptAt = now
+			return nil
+		}
+	}
+	return errHandler.ErrDoesNotExist
+}
+
+func (ts *testOutboxStore) get(id int64) OutboxEmail {
+	for _, e := range ts.emails {
+		if e.ID == id {
+			return *e
+		}
+	}
+	return OutboxEmail{}
+}
+
+// String formats the email without the message for the test failures
+func (e OutboxEmail) String() string {
+	return fmt.Sprintf("{ID:%d Recipient:%s Kind:%s Status:%s Attempts:%d LastError:%q NextAttemptAt:%v SentAt:%v}",
+		e.ID, e.Recipient, e.Kind, e.Status, e.Attempts, e.LastError, e.NextAttemptAt, e.SentAt)
+}
+
+func TestOutbox(t *testing.T) {
+	ctx := context.Background()
+	smtpSrv := newSMTPStandIn(t)
+	mailer, err := NewMailer(Operator{Operator: "Supernode"}, map[string]SMTPConfig{
+		"test": {Email: "noreply@example.com", Host: "127.0.0.1", Port: smtpSrv.port()},
+	}, ServerInfo{ServerAddr: "supernode.example.com", DefaultLanguage: "en"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := &testOutboxStore{}
+	ob := newOutbox(OutboxConfig{
+		Enabled:            true,
+		MaxAttempts:        3,
+		InitialBackoff:     time.Minute,
+		RecipientRateLimit: 1,
+	}, st, mailer)
+	mailer.outbox = st
+
+	// the email is queued, the SMTP server is not contacted by the handler
+	smtpSrv.setReject(1)
+	if err := mailer.SendRegistrationConfirmation("alice@example.com", "en", "secret-token"); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	if len(st.emails) != 1 || st.emails[0].Status != OutboxStatusPending || st.emails[0].Kind != string(RegistrationConfirmation) {
+		t.Fatalf("expected the email to be queued, got %v", st.emails)
+	}
+	if len(smtpSrv.received()) != 0 {
+		t.Fatalf("expected nothing to be sent")
+	}
+
+	// the first attempt fails and the email is retried after the backoff
+	if err := ob.process(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+	e := st.get(1)
+	if e.Status != OutboxStatusPending || e.Attempts != 1 || e.LastError == "" || !e.NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("unexpected email after the failed attempt: %v", e)
+	}
+	if err := ob.process(ctx, now.Add(30*time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if e := st.get(1); e.Attempts != 1 {
+		t.Errorf("the email has been retried before the backoff: %v", e)
+	}
+	if err := ob.process(ctx, now.Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	e = st.get(1)
+	if e.Status != OutboxStatusSent || e.Attempts != 2 || e.SentAt == nil || len(e.Message) != 0 {
+		t.Errorf("unexpected email after sending: %v", e)
+	}
+	msgs := smtpSrv.received()
+	if len(msgs) != 1 || !strings.Contains(msgs[0], "alice@example.com") {
+		t.Fatalf("unexpected messages received: %v", msgs)
+	}
+
+	// the recipient has reached the rate limit, the email is postponed
+	// without counting the attempt
+	if err := mailer.SendPasswordResetUnknown("alice@example.com", "en"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ob.process(ctx, now.Add(2*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	e = st.get(2)
+	if e.Status != OutboxStatusPending || e.Attempts != 0 || !e.NextAttemptAt.Equal(now.Add(2*time.Minute+time.Hour)) {
+		t.Errorf("unexpected email over the rate limit: %v", e)
+	}
+	if len(smtpSrv.received()) != 1 {
+		t.Errorf("expected the email over the rate limit not to be sent")
+	}
+
+	// the email is marked as failed after max attempts
+	smtpSrv.setReject(10)
+	if err := mailer.SendPasswordResetUnknown("bob@example.com", "en"); err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range []time.Duration{0, time.Minute, 3 * time.Minute} {
+		if err := ob.process(ctx, now.Add(3*time.Minute+d)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	e = st.get(3)
+	if e.Status != OutboxStatusFailed || e.Attempts != 3 || len(e.Message) == 0 {
+		t.Errorf("unexpected email after max attempts: %v", e)
+	}
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	ob := newOutbox(OutboxConfig{InitialBackoff: time.Minute, MaxBackoff: 5 * time.Minute}, nil, nil)
+	for attempts, exp := range map[int]time.Duration{
+		1: time.Minute,
+		2: 2 * time.Minute,
+		3: 4 * time.Minute,
+		4: 5 * time.Minute,
+		9: 5 * time.Minute,
+	} {
+		if d := ob.backoff(attempts); d != exp {
+			t.Errorf("backoff after %d attempts: expected %v, got %v", attempts, exp, d)
+		}
+	}
+}
+
+type testAuth struct {
+	globalAdmin bool
+}
+
+func (ta *testAuth) GetCredentials(ctx context.Context, opts *auth.Options) (*auth.Credentials, error) {
+	return &auth.Credentials{UserID: 1, Username: "admin", IsGlobalAdmin: ta.globalAdmin}, nil
+}
+
+func TestOutboxServer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	st := &testOutboxStore{}
+	for _, e := range []OutboxEmail{
+		{Recipient: "alice@example.com", Status: OutboxStatusSent, SentAt: &now},
+		{Recipient: "bob@example.com", Status: OutboxStatusFailed, Attempts: 10, LastError: "SMTP server failed"},
+	} {
+		e := e
+		if err := st.InsertOutboxEmail(ctx, &e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	srv := NewOutboxServer(st, &testAuth{})
+	if _, err := srv.List(ctx, &api.ListEmailOutboxRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected permission denied, got %v", err)
+	}
+
+	srv = NewOutboxServer(st, &testAuth{globalAdmin: true})
+	// the failed emails are listed by default
+	resp, err := srv.List(ctx, &api.ListEmailOutboxRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.TotalCount != 1 || resp.Result[0].Recipient != "bob@example.com" || resp.Result[0].Attempts != 10 ||
+		resp.Result[0].Status != api.EmailOutboxStatus_EMAIL_OUTBOX_FAILED {
+		t.Errorf("unexpected response: %v", resp)
+	}
+
+	if _, err := srv.Retry(ctx, &api.RetryEmailOutboxRequest{Id: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if e := st.get(2); e.Status != OutboxStatusPending || e.Attempts != 0 {
+		t.Errorf("unexpected email after retry: %v", e)
+	}
+	// only the failed emails may be retried
+	if _, err := srv.Retry(ctx, &api.RetryEmailOutboxRequest{Id: 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
@@ -15,6 +15,10 @@ var (
 var (
 	// ErrInvalidPrecision defines influxdb errors
 	ErrInvalidPrecision = errors.New("invalid precision value")
+	// ErrInvalidInfluxDBVersion is returned for unknown InfluxDB API version
+	ErrInvalidInfluxDBVersion = errors.New("invalid influxdb version")
+	// ErrInfluxDBV2Settings is returned if InfluxDB 2.x settings are missing
+	ErrInfluxDBV2Settings = errors.New("token, organization and bucket must be set for influxdb 2.x")
 )
 
 // Action defines the action type.
@@ -30,6 +30,7 @@ import (
 	dps "github.com/mxc-foundation/lpwan-app-server/internal/api/external/dp"
 	"github.com/mxc-foundation/lpwan-app-server/internal/integration/models"
 	apps "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/modules/coverage"
 	ds "github.com/mxc-foundation/lpwan-app-server/internal/modules/device/data"
 	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
 )
@@ -54,6 +55,7 @@ var tasks = []func(*uplinkContext) error{
 	getApplication,
 	getDeviceProfile,
 	updateDeviceLastSeenAndDR,
+	updateCoverage,
 	updateDeviceActivation,
 	decryptPayload,
 	handleApplicationLayers,
@@ -122,6 +124,20 @@ func updateDeviceLastSeenAndDR(ctx *uplinkContext) error {
 	return nil
 }
 
+func updateCoverage(ctx *uplinkContext) error {
+	if ctx.device.Latitude == nil || ctx.device.Longitude == nil {
+		return nil
+	}
+
+	// coverage is informative, failing to update it must not stop the uplink
+	if err := coverage.RecordUplink(ctx.ctx, ctx.handler, *ctx.device.Latitude, *ctx.device.Longitude,
+		ctx.uplinkDataReq.RxInfo); err != nil {
+		log.WithError(err).WithField("dev_eui", ctx.device.DevEUI).Error("update gateway coverage error")
+	}
+
+	return nil
+}
+
 func updateDeviceActivation(ctx *uplinkContext) error {
 	da := ctx.uplinkDataReq.DeviceActivationContext
 
@@ -16,6 +16,7 @@ import (
 	"github.com/brocaar/lorawan/applayer/fragmentation"
 	"github.com/brocaar/lorawan/applayer/multicastsetup"
 
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
 	"github.com/mxc-foundation/lpwan-app-server/internal/logging"
 	"github.com/mxc-foundation/lpwan-app-server/internal/modules/multicast-group"
 
@@ -67,8 +68,16 @@ func Setup(name string, h *store.Handler, conf Config, s FuotaStruct, nsCli *nsc
 	return nil
 }
 
+// leaderJob is the name of the job in the leader election
+const leaderJob = "fuota-deployment"
+
 func (c *controller) fuotaDeploymentLoop(h *store.Handler) {
 	for {
+		if !leader.IsLeader(leaderJob) {
+			time.Sleep(c.interval)
+			continue
+		}
+
 		ctxID, err := uuid.NewV4()
 		if err != nil {
 			log.WithError(err).Error("new uuid error")
@@ -0,0 +1,9 @@
+package data
+
+import "time"
+
+// Config defines the gateway ping settings
+type Config struct {
+	// Pings older than this are deleted, pings are kept forever when not set.
+	HistoryRetention time.Duration `mapstructure:"history_retention"`
+}
@@ -20,23 +20,38 @@ import (
 
 	nsd "github.com/mxc-foundation/lpwan-app-server/internal/api/external/ns"
 	"github.com/mxc-foundation/lpwan-app-server/internal/backend/networkserver"
+	"github.com/mxc-foundation/lpwan-app-server/internal/config"
+	gwpingdata "github.com/mxc-foundation/lpwan-app-server/internal/gwping/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
 	"github.com/mxc-foundation/lpwan-app-server/internal/logging"
 	gwd "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
 	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
 )
 
 func init() {
+	mgr.RegisterSettingsSetup(moduleName, SettingsSetup)
 	mgr.RegisterModuleSetup(moduleName, Setup)
 }
 
 const moduleName = "gwping"
 
+// historyCleanupInterval defines how often the pings exceeding the history
+// retention are deleted
+const historyCleanupInterval = time.Hour
+
 var ctrl struct {
 	handler *store.Handler
+	s       gwpingdata.Config
 
 	moduleUp bool
 }
 
+// SettingsSetup stores the gateway ping settings
+func SettingsSetup(name string, conf config.Config) error {
+	ctrl.s = conf.ApplicationServer.GatewayPing
+	return nil
+}
+
 func Setup(name string, h *store.Handler) error {
 	if ctrl.moduleUp {
 		return nil
@@ -54,7 +69,13 @@ func Setup(name string, h *store.Handler) error {
 
 // SendPingLoop is a never returning function sending the gateway pings.
 func SendPingLoop() {
+	var lastCleanup time.Time
 	for {
+		if !leader.IsLeader(moduleName) {
+			time.Sleep(time.Second)
+			continue
+		}
+
 		ctxID, err := uuid.NewV4()
 		if err != nil {
 			log.WithError(err).Error("new uuid error")
@@ -66,10 +87,29 @@ func SendPingLoop() {
 		if err := sendGatewayPing(ctx, ctrl.handler); err != nil {
 			log.Errorf("send gateway ping error: %s", err)
 		}
+		if ctrl.s.HistoryRetention > 0 && time.Since(lastCleanup) >= historyCleanupInterval {
+			lastCleanup = time.Now()
+			if err := deleteExpiredPings(ctx, ctrl.handler, ctrl.s.HistoryRetention); err != nil {
+				log.Errorf("delete expired gateway pings error: %s", err)
+			}
+		}
 		time.Sleep(time.Second)
 	}
 }
 
+// deleteExpiredPings deletes the pings that are older than the retention
+// period, the last ping of every gateway is kept.
+func deleteExpiredPings(ctx context.Context, handler *store.Handler, retention time.Duration) error {
+	n, err := handler.DeleteGatewayPingsBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		log.WithField("count", n).Info("expired gateway pings deleted")
+	}
+	return nil
+}
+
 // HandleReceivedPing handles a ping received by one or multiple gateways.
 func HandleReceivedPing(ctx context.Context, req *as.HandleProprietaryUplinkRequest) error {
 	var mic lorawan.MIC
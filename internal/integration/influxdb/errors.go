@@ -6,5 +6,7 @@ import (
 
 // errors
 var (
-	ErrInvalidPrecision = errors.ErrInvalidPrecision
+	ErrInvalidPrecision   = errors.ErrInvalidPrecision
+	ErrInvalidVersion     = errors.ErrInvalidInfluxDBVersion
+	ErrInfluxDBV2Settings = errors.ErrInfluxDBV2Settings
 )
@@ -2,18 +2,15 @@
 package influxdb
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"net/http"
-	"net/url"
 	"reflect"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mmcloughlin/geohash"
 	"github.com/pkg/errors"
@@ -28,14 +25,28 @@ import (
 
 var precisionValidator = regexp.MustCompile(`^(ns|u|ms|s|m|h)$`)
 
+// InfluxDB API versions
+const (
+	// Version1 is the InfluxDB 1.x API with db / rp and basic auth
+	Version1 = "v1"
+	// Version2 is the InfluxDB 2.x API with org / bucket and token auth
+	Version2 = "v2"
+)
+
 // Config contains the configuration for the InfluxDB integration.
 type Config struct {
+	// Version is the InfluxDB API version, Version1 if not set
+	Version             string `json:"version"`
 	Endpoint            string `json:"endpoint"`
 	DB                  string `json:"db"`
 	Username            string `json:"username"`
 	Password            string `json:"password"`
 	RetentionPolicyName string `json:"retentionPolicyName"`
 	Precision           string `json:"precision"`
+	// Token, Organization and Bucket are used by the InfluxDB 2.x API
+	Token        string `json:"token"`
+	Organization string `json:"organization"`
+	Bucket       string `json:"bucket"`
 }
 
 // Validate validates the HandlerConfig data.
@@ -43,6 +54,19 @@ func (c Config) Validate() error {
 	if !precisionValidator.MatchString(c.Precision) {
 		return ErrInvalidPrecision
 	}
+	switch c.Version {
+	case "", Version1:
+	case Version2:
+		if c.Token == "" || c.Organization == "" || c.Bucket == "" {
+			return ErrInfluxDBV2Settings
+		}
+		// InfluxDB 2.x doesn't support minutes and hours
+		if c.Precision == "m" || c.Precision == "h" {
+			return ErrInvalidPrecision
+		}
+	default:
+		return ErrInvalidVersion
+	}
 	return nil
 }
 
@@ -118,43 +142,17 @@ func New(conf Config) (*Integration, error) {
 }
 
 func (i *Integration) send(measurements []measurement) error {
-	var measStr []string
+	var lines []string
 	for _, m := range measurements {
-		measStr = append(measStr, m.String())
-	}
-	sort.Strings(measStr)
-
-	b := []byte(strings.Join(measStr, "\n"))
-
-	args := url.Values{}
-	args.Set("db", i.config.DB)
-	args.Set("precision", i.config.Precision)
-	args.Set("rp", i.config.RetentionPolicyName)
-
-	req, err := http.NewRequest("POST", i.config.Endpoint+"?"+args.Encode(), bytes.NewReader(b))
-	if err != nil {
-		return errors.Wrap(err, "new request error")
+		lines = append(lines, m.String())
 	}
+	sort.Strings(lines)
 
-	req.Header.Set("Content-Type", "text/plain")
-
-	if i.config.Username != "" || i.config.Password != "" {
-		req.SetBasicAuth(i.config.Username, i.config.Password)
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return errors.Wrap(err, "http request error")
-	}
-	defer resp.Body.Close()
-
-	// check that response is in 200 range
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		b, _ := ioutil.ReadAll(resp.Body)
-		return fmt.Errorf("expected 2xx response, got: %d (%s)", resp.StatusCode, string(b))
+	if batchConf.BatchSize > 1 {
+		queue(i.config, lines, time.Now())
+		return nil
 	}
-
-	return nil
+	return write(i.config, []byte(strings.Join(lines, "\n")))
 }
 
 // Close closes the handler.
@@ -7,7 +7,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
@@ -17,6 +20,7 @@ import (
 	"github.com/brocaar/chirpstack-api/go/v3/gw"
 
 	"github.com/mxc-foundation/lpwan-app-server/internal/integration/models"
+	"github.com/mxc-foundation/lpwan-app-server/internal/integration/types"
 )
 
 func init() {
@@ -338,3 +342,125 @@ device_uplink,application_name=test-app,dev_eui=0102030405060708,device_name=tes
 func TestHandler(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		conf Config
+		err  error
+	}{
+		{
+			name: "v1",
+			conf: Config{Endpoint: "http://localhost:8086/write", DB: "db", Precision: "h"},
+		},
+		{
+			name: "v2",
+			conf: Config{Version: Version2, Precision: "u", Token: "t", Organization: "o", Bucket: "b"},
+		},
+		{
+			name: "v2 without bucket",
+			conf: Config{Version: Version2, Precision: "s", Token: "t", Organization: "o"},
+			err:  ErrInfluxDBV2Settings,
+		},
+		{
+			name: "v2 precision",
+			conf: Config{Version: Version2, Precision: "h", Token: "t", Organization: "o", Bucket: "b"},
+			err:  ErrInvalidPrecision,
+		},
+		{
+			name: "unknown version",
+			conf: Config{Version: "v3", Precision: "s"},
+			err:  ErrInvalidVersion,
+		},
+	} {
+		if err := tc.conf.Validate(); err != tc.err {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
+type batchHTTPHandler struct {
+	mu     sync.Mutex
+	fail   int
+	bodies []string
+	req    *http.Request
+}
+
+func (h *batchHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b, _ := ioutil.ReadAll(r.Body)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.req = r
+	if h.fail > 0 {
+		h.fail--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	h.bodies = append(h.bodies, string(b))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestBatchedWrites(t *testing.T) {
+	defer Setup(types.IntegrationInfluxDBConfig{})
+	Setup(types.IntegrationInfluxDBConfig{
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+		MaxBufferSize: 3,
+	})
+
+	h := &batchHTTPHandler{fail: 1}
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	conf := Config{
+		Version:      Version2,
+		Endpoint:     server.URL + "/api/v2/write",
+		Precision:    "u",
+		Token:        "secret",
+		Organization: "mxc",
+		Bucket:       "devices",
+	}
+	ts := time.Unix(1600000000, 0)
+	// full batch is written immediately, the write fails and the batch is
+	// retried after the flush interval
+	queue(conf, []string{"a value=1i", "b value=2i"}, ts)
+	w := getWriter(conf)
+	for start := time.Now(); ; time.Sleep(10 * time.Millisecond) {
+		h.mu.Lock()
+		failed := h.fail == 0
+		h.mu.Unlock()
+		w.mu.Lock()
+		returned := len(w.lines) == 2
+		w.mu.Unlock()
+		if failed && returned {
+			break
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("the batch hasn't been written")
+		}
+	}
+	// the oldest point is dropped when the buffer is full
+	queue(conf, []string{"c value=3i", "d value=4i"}, ts)
+	Flush()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	expected := []string{
+		"b value=2i 1600000000000000\nc value=3i 1600000000000000",
+		"d value=4i 1600000000000000",
+	}
+	if !reflect.DeepEqual(h.bodies, expected) {
+		t.Errorf("expected %q, got %q", expected, h.bodies)
+	}
+	if auth := h.req.Header.Get("Authorization"); auth != "Token secret" {
+		t.Errorf("unexpected authorization header: %s", auth)
+	}
+	expectedQuery := url.Values{
+		"org":       []string{"mxc"},
+		"bucket":    []string{"devices"},
+		"precision": []string{"us"},
+	}
+	if !reflect.DeepEqual(h.req.URL.Query(), expectedQuery) {
+		t.Errorf("expected query %v, got %v", expectedQuery, h.req.URL.Query())
+	}
+}
@@ -0,0 +1,325 @@
+package influxdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/integration/types"
+)
+
+const (
+	defaultFlushInterval = time.Second
+	// maxRetryInterval is the max interval between the retries of the
+	// failed write
+	maxRetryInterval = 5 * time.Minute
+	// idleTimeout is the time after which the writer that hasn't been used
+	// is removed
+	idleTimeout = 10 * time.Minute
+)
+
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+var (
+	batchConf types.IntegrationInfluxDBConfig
+
+	writersMu sync.Mutex
+	writers   = make(map[string]*writer)
+)
+
+// Setup configures the batched writes. If the batch size is greater than one,
+// the points are buffered and written in batches instead of writing every
+// event with a separate request.
+func Setup(conf types.IntegrationInfluxDBConfig) {
+	if conf.FlushInterval <= 0 {
+		conf.FlushInterval = defaultFlushInterval
+	}
+	if conf.MaxBufferSize < conf.BatchSize {
+		conf.MaxBufferSize = 10 * conf.BatchSize
+	}
+	batchConf = conf
+}
+
+// Flush stops the batched writers and writes all the buffered points.
+func Flush() {
+	writersMu.Lock()
+	ws := writers
+	writers = make(map[string]*writer)
+	writersMu.Unlock()
+
+	for _, w := range ws {
+		close(w.done)
+		<-w.stopped
+		w.mu.Lock()
+		w.closed = true
+		w.mu.Unlock()
+		if err := w.writeBatches(); err != nil {
+			log.WithError(err).WithField("endpoint", w.conf.Endpoint).
+				Error("integration/influxdb: flush error")
+		}
+	}
+}
+
+// statusError is returned when InfluxDB responds with non 2xx status
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("expected 2xx response, got: %d (%s)", e.code, e.body)
+}
+
+// retryable returns true if the write that failed with err may succeed when
+// retried
+func retryable(err error) bool {
+	if se, ok := err.(*statusError); ok {
+		return se.code == http.StatusTooManyRequests || se.code >= 500
+	}
+	return true
+}
+
+// write writes the points in line protocol format to InfluxDB
+func write(conf Config, body []byte) error {
+	args := url.Values{}
+	if conf.Version == Version2 {
+		args.Set("org", conf.Organization)
+		args.Set("bucket", conf.Bucket)
+		if conf.Precision == "u" {
+			args.Set("precision", "us")
+		} else {
+			args.Set("precision", conf.Precision)
+		}
+	} else {
+		args.Set("db", conf.DB)
+		args.Set("precision", conf.Precision)
+		args.Set("rp", conf.RetentionPolicyName)
+	}
+
+	req, err := http.NewRequest("POST", conf.Endpoint+"?"+args.Encode(), bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "new request error")
+	}
+
+	req.Header.Set("Content-Type", "text/plain")
+
+	if conf.Version == Version2 {
+		req.Header.Set("Authorization", "Token "+conf.Token)
+	} else if conf.Username != "" || conf.Password != "" {
+		req.SetBasicAuth(conf.Username, conf.Password)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http request error")
+	}
+	defer resp.Body.Close()
+
+	// check that response is in 200 range
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return &statusError{code: resp.StatusCode, body: string(b)}
+	}
+
+	return nil
+}
+
+// formatTimestamp returns the time in the units of the precision
+func formatTimestamp(t time.Time, precision string) string {
+	ts := t.UnixNano()
+	switch precision {
+	case "u":
+		ts /= int64(time.Microsecond)
+	case "ms":
+		ts /= int64(time.Millisecond)
+	case "s":
+		ts /= int64(time.Second)
+	case "m":
+		ts /= int64(time.Minute)
+	case "h":
+		ts /= int64(time.Hour)
+	}
+	return strconv.FormatInt(ts, 10)
+}
+
+// queue adds the points to the buffer of the writer of the InfluxDB target.
+// The points are timestamped with t, so the time of the event is kept when
+// the write is delayed.
+func queue(conf Config, lines []string, t time.Time) {
+	ts := " " + formatTimestamp(t, conf.Precision)
+	timestamped := make([]string, len(lines))
+	for i, l := range lines {
+		timestamped[i] = l + ts
+	}
+	// the writer might have been removed as idle after we got it
+	for !getWriter(conf).add(timestamped) {
+	}
+}
+
+// writer buffers the points written to one InfluxDB target and writes them
+// in batches
+type writer struct {
+	conf Config
+
+	mu       sync.Mutex
+	lines    []string
+	lastUsed time.Time
+	// closed is set when the writer has been removed and doesn't accept
+	// new points
+	closed bool
+
+	flush   chan struct{}
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+func getWriter(conf Config) *writer {
+	// Config contains only strings, so marshaling never fails
+	key, _ := json.Marshal(conf)
+
+	writersMu.Lock()
+	defer writersMu.Unlock()
+	w, ok := writers[string(key)]
+	if !ok {
+		w = &writer{
+			conf:     conf,
+			lastUsed: time.Now(),
+			flush:    make(chan struct{}, 1),
+			done:     make(chan struct{}),
+			stopped:  make(chan struct{}),
+		}
+		writers[string(key)] = w
+		go w.run(string(key))
+	}
+	return w
+}
+
+// add adds the points to the buffer. If the buffer is full the oldest points
+// are dropped. It returns false if the writer has been closed.
+func (w *writer) add(lines []string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return false
+	}
+	w.lines = append(w.lines, lines...)
+	w.trim()
+	w.lastUsed = time.Now()
+	if len(w.lines) >= batchConf.BatchSize {
+		select {
+		case w.flush <- struct{}{}:
+		default:
+		}
+	}
+	return true
+}
+
+// trim drops the oldest points if the buffer is full, the caller must hold
+// the lock
+func (w *writer) trim() {
+	if over := len(w.lines) - batchConf.MaxBufferSize; over > 0 {
+		log.WithField("endpoint", w.conf.Endpoint).
+			Warnf("integration/influxdb: buffer is full, dropping %d points", over)
+		w.lines = w.lines[over:]
+	}
+}
+
+func (w *writer) run(key string) {
+	defer close(w.stopped)
+	ticker := time.NewTicker(batchConf.FlushInterval)
+	defer ticker.Stop()
+
+	var backoff time.Duration
+	var retryAt time.Time
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-w.flush:
+		case <-ticker.C:
+			if w.idle(key) {
+				return
+			}
+		}
+		if time.Now().Before(retryAt) {
+			continue
+		}
+		if err := w.writeBatches(); err != nil {
+			backoff *= 2
+			if backoff < batchConf.FlushInterval {
+				backoff = batchConf.FlushInterval
+			}
+			if backoff > maxRetryInterval {
+				backoff = maxRetryInterval
+			}
+			retryAt = time.Now().Add(backoff)
+			log.WithError(err).WithField("endpoint", w.conf.Endpoint).
+				Warnf("integration/influxdb: write error, retry in %s", backoff)
+			continue
+		}
+		backoff = 0
+	}
+}
+
+// idle removes the writer if its buffer is empty and it hasn't been used for
+// a while
+func (w *writer) idle(key string) bool {
+	writersMu.Lock()
+	defer writersMu.Unlock()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.lines) > 0 || time.Since(w.lastUsed) < idleTimeout {
+		return false
+	}
+	w.closed = true
+	if writers[key] == w {
+		delete(writers, key)
+	}
+	return true
+}
+
+// writeBatches writes the buffered points in batches until the buffer is
+// empty or the write fails. The batch that failed with temporary error is
+// returned to the buffer to be retried later, otherwise it's dropped.
+func (w *writer) writeBatches() error {
+	for {
+		w.mu.Lock()
+		n := len(w.lines)
+		if n > batchConf.BatchSize {
+			n = batchConf.BatchSize
+		}
+		batch := w.lines[:n]
+		w.lines = w.lines[n:]
+		w.mu.Unlock()
+		if n == 0 {
+			return nil
+		}
+
+		err := write(w.conf, []byte(strings.Join(batch, "\n")))
+		if err == nil {
+			continue
+		}
+		if !retryable(err) {
+			log.WithError(err).WithField("endpoint", w.conf.Endpoint).
+				Errorf("integration/influxdb: write error, dropping %d points", n)
+			continue
+		}
+		w.mu.Lock()
+		lines := make([]string, 0, n+len(w.lines))
+		lines = append(lines, batch...)
+		w.lines = append(lines, w.lines...)
+		w.trim()
+		w.mu.Unlock()
+		return err
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/mxc-foundation/lpwan-app-server/internal/devicedata"
 	"github.com/mxc-foundation/lpwan-app-server/internal/integration/amqp"
 	"github.com/mxc-foundation/lpwan-app-server/internal/integration/awssns"
 	"github.com/mxc-foundation/lpwan-app-server/internal/integration/azureservicebus"
@@ -55,6 +56,9 @@ func SetupGlobalIntegrations(config types.IntegrationStruct) ([]models.Integrati
 		marshalType = marshaler.JSONV3
 	}
 
+	// batched writes of the influxdb application integrations
+	influxdb.Setup(config.InfluxDB)
+
 	// configure logger integration (for device events in web-interface)
 	i, err := logger.New(logger.Config{})
 	if err != nil {
@@ -95,6 +99,23 @@ func SetupGlobalIntegrations(config types.IntegrationStruct) ([]models.Integrati
 	return ints, nil
 }
 
+// FlushApplicationIntegrations writes the data buffered by the application
+// integrations
+func FlushApplicationIntegrations() {
+	influxdb.Flush()
+}
+
+// DeviceDataStore returns the store of the device events written by the
+// postgresql integration, or nil if the integration is not enabled
+func DeviceDataStore(ints []models.IntegrationHandler) devicedata.Store {
+	for _, i := range ints {
+		if pi, ok := i.(*postgresql.Integration); ok {
+			return pi
+		}
+	}
+	return nil
+}
+
 // Store defines db APIs used by integration package
 type Store interface {
 	GetIntegrationsForApplicationID(ctx context.Context, applicationID int64) ([]appd.Integration, error)
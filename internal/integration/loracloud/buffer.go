@@ -103,3 +103,13 @@ func GetGeolocBuffer(ctx context.Context, devEUI lorawan.EUI64, ttl time.Duratio
 
 	return out, nil
 }
+
+// DeleteGeolocBuffer deletes the geolocation buffer of the device.
+func DeleteGeolocBuffer(ctx context.Context, devEUI lorawan.EUI64) error {
+	key := fmt.Sprintf(geolocBufferKeyTempl, devEUI)
+	if err := rs.RedisClient().Del(key).Err(); err != nil {
+		return errors.Wrap(err, "delete buffer error")
+	}
+
+	return nil
+}
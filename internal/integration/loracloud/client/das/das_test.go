@@ -92,3 +92,28 @@ func (ts *ClientTestSuite) TestUplinkSend() {
 func TestClient(t *testing.T) {
 	suite.Run(t, new(ClientTestSuite))
 }
+
+func TestUplinkResponseResult(t *testing.T) {
+	assert := require.New(t)
+
+	var res UplinkResponseResult
+	assert.NoError(json.Unmarshal([]byte(`{
+		"file": {"data": "0102"},
+		"stream_records": [[0, "0a0b"], [2, "0c"]],
+		"dnlink": {"port": 150, "payload": "ff"}
+	}`), &res))
+	assert.Equal(UplinkResponseResult{
+		File: &File{Data: helpers.HEXBytes{1, 2}},
+		StreamRecords: []StreamRecord{
+			{Offset: 0, Data: helpers.HEXBytes{10, 11}},
+			{Offset: 2, Data: helpers.HEXBytes{12}},
+		},
+		Downlink: &LoRaDownlink{Port: 150, Payload: helpers.HEXBytes{255}},
+	}, res)
+
+	b, err := json.Marshal(res.StreamRecords[0])
+	assert.NoError(err)
+	assert.Equal(`[0,"0a0b"]`, string(b))
+
+	assert.Error(json.Unmarshal([]byte(`{"stream_records": [[0]]}`), &res))
+}
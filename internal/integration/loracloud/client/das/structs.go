@@ -1,6 +1,9 @@
 package das
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/mxc-foundation/lpwan-app-server/internal/integration/loracloud/client/helpers"
 )
 
@@ -24,7 +27,7 @@ type UplinkMsg struct {
 	DR        uint8   `json:"dr"`
 	Freq      uint32  `json:"freq"`
 	Timestamp float64 `json:"timestamp"` // Seconds since UTC
-	Payload   string  `json:"payload"`   // Leave this blank
+	Payload   string  `json:"payload"`   // HEX encoded payload of the stream uplinks, blank otherwise
 }
 
 // UplinkMsgModem implements the LoRa Cloud UplinkMsg object containing a modem payload.
@@ -65,8 +68,8 @@ type UplinkResponseItem struct {
 
 // UplinkResponseResult holds the response result.
 type UplinkResponseResult struct {
-	File              interface{}       `json:"file"`
-	StreamRecords     interface{}       `json:"stream_records"`
+	File              *File             `json:"file"`
+	StreamRecords     []StreamRecord    `json:"stream_records"`
 	PositionSolution  *PositionSolution `json:"position_solution"`
 	FulfilledRequests interface{}       `json:"fulfilled_requests"`
 	FPorts            interface{}       `json:"fports"`
@@ -91,3 +94,39 @@ type PositionSolution struct {
 	Accuracy       float64   `json:"accuracy"`
 	Timestamp      float64   `json:"timestamp"`
 }
+
+// StreamRecord implements the LoRa Cloud stream record, the data of the
+// stream reassembled from the uplinks. It is encoded as [offset, data] array.
+type StreamRecord struct {
+	Offset uint32
+	Data   helpers.HEXBytes
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r StreamRecord) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{r.Offset, r.Data})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *StreamRecord) UnmarshalJSON(b []byte) error {
+	var v []json.RawMessage
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	if len(v) != 2 {
+		return fmt.Errorf("stream record must contain 2 items, got %d", len(v))
+	}
+	if err := json.Unmarshal(v[0], &r.Offset); err != nil {
+		return fmt.Errorf("invalid stream record offset: %v", err)
+	}
+	if err := json.Unmarshal(v[1], &r.Data); err != nil {
+		return fmt.Errorf("invalid stream record data: %v", err)
+	}
+	return nil
+}
+
+// File implements the LoRa Cloud file object, the file reassembled from the
+// fragments uploaded by the device.
+type File struct {
+	Data helpers.HEXBytes `json:"data"`
+}
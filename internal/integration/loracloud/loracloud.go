@@ -5,6 +5,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/device"
@@ -26,6 +28,7 @@ import (
 	"github.com/mxc-foundation/lpwan-app-server/internal/integration/loracloud/client/helpers"
 	"github.com/mxc-foundation/lpwan-app-server/internal/integration/models"
 	"github.com/mxc-foundation/lpwan-app-server/internal/logging"
+	"github.com/mxc-foundation/lpwan-app-server/internal/modules/coverage"
 	"github.com/mxc-foundation/lpwan-app-server/internal/storage"
 )
 
@@ -44,12 +47,55 @@ type Config struct {
 	GeolocationWifi             bool   `json:"geolocationWifi"`
 	GeolocationWifiPayloadField string `json:"geolocationWifiPayloadField"`
 
+	// GeolocationURI is the base URL of the geolocation API, the LoRa Cloud
+	// instance is used if not set.
+	GeolocationURI string `json:"geolocationURI"`
+
 	// Device Application Services.
 	DAS              bool   `json:"das"`
 	DASToken         string `json:"dasToken"`
 	DASModemPort     uint8  `json:"dasModemPort"`
 	DASGNSSPort      uint8  `json:"dasGNSSPort"`
 	DASGNSSUseRxTime bool   `json:"dasGNSSUseRxTime"`
+	// DASStreamPort is the FPort of the uplinks carrying the stream data, the
+	// stream is disabled if not set.
+	DASStreamPort uint8 `json:"dasStreamPort"`
+	// DASURI is the base URL of the DAS API, the LoRa Cloud instance is used
+	// if not set.
+	DASURI string `json:"dasURI"`
+}
+
+// Defaults used when the config doesn't set the values.
+const (
+	DefaultGeolocationURI = "https://gls.loracloud.com"
+	DefaultDASURI         = "https://das.loracloud.com"
+	DefaultDASGNSSPort    = 198
+)
+
+// Validate checks that the config is valid.
+func (c Config) Validate() error {
+	for name, uri := range map[string]string{"geolocation": c.GeolocationURI, "das": c.DASURI} {
+		if uri == "" {
+			continue
+		}
+		u, err := url.Parse(uri)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid %s uri: %s", name, uri)
+		}
+	}
+	if c.DAS {
+		ports := map[uint8]string{c.DASModemPort: "modem"}
+		for name, port := range map[string]uint8{"gnss": c.DASGNSSPort, "stream": c.DASStreamPort} {
+			if port == 0 {
+				continue
+			}
+			if other, ok := ports[port]; ok {
+				return fmt.Errorf("das %s port must be different from the %s port", name, other)
+			}
+			ports[port] = name
+		}
+	}
+	return nil
 }
 
 // Integration implements a LoRaCloud Integration.
@@ -59,19 +105,36 @@ type Integration struct {
 	config         Config
 	geolocationURI string
 	dasURI         string
+	// enqueueDownlink queues the downlink requested by DAS to the device
+	enqueueDownlink func(ctx context.Context, devEUI lorawan.EUI64, fPort uint8, data []byte) (uint32, error)
 }
 
 // New creates a new LoRaCloud integration.
 func New(conf Config, h *store.Handler, nsCli *nscli.Client) (*Integration, error) {
-	conf.DASGNSSPort = 198
-
-	return &Integration{
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+	if conf.DASGNSSPort == 0 {
+		conf.DASGNSSPort = DefaultDASGNSSPort
+	}
+	i := &Integration{
 		h:              h,
 		nsCli:          nsCli,
 		config:         conf,
-		geolocationURI: "https://gls.loracloud.com",
-		dasURI:         "https://das.loracloud.com",
-	}, nil
+		geolocationURI: strings.TrimSuffix(conf.GeolocationURI, "/"),
+		dasURI:         strings.TrimSuffix(conf.DASURI, "/"),
+	}
+	if i.geolocationURI == "" {
+		i.geolocationURI = DefaultGeolocationURI
+	}
+	if i.dasURI == "" {
+		i.dasURI = DefaultDASURI
+	}
+	i.enqueueDownlink = func(ctx context.Context, devEUI lorawan.EUI64, fPort uint8, data []byte) (uint32, error) {
+		return device.EnqueueDownlinkPayload(ctx, storage.DB(), devEUI, false, fPort, data, i.nsCli)
+	}
+
+	return i, nil
 }
 
 // HandleUplinkEvent handles the Uplinkevent.
@@ -96,6 +159,10 @@ func (i *Integration) HandleUplinkEvent(ctx context.Context, ii models.Integrati
 
 			// if it resolved to a location, send it to integrations
 			if loc != nil {
+				if err := coverage.RecordUplink(ctx, i.h, loc.Latitude, loc.Longitude, pl.RxInfo); err != nil {
+					log.WithError(err).Error("integration/loracloud: update gateway coverage error")
+				}
+
 				var fCnt uint32
 				if len(uplinkIDs) == 0 {
 					fCnt = pl.FCnt
@@ -533,15 +600,22 @@ func (i *Integration) dasUplinkMetaData(ctx context.Context, vars map[string]str
 	client := das.New(i.dasURI, i.config.DASToken)
 	start := time.Now()
 
+	msg := das.UplinkMsg{
+		MsgType:   "updf",
+		FCnt:      pl.FCnt,
+		Timestamp: float64(helpers.GetTimestamp(pl.RxInfo).Unix()),
+		Port:      uint8(pl.FPort),
+		DR:        uint8(pl.Dr),
+		Freq:      pl.GetTxInfo().Frequency,
+	}
+	// the payload of the stream uplinks is reassembled by das and returned
+	// as stream records
+	if i.config.DASStreamPort != 0 && pl.FPort == uint32(i.config.DASStreamPort) {
+		msg.Payload = helpers.HEXBytes(pl.Data).String()
+	}
+
 	resp, err := client.UplinkSend(ctx, das.UplinkRequest{
-		helpers.EUI64(devEUI): das.UplinkMsg{
-			MsgType:   "updf",
-			FCnt:      pl.FCnt,
-			Timestamp: float64(helpers.GetTimestamp(pl.RxInfo).Unix()),
-			Port:      uint8(pl.FPort),
-			DR:        uint8(pl.Dr),
-			Freq:      pl.GetTxInfo().Frequency,
-		},
+		helpers.EUI64(devEUI): msg,
 	})
 	if err != nil {
 		return errors.Wrap(err, "das error")
@@ -567,26 +641,24 @@ func (i *Integration) handleDASResponse(ctx context.Context, vars map[string]str
 		return fmt.Errorf("das api returned error: %s", devResp.Error)
 	}
 
-	b, err := json.Marshal(devResp.Result)
-	if err != nil {
-		return errors.Wrap(err, "marshal json error")
-	}
+	i.sendIntegrationEvent(ctx, vars, ii, pl, "DAS_UplinkResponse", devResp.Result)
 
-	intPL := integration.IntegrationEvent{
-		ApplicationId:   pl.ApplicationId,
-		ApplicationName: pl.ApplicationName,
-		DeviceName:      pl.DeviceName,
-		DevEui:          pl.DevEui,
-		IntegrationName: "loracloud",
-		EventType:       "DAS_UplinkResponse",
-		ObjectJson:      string(b),
+	// the data reassembled by das is sent to the integrations as separate
+	// events
+	for _, rec := range devResp.Result.StreamRecords {
+		i.sendIntegrationEvent(ctx, vars, ii, pl, "DAS_StreamRecord", struct {
+			Offset uint32 `json:"offset"`
+			Data   []byte `json:"data"`
+		}{rec.Offset, rec.Data})
 	}
-	if err := ii.HandleIntegrationEvent(ctx, nil, intPL); err != nil {
-		log.WithError(err).Error("integration/loracloud: handle integration event error")
+	if f := devResp.Result.File; f != nil {
+		i.sendIntegrationEvent(ctx, vars, ii, pl, "DAS_File", struct {
+			Data []byte `json:"data"`
+		}{f.Data})
 	}
 
 	if dl := devResp.Result.Downlink; dl != nil {
-		fCnt, err := device.EnqueueDownlinkPayload(ctx, storage.DB(), devEUI, false, dl.Port, dl.Payload[:], i.nsCli)
+		fCnt, err := i.enqueueDownlink(ctx, devEUI, dl.Port, dl.Payload[:])
 		if err != nil {
 			log.WithError(err).Error("integration/loracloud: enqueue downlink payload error")
 		} else {
@@ -625,6 +697,29 @@ func (i *Integration) handleDASResponse(ctx context.Context, vars map[string]str
 	return nil
 }
 
+// sendIntegrationEvent sends the object as JSON to the integrations.
+func (i *Integration) sendIntegrationEvent(ctx context.Context, vars map[string]string, ii models.Integration,
+	pl integration.UplinkEvent, eventType string, obj interface{}) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		log.WithError(err).Error("integration/loracloud: marshal json error")
+		return
+	}
+
+	intPL := integration.IntegrationEvent{
+		ApplicationId:   pl.ApplicationId,
+		ApplicationName: pl.ApplicationName,
+		DeviceName:      pl.DeviceName,
+		DevEui:          pl.DevEui,
+		IntegrationName: "loracloud",
+		EventType:       eventType,
+		ObjectJson:      string(b),
+	}
+	if err := ii.HandleIntegrationEvent(ctx, vars, intPL); err != nil {
+		log.WithError(err).Error("integration/loracloud: handle integration event error")
+	}
+}
+
 // filterOnFineTimestamp filters the given frame RXInfo slices on the presence
 // of a plain fine-timestamp. Per frame it filters on the availability of
 // minPerFrame.
@@ -0,0 +1,130 @@
+package loracloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brocaar/chirpstack-api/go/v3/as/integration"
+	"github.com/brocaar/lorawan"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/integration/loracloud/client/das"
+	"github.com/mxc-foundation/lpwan-app-server/internal/integration/loracloud/client/helpers"
+	"github.com/mxc-foundation/lpwan-app-server/internal/integration/models"
+)
+
+type testIntegration struct {
+	integrationEvents []integration.IntegrationEvent
+	locationEvents    []integration.LocationEvent
+}
+
+func (ti *testIntegration) HandleUplinkEvent(ctx context.Context, vars map[string]string, pl integration.UplinkEvent) error {
+	return nil
+}
+
+func (ti *testIntegration) HandleJoinEvent(ctx context.Context, vars map[string]string, pl integration.JoinEvent) error {
+	return nil
+}
+
+func (ti *testIntegration) HandleAckEvent(ctx context.Context, vars map[string]string, pl integration.AckEvent) error {
+	return nil
+}
+
+func (ti *testIntegration) HandleErrorEvent(ctx context.Context, vars map[string]string, pl integration.ErrorEvent) error {
+	return nil
+}
+
+func (ti *testIntegration) HandleStatusEvent(ctx context.Context, vars map[string]string, pl integration.StatusEvent) error {
+	return nil
+}
+
+func (ti *testIntegration) HandleLocationEvent(ctx context.Context, vars map[string]string, pl integration.LocationEvent) error {
+	ti.locationEvents = append(ti.locationEvents, pl)
+	return nil
+}
+
+func (ti *testIntegration) HandleTxAckEvent(ctx context.Context, vars map[string]string, pl integration.TxAckEvent) error {
+	return nil
+}
+
+func (ti *testIntegration) HandleIntegrationEvent(ctx context.Context, vars map[string]string, pl integration.IntegrationEvent) error {
+	ti.integrationEvents = append(ti.integrationEvents, pl)
+	return nil
+}
+
+func (ti *testIntegration) DataDownChan() chan models.DataDownPayload {
+	return nil
+}
+
+func TestNew(t *testing.T) {
+	assert := require.New(t)
+
+	i, err := New(Config{}, nil, nil)
+	assert.NoError(err)
+	assert.Equal(DefaultGeolocationURI, i.geolocationURI)
+	assert.Equal(DefaultDASURI, i.dasURI)
+	assert.EqualValues(DefaultDASGNSSPort, i.config.DASGNSSPort)
+
+	i, err = New(Config{
+		GeolocationURI: "http://localhost:8090/",
+		DASURI:         "https://das.example.com",
+		DASGNSSPort:    197,
+	}, nil, nil)
+	assert.NoError(err)
+	assert.Equal("http://localhost:8090", i.geolocationURI)
+	assert.Equal("https://das.example.com", i.dasURI)
+	assert.EqualValues(197, i.config.DASGNSSPort)
+
+	_, err = New(Config{DASURI: "das.example.com"}, nil, nil)
+	assert.Error(err)
+	_, err = New(Config{DAS: true, DASModemPort: 199, DASStreamPort: 199}, nil, nil)
+	assert.Error(err)
+}
+
+func TestHandleDASResponse(t *testing.T) {
+	assert := require.New(t)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	i, err := New(Config{DAS: true}, nil, nil)
+	assert.NoError(err)
+	var downlinks []das.LoRaDownlink
+	i.enqueueDownlink = func(ctx context.Context, eui lorawan.EUI64, fPort uint8, data []byte) (uint32, error) {
+		assert.Equal(devEUI, eui)
+		downlinks = append(downlinks, das.LoRaDownlink{Port: fPort, Payload: data})
+		return 1, nil
+	}
+
+	ii := &testIntegration{}
+	err = i.handleDASResponse(context.Background(), nil, devEUI, das.UplinkResponse{
+		Result: das.UplinkDeviceMapResponse{
+			helpers.EUI64(devEUI): das.UplinkResponseItem{
+				Result: das.UplinkResponseResult{
+					StreamRecords: []das.StreamRecord{{Offset: 0, Data: helpers.HEXBytes{1, 2}}},
+					File:          &das.File{Data: helpers.HEXBytes{3}},
+					Downlink:      &das.LoRaDownlink{Port: 150, Payload: helpers.HEXBytes{4, 5}},
+					PositionSolution: &das.PositionSolution{
+						LLH: []float64{1.5, 2.5, 3.5},
+					},
+				},
+			},
+		},
+	}, ii, integration.UplinkEvent{DevEui: devEUI[:]})
+	assert.NoError(err)
+
+	assert.Len(ii.integrationEvents, 3)
+	assert.Equal("DAS_UplinkResponse", ii.integrationEvents[0].EventType)
+	assert.Equal("DAS_StreamRecord", ii.integrationEvents[1].EventType)
+	assert.JSONEq(`{"offset": 0, "data": "AQI="}`, ii.integrationEvents[1].ObjectJson)
+	assert.Equal("DAS_File", ii.integrationEvents[2].EventType)
+	assert.JSONEq(`{"data": "Aw=="}`, ii.integrationEvents[2].ObjectJson)
+	assert.Equal([]das.LoRaDownlink{{Port: 150, Payload: helpers.HEXBytes{4, 5}}}, downlinks)
+	assert.Len(ii.locationEvents, 1)
+	assert.Equal(2.5, ii.locationEvents[0].Location.Longitude)
+
+	err = i.handleDASResponse(context.Background(), nil, devEUI, das.UplinkResponse{
+		Result: das.UplinkDeviceMapResponse{
+			helpers.EUI64(devEUI): das.UplinkResponseItem{Error: "invalid payload"},
+		},
+	}, ii, integration.UplinkEvent{DevEui: devEUI[:]})
+	assert.Error(err)
+}
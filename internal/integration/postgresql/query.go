@@ -0,0 +1,215 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/devicedata"
+)
+
+// deleteBatchSize is the max number of events deleted by one statement
+const deleteBatchSize = 10000
+
+// binaryFields are the expressions used to encode binary fields in JSON
+var binaryFields = map[string]string{
+	"data":     "encode(data, 'base64')",
+	"dev_addr": "encode(dev_addr, 'hex')",
+}
+
+// fieldsExpression returns the expression building JSON object with the
+// fields specific to the event type
+func fieldsExpression(eventType string) (string, error) {
+	fields, ok := devicedata.Fields[eventType]
+	if !ok {
+		return "", fmt.Errorf("unknown event type: %s", eventType)
+	}
+	var args []string
+	for _, f := range fields {
+		expr, ok := binaryFields[f]
+		if !ok {
+			expr = f
+		}
+		args = append(args, fmt.Sprintf("'%s', %s", f, expr))
+	}
+	return "json_build_object(" + strings.Join(args, ", ") + ")", nil
+}
+
+func filterWhere(filter devicedata.Filter) (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	args = append(args, filter.ApplicationID)
+	conds = append(conds, fmt.Sprintf("application_id = $%d", len(args)))
+	if filter.DevEUI != nil {
+		args = append(args, filter.DevEUI[:])
+		conds = append(conds, fmt.Sprintf("dev_eui = $%d", len(args)))
+	}
+	if !filter.Start.IsZero() {
+		args = append(args, filter.Start)
+		conds = append(conds, fmt.Sprintf("received_at >= $%d", len(args)))
+	}
+	if !filter.End.IsZero() {
+		args = append(args, filter.End)
+		conds = append(conds, fmt.Sprintf("received_at < $%d", len(args)))
+	}
+	return " where " + strings.Join(conds, " and "), args
+}
+
+// GetDeviceDataCount returns the number of the events matching the filter.
+func (i *Integration) GetDeviceDataCount(ctx context.Context, filter devicedata.Filter) (int64, error) {
+	if _, ok := devicedata.Fields[filter.Type]; !ok {
+		return 0, fmt.Errorf("unknown event type: %s", filter.Type)
+	}
+	where, args := filterWhere(filter)
+	var count int64
+	if err := i.db.GetContext(ctx, &count, "select count(*) from "+filter.Type+where, args...); err != nil {
+		return 0, errors.Wrap(err, "select error")
+	}
+	return count, nil
+}
+
+// GetDeviceData returns the events matching the filter.
+func (i *Integration) GetDeviceData(ctx context.Context, filter devicedata.Filter) ([]devicedata.Record, error) {
+	fields, err := fieldsExpression(filter.Type)
+	if err != nil {
+		return nil, err
+	}
+	where, args := filterWhere(filter)
+	order := " order by received_at desc, id desc"
+	if filter.Ascending {
+		order = " order by received_at, id"
+	}
+	args = append(args, filter.Limit, filter.Offset)
+	query := fmt.Sprintf(`
+		select
+			id,
+			received_at,
+			dev_eui,
+			device_name,
+			application_id,
+			application_name,
+			tags,
+			%s as fields
+		from %s%s%s
+		limit $%d offset $%d`, fields, filter.Type, where, order, len(args)-1, len(args))
+
+	var rows []struct {
+		ID              uuid.UUID     `db:"id"`
+		ReceivedAt      time.Time     `db:"received_at"`
+		DevEUI          lorawan.EUI64 `db:"dev_eui"`
+		DeviceName      string        `db:"device_name"`
+		ApplicationID   int64         `db:"application_id"`
+		ApplicationName string        `db:"application_name"`
+		Tags            hstore.Hstore `db:"tags"`
+		Fields          []byte        `db:"fields"`
+	}
+	if err := i.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, errors.Wrap(err, "select error")
+	}
+
+	records := make([]devicedata.Record, len(rows))
+	for j, r := range rows {
+		tags := make(map[string]string)
+		for k, v := range r.Tags.Map {
+			if v.Valid {
+				tags[k] = v.String
+			}
+		}
+		records[j] = devicedata.Record{
+			ID:              r.ID,
+			ReceivedAt:      r.ReceivedAt,
+			DevEUI:          r.DevEUI,
+			DeviceName:      r.DeviceName,
+			ApplicationID:   r.ApplicationID,
+			ApplicationName: r.ApplicationName,
+			Tags:            tags,
+			Fields:          json.RawMessage(r.Fields),
+		}
+	}
+	return records, nil
+}
+
+// DeleteDeviceDataBefore deletes the events of the type received before the
+// given time. The events are deleted in batches to avoid long locks.
+func (i *Integration) DeleteDeviceDataBefore(ctx context.Context, eventType string, before time.Time) (int64, error) {
+	if _, ok := devicedata.Fields[eventType]; !ok {
+		return 0, fmt.Errorf("unknown event type: %s", eventType)
+	}
+	query := fmt.Sprintf(`
+		delete from %[1]s where id in (
+			select id from %[1]s where received_at < $1 limit $2
+		)`, eventType)
+	var deleted int64
+	for {
+		res, err := i.db.ExecContext(ctx, query, before, deleteBatchSize)
+		if err != nil {
+			return deleted, errors.Wrap(err, "delete error")
+		}
+		ra, err := res.RowsAffected()
+		if err != nil {
+			return deleted, errors.Wrap(err, "get rows affected error")
+		}
+		deleted += ra
+		if ra < deleteBatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+// DeleteDeviceData deletes the events of the type matching the filter. The
+// events are deleted in batches to avoid long locks.
+func (i *Integration) DeleteDeviceData(ctx context.Context, eventType string, filter devicedata.DeleteFilter) (int64, error) {
+	if _, ok := devicedata.Fields[eventType]; !ok {
+		return 0, fmt.Errorf("unknown event type: %s", eventType)
+	}
+	var conds []string
+	var args []interface{}
+	if len(filter.ApplicationIDs) != 0 {
+		args = append(args, pq.Int64Array(filter.ApplicationIDs))
+		conds = append(conds, fmt.Sprintf("application_id = any($%d)", len(args)))
+	}
+	if len(filter.DevEUIs) != 0 {
+		euis := make(pq.ByteaArray, len(filter.DevEUIs))
+		for j := range filter.DevEUIs {
+			euis[j] = filter.DevEUIs[j][:]
+		}
+		args = append(args, euis)
+		conds = append(conds, fmt.Sprintf("dev_eui = any($%d)", len(args)))
+	}
+	if len(conds) == 0 {
+		return 0, fmt.Errorf("application IDs or DevEUIs must be set")
+	}
+	where := "(" + strings.Join(conds, " or ") + ")"
+	if !filter.Before.IsZero() {
+		args = append(args, filter.Before)
+		where += fmt.Sprintf(" and received_at < $%d", len(args))
+	}
+	args = append(args, deleteBatchSize)
+	query := fmt.Sprintf(`
+		delete from %[1]s where id in (
+			select id from %[1]s where %[2]s limit $%[3]d
+		)`, eventType, where, len(args))
+	var deleted int64
+	for {
+		res, err := i.db.ExecContext(ctx, query, args...)
+		if err != nil {
+			return deleted, errors.Wrap(err, "delete error")
+		}
+		ra, err := res.RowsAffected()
+		if err != nil {
+			return deleted, errors.Wrap(err, "get rows affected error")
+		}
+		deleted += ra
+		if ra < deleteBatchSize {
+			return deleted, nil
+		}
+	}
+}
@@ -16,6 +16,7 @@ type IntegrationStruct struct {
 	Kafka           IntegrationKafkaConfig      `mapstructure:"kafka"`
 	PostgreSQL      IntegrationPostgreSQLConfig `mapstructure:"postgresql"`
 	AMQP            IntegrationAMQPConfig       `mapstructure:"amqp"`
+	InfluxDB        IntegrationInfluxDBConfig   `mapstructure:"influxdb"`
 }
 
 // IntegrationAMQPConfig holds the AMQP integration configuration.
@@ -84,3 +85,11 @@ type IntegrationPostgreSQLConfig struct {
 	MaxOpenConnections int    `mapstructure:"max_open_connections"`
 	MaxIdleConnections int    `mapstructure:"max_idle_connections"`
 }
+
+// IntegrationInfluxDBConfig holds the configuration of the batched writes of
+// the InfluxDB application integrations.
+type IntegrationInfluxDBConfig struct {
+	BatchSize     int           `mapstructure:"batch_size"`
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+	MaxBufferSize int           `mapstructure:"max_buffer_size"`
+}
@@ -0,0 +1,85 @@
+// Package invitation implements the invitations of the users to the
+// organizations by email
+package invitation
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/user"
+	"github.com/mxc-foundation/lpwan-app-server/internal/email"
+)
+
+const (
+	// tokenAudience is the audience of the JWT accepting the invitation, the
+	// token can't be used for anything else
+	tokenAudience = "organization-invitation"
+	// invitationTTL is the time during which the invitation can be accepted
+	invitationTTL = 7 * 24 * time.Hour
+)
+
+// Invitation is the invitation of the user with the given email address to
+// the organization
+type Invitation struct {
+	ID             int64  `db:"id"`
+	OrganizationID int64  `db:"organization_id"`
+	Email          string `db:"email"`
+	IsAdmin        bool   `db:"is_admin"`
+	IsDeviceAdmin  bool   `db:"is_device_admin"`
+	IsGatewayAdmin bool   `db:"is_gateway_admin"`
+	Language       string `db:"language"`
+	// CreatedBy is the id of the user who has created the invitation
+	CreatedBy int64     `db:"created_by"`
+	CreatedAt time.Time `db:"created_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+	// AcceptedAt is set when the invitation is accepted, if the user is not
+	// registered yet the user joins the organization after registration
+	AcceptedAt *time.Time `db:"accepted_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
+
+// IsPending returns true if the invitation can be accepted
+func (inv Invitation) IsPending(now time.Time) bool {
+	return inv.AcceptedAt == nil && inv.RevokedAt == nil && now.Before(inv.ExpiresAt)
+}
+
+// Store provides access to the invitations
+type Store interface {
+	// CreateOrganizationInvitation creates the invitation, the pending
+	// invitation of the same email address to the organization is revoked
+	CreateOrganizationInvitation(ctx context.Context, inv *Invitation) error
+	// GetOrganizationInvitation returns the invitation with the given id
+	GetOrganizationInvitation(ctx context.Context, id int64) (Invitation, error)
+	// GetPendingOrganizationInvitationCount returns the number of the
+	// pending invitations to the organization
+	GetPendingOrganizationInvitationCount(ctx context.Context, organizationID int64) (int64, error)
+	// GetPendingOrganizationInvitations returns the pending invitations to
+	// the organization, the newest first
+	GetPendingOrganizationInvitations(ctx context.Context, organizationID, limit, offset int64) ([]Invitation, error)
+	// RevokeOrganizationInvitation revokes the pending invitation to the
+	// organization
+	RevokeOrganizationInvitation(ctx context.Context, organizationID, id int64) error
+	// AcceptOrganizationInvitation marks the invitation as accepted. If
+	// userID is not 0, the user is added to the organization with the
+	// permissions of the invitation
+	AcceptOrganizationInvitation(ctx context.Context, id, userID int64) error
+
+	// GetOrganization returns the organization with the given id
+	GetOrganization(ctx context.Context, id int64, forUpdate bool) (organization.Organization, error)
+	// GetUserByEmail returns the user with the given email
+	GetUserByEmail(ctx context.Context, email string) (user.User, error)
+}
+
+// Mailer sends the invitations
+type Mailer interface {
+	SendOrganizationInvitation(email, lang string, param email.Param) error
+}
+
+// Registrar starts the registration of the invited user
+type Registrar interface {
+	RegisterUser(ctx context.Context, req *api.RegisterUserRequest) (*empty.Empty, error)
+}
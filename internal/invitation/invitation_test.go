@@ -0,0 +1,244 @@
+package invitation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/lestrrat-go/jwx/jwa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/user"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	"github.com/mxc-foundation/lpwan-app-server/internal/email"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/jwt"
+)
+
+type testStore struct {
+	invitations map[int64]*Invitation
+	users       map[string]user.User
+	// joined are the users added to the organizations by invitation id
+	joined map[int64]int64
+}
+
+func (ts *testStore) CreateOrganizationInvitation(ctx context.Context, inv *Invitation) error {
+	for _, i := range ts.invitations {
+		if i.OrganizationID == inv.OrganizationID && i.Email == inv.Email && i.IsPending(inv.CreatedAt) {
+			i.RevokedAt = &inv.CreatedAt
+		}
+	}
+	inv.ID = int64(len(ts.invitations) + 1)
+	i := *inv
+	ts.invitations[inv.ID] = &i
+	return nil
+}
+
+func (ts *testStore) GetOrganizationInvitation(ctx context.Context, id int64) (Invitation, error) {
+	inv, ok := ts.invitations[id]
+	if !ok {
+		return Invitation{}, errHandler.ErrDoesNotExist
+	}
+	return *inv, nil
+}
+
+func (ts *testStore) GetPendingOrganizationInvitationCount(ctx context.Context, organizationID int64) (int64, error) {
+	invs, _ := ts.GetPendingOrganizationInvitations(ctx, organizationID, 0, 0)
+	return int64(len(invs)), nil
+}
+
+func (ts *testStore) GetPendingOrganizationInvitations(ctx context.Context, organizationID, limit,
+	offset int64) ([]Invitation, error) {
+	var res []Invitation
+	for _, inv := range ts.invitations {
+		if inv.OrganizationID == organizationID && inv.IsPending(time.Now()) {
+			res = append(res, *inv)
+		}
+	}
+	return res, nil
+}
+
+func (ts *testStore) RevokeOrganizationInvitation(ctx context.Context, organizationID, id int64) error {
+	inv, ok := ts.invitations[id]
+	if !ok || inv.OrganizationID != organizationID || !inv.IsPending(time.Now()) {
+		return errHandler.ErrDoesNotExist
+	}
+	now := time.Now()
+	inv.RevokedAt = &now
+	return nil
+}
+
+func (ts *testStore) AcceptOrganizationInvitation(ctx context.Context, id, userID int64) error {
+	inv, ok := ts.invitations[id]
+	if !ok || !inv.IsPending(time.Now()) {
+		return errHandler.ErrDoesNotExist
+	}
+	now := time.Now()
+	inv.AcceptedAt = &now
+	if userID != 0 {
+		ts.joined[id] = userID
+	}
+	return nil
+}
+
+func (ts *testStore) GetOrganization(ctx context.Context, id int64, forUpdate bool) (organization.Organization, error) {
+	return organization.Organization{ID: id, Name: fmt.Sprintf("org-%d", id)}, nil
+}
+
+func (ts *testStore) GetUserByEmail(ctx context.Context, email string) (user.User, error) {
+	u, ok := ts.users[email]
+	if !ok {
+		return user.User{}, errHandler.ErrDoesNotExist
+	}
+	return u, nil
+}
+
+type testMailer struct {
+	email  string
+	params []email.Param
+}
+
+func (tm *testMailer) SendOrganizationInvitation(email, lang string, param email.Param) error {
+	tm.email = email
+	tm.params = append(tm.params, param)
+	return nil
+}
+
+type testRegistrar struct {
+	registered []string
+}
+
+func (tr *testRegistrar) RegisterUser(ctx context.Context, req *api.RegisterUserRequest) (*empty.Empty, error) {
+	tr.registered = append(tr.registered, req.Email)
+	return &empty.Empty{}, nil
+}
+
+type testAuth struct {
+	userID int64
+}
+
+func (ta *testAuth) GetCredentials(ctx context.Context, opts *auth.Options) (*auth.Credentials, error) {
+	if ta.userID == 0 {
+		return nil, fmt.Errorf("not logged in")
+	}
+	return &auth.Credentials{
+		UserID:     ta.userID,
+		Username:   "admin@example.com",
+		OrgID:      opts.OrgID,
+		IsOrgAdmin: opts.OrgID == 3,
+	}, nil
+}
+
+func TestInvitation(t *testing.T) {
+	ctx := context.Background()
+	st := &testStore{
+		invitations: make(map[int64]*Invitation),
+		users:       map[string]user.User{"alice@example.com": {ID: 5, Email: "alice@example.com", IsActive: true}},
+		joined:      make(map[int64]int64),
+	}
+	mailer := &testMailer{}
+	registrar := &testRegistrar{}
+	jwtv := jwt.NewValidator(jwa.HS256, []byte("secret"), 0)
+	ta := &testAuth{userID: 1}
+	srv := NewServer(st, mailer, registrar, jwtv, ta)
+
+	// only the org admin may invite users
+	_, err := srv.Create(ctx, &api.CreateOrganizationInvitationRequest{OrganizationId: 4, Email: "alice@example.com"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected permission denied, got %v", err)
+	}
+	_, err = srv.Create(ctx, &api.CreateOrganizationInvitationRequest{OrganizationId: 3, Email: "not an email"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument, got %v", err)
+	}
+
+	resp, err := srv.Create(ctx, &api.CreateOrganizationInvitationRequest{
+		OrganizationId: 3,
+		Email:          " Alice@Example.com",
+		IsDeviceAdmin:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Invitation.Email != "alice@example.com" || resp.Invitation.Language != "en" || mailer.email != "alice@example.com" ||
+		mailer.params[0].ItemID[email.InvitationOrgName] != "org-3" {
+		t.Errorf("unexpected invitation %v sent to %s with %+v", resp.Invitation, mailer.email, mailer.params[0])
+	}
+	aliceToken := mailer.params[0].Token
+
+	// the invitation token can't be used to log in
+	if _, err := jwtv.GetClaims(aliceToken, ""); err == nil {
+		t.Errorf("expected the invitation token to be rejected for login")
+	}
+
+	// the existing user must be logged in to accept
+	ta.userID = 0
+	_, err = srv.Accept(ctx, &api.AcceptOrganizationInvitationRequest{Token: aliceToken})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected unauthenticated, got %v", err)
+	}
+	ta.userID = 6
+	_, err = srv.Accept(ctx, &api.AcceptOrganizationInvitationRequest{Token: aliceToken})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected permission denied, got %v", err)
+	}
+	ta.userID = 5
+	accResp, err := srv.Accept(ctx, &api.AcceptOrganizationInvitationRequest{Token: aliceToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accResp.OrganizationId != 3 || accResp.RegistrationRequired || st.joined[resp.Invitation.Id] != 5 {
+		t.Errorf("unexpected response %v, joined: %v", accResp, st.joined)
+	}
+	// the invitation can be accepted only once
+	_, err = srv.Accept(ctx, &api.AcceptOrganizationInvitationRequest{Token: aliceToken})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected failed precondition, got %v", err)
+	}
+
+	// the unknown user is registered
+	ta.userID = 1
+	resp, err = srv.Create(ctx, &api.CreateOrganizationInvitationRequest{OrganizationId: 3, Email: "bob@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ta.userID = 0
+	accResp, err = srv.Accept(ctx, &api.AcceptOrganizationInvitationRequest{Token: mailer.params[1].Token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !accResp.RegistrationRequired || len(registrar.registered) != 1 || registrar.registered[0] != "bob@example.com" ||
+		st.invitations[resp.Invitation.Id].AcceptedAt == nil {
+		t.Errorf("unexpected response %v, registered: %v", accResp, registrar.registered)
+	}
+
+	// revoked invitation can't be accepted
+	ta.userID = 1
+	resp, err = srv.Create(ctx, &api.CreateOrganizationInvitationRequest{OrganizationId: 3, Email: "carol@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := srv.List(ctx, &api.ListOrganizationInvitationsRequest{OrganizationId: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.TotalCount != 1 || list.Result[0].Email != "carol@example.com" {
+		t.Errorf("unexpected list: %v", list)
+	}
+	if _, err := srv.Revoke(ctx, &api.RevokeOrganizationInvitationRequest{OrganizationId: 3, Id: resp.Invitation.Id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = srv.Accept(ctx, &api.AcceptOrganizationInvitationRequest{Token: mailer.params[2].Token})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected failed precondition, got %v", err)
+	}
+	_, err = srv.Accept(ctx, &api.AcceptOrganizationInvitationRequest{Token: "invalid"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument, got %v", err)
+	}
+}
@@ -0,0 +1,263 @@
+package invitation
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
+	"github.com/mxc-foundation/lpwan-app-server/internal/audit"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	"github.com/mxc-foundation/lpwan-app-server/internal/email"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/jwt"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+// Server implements the organization invitation service API
+type Server struct {
+	store     Store
+	mailer    Mailer
+	registrar Registrar
+	jwtv      *jwt.Validator
+	auth      auth.Authenticator
+}
+
+// NewServer creates a new organization invitation service server
+func NewServer(store Store, mailer Mailer, registrar Registrar, jwtv *jwt.Validator, auth auth.Authenticator) *Server {
+	return &Server{
+		store:     store,
+		mailer:    mailer,
+		registrar: registrar,
+		jwtv:      jwtv,
+		auth:      auth,
+	}
+}
+
+// invitationState is the invitation recorded in the audit log, the email of
+// the invitee is not recorded as it can't be erased from the log
+type invitationState struct {
+	IsAdmin        bool `json:"is_admin"`
+	IsDeviceAdmin  bool `json:"is_device_admin"`
+	IsGatewayAdmin bool `json:"is_gateway_admin"`
+}
+
+func newInvitationState(inv Invitation) invitationState {
+	return invitationState{
+		IsAdmin:        inv.IsAdmin,
+		IsDeviceAdmin:  inv.IsDeviceAdmin,
+		IsGatewayAdmin: inv.IsGatewayAdmin,
+	}
+}
+
+func invitationToPB(inv Invitation) *api.OrganizationInvitation {
+	return &api.OrganizationInvitation{
+		Id:             inv.ID,
+		OrganizationId: inv.OrganizationID,
+		Email:          inv.Email,
+		IsAdmin:        inv.IsAdmin,
+		IsDeviceAdmin:  inv.IsDeviceAdmin,
+		IsGatewayAdmin: inv.IsGatewayAdmin,
+		Language:       inv.Language,
+		CreatedBy:      inv.CreatedBy,
+		CreatedAt:      timestamppb.New(inv.CreatedAt),
+		ExpiresAt:      timestamppb.New(inv.ExpiresAt),
+	}
+}
+
+func (a *Server) getOrgAdminCredentials(ctx context.Context, organizationID int64) (*auth.Credentials, error) {
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(organizationID))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.Has(auth.PermOrganizationAdmin) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return cred, nil
+}
+
+// Create creates the invitation and sends it to the email address. If the
+// email address has already been invited to the organization, the previous
+// invitation is revoked.
+func (a *Server) Create(ctx context.Context, req *api.CreateOrganizationInvitationRequest) (*api.CreateOrganizationInvitationResponse, error) {
+	cred, err := a.getOrgAdminCredentials(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	emailAddr := strings.ToLower(strings.TrimSpace(req.Email))
+	if addr, err := mail.ParseAddress(emailAddr); err != nil || addr.Address != emailAddr {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email address")
+	}
+	language := req.Language
+	if language == "" {
+		language = "en"
+	}
+	org, err := a.store.GetOrganization(ctx, req.OrganizationId, false)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	now := time.Now()
+	inv := Invitation{
+		OrganizationID: req.OrganizationId,
+		Email:          emailAddr,
+		IsAdmin:        req.IsAdmin,
+		IsDeviceAdmin:  req.IsDeviceAdmin,
+		IsGatewayAdmin: req.IsGatewayAdmin,
+		Language:       language,
+		CreatedBy:      cred.UserID,
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(invitationTTL),
+	}
+	if err := a.store.CreateOrganizationInvitation(ctx, &inv); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+
+	token, err := a.jwtv.SignToken(jwt.Claims{
+		Username:       inv.Email,
+		OrganizationID: inv.OrganizationID,
+		InvitationID:   inv.ID,
+	}, int64(invitationTTL/time.Second), []string{tokenAudience})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "couldn't sign invitation token: %v", err)
+	}
+	orgName := org.DisplayName
+	if orgName == "" {
+		orgName = org.Name
+	}
+	err = a.mailer.SendOrganizationInvitation(inv.Email, inv.Language, email.Param{
+		Token: token,
+		ItemID: map[string]string{
+			email.InvitationOrgName: orgName,
+			email.InvitationInviter: cred.Username,
+		},
+		Date: map[string]string{
+			email.InvitationExpiresAt: inv.ExpiresAt.UTC().Format("2006-01-02 15:04 MST"),
+		},
+	})
+	if err != nil {
+		// the invitation that hasn't been sent can't be accepted anyway
+		if err := a.store.RevokeOrganizationInvitation(ctx, inv.OrganizationID, inv.ID); err != nil {
+			logrus.WithError(err).Errorf("couldn't revoke invitation %d", inv.ID)
+		}
+		return nil, status.Errorf(codes.Internal, "couldn't send invitation: %v", err)
+	}
+	audit.Describe(ctx, inv.OrganizationID, fmt.Sprintf("invitation:%d", inv.ID), nil, newInvitationState(inv))
+
+	return &api.CreateOrganizationInvitationResponse{Invitation: invitationToPB(inv)}, nil
+}
+
+// List returns the pending invitations to the organization
+func (a *Server) List(ctx context.Context, req *api.ListOrganizationInvitationsRequest) (*api.ListOrganizationInvitationsResponse, error) {
+	if _, err := a.getOrgAdminCredentials(ctx, req.OrganizationId); err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	count, err := a.store.GetPendingOrganizationInvitationCount(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	invs, err := a.store.GetPendingOrganizationInvitations(ctx, req.OrganizationId, limit, req.Offset)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	resp := &api.ListOrganizationInvitationsResponse{TotalCount: count}
+	for _, inv := range invs {
+		resp.Result = append(resp.Result, invitationToPB(inv))
+	}
+	return resp, nil
+}
+
+// Revoke revokes the pending invitation
+func (a *Server) Revoke(ctx context.Context, req *api.RevokeOrganizationInvitationRequest) (*api.RevokeOrganizationInvitationResponse, error) {
+	if _, err := a.getOrgAdminCredentials(ctx, req.OrganizationId); err != nil {
+		return nil, err
+	}
+	inv, err := a.store.GetOrganizationInvitation(ctx, req.Id)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	if err := a.store.RevokeOrganizationInvitation(ctx, req.OrganizationId, req.Id); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	audit.Describe(ctx, req.OrganizationId, fmt.Sprintf("invitation:%d", req.Id), newInvitationState(inv), nil)
+	return &api.RevokeOrganizationInvitationResponse{}, nil
+}
+
+// Accept accepts the invitation. The registered user must be logged in and
+// is added to the organization immediately. For the unknown email address
+// the registration is started, and the user is added to the organization
+// when the registration is finished.
+func (a *Server) Accept(ctx context.Context, req *api.AcceptOrganizationInvitationRequest) (*api.AcceptOrganizationInvitationResponse, error) {
+	claims, err := a.jwtv.GetClaims(req.Token, tokenAudience)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation token: %v", err)
+	}
+	inv, err := a.store.GetOrganizationInvitation(ctx, claims.InvitationID)
+	if err == errHandler.ErrDoesNotExist {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation token")
+	}
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	if inv.OrganizationID != claims.OrganizationID || inv.Email != claims.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation token")
+	}
+	if !inv.IsPending(time.Now()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "invitation has been accepted, revoked or has expired")
+	}
+
+	resp := &api.AcceptOrganizationInvitationResponse{OrganizationId: inv.OrganizationID}
+	u, err := a.store.GetUserByEmail(ctx, inv.Email)
+	if err != nil && err != errHandler.ErrDoesNotExist {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	var userID int64
+	if err == nil && u.IsActive {
+		cred, err := a.auth.GetCredentials(ctx, auth.NewOptions())
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "log in as %s to accept the invitation: %v", inv.Email, err)
+		}
+		if cred.UserID != u.ID {
+			return nil, status.Errorf(codes.PermissionDenied, "the invitation has been sent to another user")
+		}
+		userID = u.ID
+	} else {
+		// either the user is unknown or hasn't finished the registration
+		if _, err := a.registrar.RegisterUser(ctx, &api.RegisterUserRequest{
+			Email:    inv.Email,
+			Language: inv.Language,
+		}); err != nil {
+			return nil, err
+		}
+		resp.RegistrationRequired = true
+	}
+
+	if err := a.store.AcceptOrganizationInvitation(ctx, inv.ID, userID); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	audit.Describe(ctx, inv.OrganizationID, fmt.Sprintf("invitation:%d", inv.ID), nil, newInvitationState(inv))
+	return resp, nil
+}
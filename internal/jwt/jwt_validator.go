@@ -24,6 +24,8 @@ type Claims struct {
 	ExternalCred string `json:"externalCred"`
 	// OrganizationID is used when organization id is required for signing JWT and with audience "mosquitto-auth"
 	OrganizationID int64 `json:"organizationId"`
+	// InvitationID is used with audience "organization-invitation" to identify the invitation
+	InvitationID int64 `json:"invitationId"`
 }
 
 // Validator validates JWT tokens.
@@ -64,6 +66,9 @@ func (v Validator) SignToken(claims Claims, ttl int64, audience []string) (strin
 	_ = t.Set("service", claims.Service)
 	_ = t.Set("externalCred", claims.ExternalCred)
 	_ = t.Set("organizationId", claims.OrganizationID)
+	if claims.InvitationID != 0 {
+		_ = t.Set("invitationId", claims.InvitationID)
+	}
 
 	token, err := jwt.Sign(t, v.algorithm, v.secret)
 	if err != nil {
@@ -133,5 +138,14 @@ func (v Validator) GetClaims(tokenEncoded, audience string) (*Claims, error) {
 		claims.OrganizationID = int64(organizationIDFloat)
 	}
 
+	invitationID, ok := token.Get("invitationId")
+	if ok {
+		invitationIDFloat, ok := invitationID.(float64)
+		if !ok {
+			return nil, fmt.Errorf("invitationId is not a number")
+		}
+		claims.InvitationID = int64(invitationIDFloat)
+	}
+
 	return claims, nil
 }
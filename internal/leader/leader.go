@@ -0,0 +1,175 @@
+// Package leader implements the election of the replica that runs a
+// background job when several app-server replicas share the same database.
+package leader
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Backends of the leader election
+const (
+	BackendRedis    = "redis"
+	BackendPostgres = "postgres"
+)
+
+const defaultTTL = 30 * time.Second
+
+// Config contains configuration of the leader election
+type Config struct {
+	// Backend is either redis or postgres. When not set every replica runs
+	// every job, which is only safe if a single replica is running.
+	Backend string `mapstructure:"backend"`
+	// TTL is how long the leadership is kept if the leader dies without
+	// releasing it. Only used by the redis backend, postgres releases the
+	// locks as soon as the connection is closed.
+	TTL time.Duration `mapstructure:"ttl"`
+}
+
+// Locker is the distributed lock used to elect the leader
+type Locker interface {
+	// Lock acquires the lock with the given name or extends it if it's
+	// already held by this replica. It returns false if the lock is held by
+	// another replica.
+	Lock(ctx context.Context, name string, ttl time.Duration) (bool, error)
+	// Unlock releases the lock if it's held by this replica
+	Unlock(ctx context.Context, name string) error
+}
+
+// Elector campaigns for the leadership of the jobs
+type Elector struct {
+	locker Locker
+	ttl    time.Duration
+
+	mu   sync.Mutex
+	jobs map[string]bool
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+var global struct {
+	sync.RWMutex
+	elector *Elector
+}
+
+// Start starts the leader election. If locker is nil this replica is the
+// leader of all the jobs.
+func Start(cfg Config, locker Locker) *Elector {
+	e := &Elector{
+		locker: locker,
+		ttl:    cfg.TTL,
+		jobs:   make(map[string]bool),
+		done:   make(chan struct{}),
+	}
+	if e.ttl <= 0 {
+		e.ttl = defaultTTL
+	}
+	global.Lock()
+	global.elector = e
+	global.Unlock()
+	return e
+}
+
+// Stop stops the election and releases the leadership of the jobs. The
+// elector is not usable after this call
+func (e *Elector) Stop() {
+	if e == nil {
+		return
+	}
+	global.Lock()
+	if global.elector == e {
+		global.elector = nil
+	}
+	global.Unlock()
+
+	close(e.done)
+	e.wg.Wait()
+}
+
+// IsLeader returns true if this replica should run the job with the given
+// name. The first call for the job starts campaigning for its leadership.
+// It returns false until the election has been started.
+func IsLeader(job string) bool {
+	global.RLock()
+	e := global.elector
+	global.RUnlock()
+	if e == nil {
+		return false
+	}
+	return e.IsLeader(job)
+}
+
+// IsLeader returns true if this replica should run the job with the given
+// name.
+func (e *Elector) IsLeader(job string) bool {
+	if e.locker == nil {
+		return true
+	}
+
+	e.mu.Lock()
+	leader, ok := e.jobs[job]
+	if ok {
+		e.mu.Unlock()
+		return leader
+	}
+	e.jobs[job] = false
+	e.mu.Unlock()
+
+	leader = e.campaign(job)
+	e.wg.Add(1)
+	go e.campaignLoop(job)
+
+	return leader
+}
+
+// campaign tries to acquire or to extend the leadership of the job
+func (e *Elector) campaign(job string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), e.ttl/3)
+	defer cancel()
+
+	leader, err := e.locker.Lock(ctx, job, e.ttl)
+	if err != nil {
+		log.WithError(err).WithField("job", job).Error("leader: lock error")
+		leader = false
+	}
+
+	e.mu.Lock()
+	if e.jobs[job] != leader {
+		log.WithFields(log.Fields{
+			"job":    job,
+			"leader": leader,
+		}).Info("leader: leadership changed")
+	}
+	e.jobs[job] = leader
+	e.mu.Unlock()
+
+	return leader
+}
+
+func (e *Elector) campaignLoop(job string) {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			e.campaign(job)
+		case <-e.done:
+			e.mu.Lock()
+			leader := e.jobs[job]
+			e.jobs[job] = false
+			e.mu.Unlock()
+			if leader {
+				if err := e.locker.Unlock(context.Background(), job); err != nil {
+					log.WithError(err).WithField("job", job).Error("leader: unlock error")
+				}
+			}
+			return
+		}
+	}
+}
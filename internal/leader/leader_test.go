@@ -0,0 +1,77 @@
+package leader
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testLocker is shared by several electors the same way as the redis
+// instance or the database is shared by several replicas
+type testLocker struct {
+	mu     sync.Mutex
+	owners map[string]*testSession
+}
+
+type testSession struct {
+	locker *testLocker
+}
+
+func (s *testSession) Lock(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	s.locker.mu.Lock()
+	defer s.locker.mu.Unlock()
+	owner := s.locker.owners[name]
+	if owner == nil {
+		s.locker.owners[name] = s
+		return true, nil
+	}
+	return owner == s, nil
+}
+
+func (s *testSession) Unlock(ctx context.Context, name string) error {
+	s.locker.mu.Lock()
+	defer s.locker.mu.Unlock()
+	if s.locker.owners[name] == s {
+		delete(s.locker.owners, name)
+	}
+	return nil
+}
+
+func TestElector(t *testing.T) {
+	tl := &testLocker{owners: make(map[string]*testSession)}
+	cfg := Config{TTL: 30 * time.Millisecond}
+
+	first := Start(cfg, &testSession{locker: tl})
+	second := Start(cfg, &testSession{locker: tl})
+	defer second.Stop()
+
+	if !first.IsLeader("job") {
+		t.Errorf("first elector should be the leader")
+	}
+	if second.IsLeader("job") {
+		t.Errorf("second elector should not be the leader")
+	}
+	if !second.IsLeader("other") {
+		t.Errorf("second elector should be the leader of the other job")
+	}
+
+	first.Stop()
+
+	deadline := time.Now().Add(time.Second)
+	for !second.IsLeader("job") {
+		if time.Now().After(deadline) {
+			t.Fatalf("second elector didn't take over")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestElectorWithoutLocker(t *testing.T) {
+	e := Start(Config{}, nil)
+	defer e.Stop()
+
+	if !e.IsLeader("job") || !IsLeader("job") {
+		t.Errorf("elector without locker should be the leader")
+	}
+}
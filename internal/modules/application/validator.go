@@ -2,51 +2,58 @@ package application
 
 import (
 	"context"
-	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
 
 	"github.com/pkg/errors"
 
-	auth "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	authcus "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
 )
 
 // Validator defines struct type for vadidating user access to APIs provided by this package
 type Validator struct {
-	Credentials *auth.Credentials
-	st          *store.Handler
+	Credentials *authcus.Credentials
+	st          AuthStore
 }
 
 // Validate defines methods used on struct Validator
 type Validate interface {
-	ValidateGlobalApplicationsAccess(ctx context.Context, flag auth.Flag, organizationID int64) (bool, error)
-	ValidateApplicationAccess(ctx context.Context, flag auth.Flag, applicationID int64) (bool, error)
-	GetUser(ctx context.Context) (auth.User, error)
+	ValidateGlobalApplicationsAccess(ctx context.Context, flag authcus.Flag, organizationID int64) (bool, error)
+	ValidateApplicationAccess(ctx context.Context, flag authcus.Flag, applicationID int64) (bool, error)
+	GetUser(ctx context.Context) (authcus.User, error)
+}
+
+// AuthStore defines db APIs used to validate the access to the applications
+type AuthStore interface {
+	AuthGetApplicationOrgID(ctx context.Context, applicationID int64) (int64, error)
 }
 
 // NewValidator returns new Validate instance for this package
-func NewValidator(st *store.Handler) Validate {
+func NewValidator(st AuthStore) Validate {
 	return &Validator{
-		Credentials: auth.NewCredentials(),
+		Credentials: authcus.NewCredentials(),
 		st:          st,
 	}
 }
 
-func (v *Validator) GetUser(ctx context.Context) (auth.User, error) {
+func (v *Validator) GetUser(ctx context.Context) (authcus.User, error) {
 	return v.Credentials.GetUser(ctx)
 }
 
 // ValidateGlobalApplicationsAccess validates if the client has access to the
 // global applications resource.
-func (v *Validator) ValidateGlobalApplicationsAccess(ctx context.Context, flag auth.Flag, organizationID int64) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
-	if err != nil {
-		return false, errors.Wrap(err, "ValidateGlobalApplicationsAccess")
-	}
-
+func (v *Validator) ValidateGlobalApplicationsAccess(ctx context.Context, flag authcus.Flag, organizationID int64) (bool, error) {
 	switch flag {
-	case auth.Create:
-		return v.st.CheckCreateApplicationAccess(ctx, u.Email, u.ID, organizationID)
-	case auth.List:
-		return v.st.CheckListApplicationAccess(ctx, u.Email, u.ID, organizationID)
+	case authcus.Create:
+		return v.Credentials.HasPermission(ctx, organizationID, auth.PermDeviceWrite)
+	case authcus.List:
+		if organizationID == 0 {
+			// any user, the applications are filtered on the user
+			if _, err := v.Credentials.GetUser(ctx); err != nil {
+				return false, errors.Wrap(err, "ValidateGlobalApplicationsAccess")
+			}
+			return true, nil
+		}
+		return v.Credentials.HasPermission(ctx, organizationID, auth.PermDeviceRead)
 	default:
 		panic("unsupported flag")
 	}
@@ -54,19 +61,17 @@ func (v *Validator) ValidateGlobalApplicationsAccess(ctx context.Context, flag a
 
 // ValidateApplicationAccess validates if the client has access to the given
 // application.
-func (v *Validator) ValidateApplicationAccess(ctx context.Context, flag auth.Flag, applicationID int64) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
+func (v *Validator) ValidateApplicationAccess(ctx context.Context, flag authcus.Flag, applicationID int64) (bool, error) {
+	orgID, err := v.st.AuthGetApplicationOrgID(ctx, applicationID)
 	if err != nil {
 		return false, errors.Wrap(err, "ValidateApplicationAccess")
 	}
 
 	switch flag {
-	case auth.Read:
-		return v.st.CheckReadApplicationAccess(ctx, u.Email, u.ID, applicationID)
-	case auth.Update:
-		return v.st.CheckUpdateApplicationAccess(ctx, u.Email, u.ID, applicationID)
-	case auth.Delete:
-		return v.st.CheckDeleteApplicationAccess(ctx, u.Email, u.ID, applicationID)
+	case authcus.Read:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceRead)
+	case authcus.Update, authcus.Delete:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceWrite)
 	default:
 		panic("unsupported flag")
 	}
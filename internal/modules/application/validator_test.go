@@ -0,0 +1,111 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/jwa"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	authcus "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
+	"github.com/mxc-foundation/lpwan-app-server/internal/jwt"
+)
+
+// testStore knows the users of the organization 3 and its application 1
+type testStore struct{}
+
+func (testStore) AuthGetUser(ctx context.Context, username string) (auth.User, error) {
+	switch username {
+	case "finance@example.com":
+		return auth.User{ID: 5, Email: username}, nil
+	case "devadmin@example.com":
+		return auth.User{ID: 6, Email: username}, nil
+	}
+	return auth.User{}, fmt.Errorf("user not found")
+}
+
+func (testStore) AuthGetOrgUser(ctx context.Context, userID, orgID int64) (auth.OrgUser, error) {
+	if orgID != 3 {
+		return auth.OrgUser{}, nil
+	}
+	switch userID {
+	case 5:
+		return auth.OrgUser{IsOrgUser: true, RoleID: 2,
+			RolePermissions: auth.Permissions(0).With(auth.PermFinanceRead)}, nil
+	case 6:
+		return auth.OrgUser{IsOrgUser: true, IsDeviceAdmin: true}, nil
+	}
+	return auth.OrgUser{}, nil
+}
+
+func (testStore) AuthGetApplicationOrgID(ctx context.Context, applicationID int64) (int64, error) {
+	if applicationID != 1 {
+		return 0, fmt.Errorf("application not found")
+	}
+	return 3, nil
+}
+
+func TestValidateApplicationAccess(t *testing.T) {
+	jwtv := jwt.NewValidator(jwa.HS256, []byte("BlV5At5TU+LWXSEkiXZVvjuhWy6zBHJzA1jBvDbses4="), 86400)
+	authcus.SetupCred(testStore{}, jwtv, nil)
+	token := func(claims jwt.Claims) context.Context {
+		tok, err := jwtv.SignToken(claims, 0, []string{"lora-app-server"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+tok))
+	}
+	finance := token(jwt.Claims{UserID: 5, Username: "finance@example.com", Service: auth.EMAIL})
+	devAdmin := token(jwt.Claims{UserID: 6, Username: "devadmin@example.com", Service: auth.EMAIL})
+
+	v := NewValidator(testStore{})
+	for _, tc := range []struct {
+		name  string
+		ctx   context.Context
+		check func(ctx context.Context) (bool, error)
+		valid bool
+	}{
+		{
+			name: "finance role can't read the application",
+			ctx:  finance,
+			check: func(ctx context.Context) (bool, error) {
+				return v.ValidateApplicationAccess(ctx, authcus.Read, 1)
+			},
+		},
+		{
+			name: "finance role can't list the applications of the organization",
+			ctx:  finance,
+			check: func(ctx context.Context) (bool, error) {
+				return v.ValidateGlobalApplicationsAccess(ctx, authcus.List, 3)
+			},
+		},
+		{
+			name: "device admin reads the application",
+			ctx:  devAdmin,
+			check: func(ctx context.Context) (bool, error) {
+				return v.ValidateApplicationAccess(ctx, authcus.Read, 1)
+			},
+			valid: true,
+		},
+		{
+			name: "device admin deletes the application",
+			ctx:  devAdmin,
+			check: func(ctx context.Context) (bool, error) {
+				return v.ValidateApplicationAccess(ctx, authcus.Delete, 1)
+			},
+			valid: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			valid, err := tc.check(tc.ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if valid != tc.valid {
+				t.Errorf("expected %v, got %v", tc.valid, valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Package coverage aggregates signal quality of the uplinks received from
+// located devices into geohash cells per gateway.
+package coverage
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/brocaar/chirpstack-api/go/v3/gw"
+	"github.com/brocaar/lorawan"
+	"github.com/mmcloughlin/geohash"
+	"github.com/pkg/errors"
+
+	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/coverage/data"
+)
+
+// GeohashPrecision is the number of geohash characters of a coverage cell,
+// 7 characters give cells of about 150m x 150m.
+const GeohashPrecision = 7
+
+// Store defines db API used by coverage module
+type Store interface {
+	UpsertCoverageCell(ctx context.Context, cell CoverageCell) error
+	GetGatewayCoverage(ctx context.Context, mac lorawan.EUI64) ([]CoverageCell, error)
+}
+
+// RecordUplink adds the uplink received by the gateways in rxInfo from the
+// device located at the given coordinates to the coverage cells
+func RecordUplink(ctx context.Context, st Store, latitude, longitude float64, rxInfo []*gw.UplinkRXInfo) error {
+	if latitude == 0 && longitude == 0 {
+		// the location is not known
+		return nil
+	}
+
+	hash := geohash.EncodeWithPrecision(latitude, longitude, GeohashPrecision)
+	now := time.Now()
+	for _, rx := range rxInfo {
+		if rx == nil {
+			continue
+		}
+		cell := CoverageCell{
+			Geohash:    hash,
+			BestRSSI:   int(rx.Rssi),
+			BestSNR:    rx.LoraSnr,
+			LastSeenAt: now,
+		}
+		copy(cell.GatewayMAC[:], rx.GatewayId)
+		if err := st.UpsertCoverageCell(ctx, cell); err != nil {
+			return errors.Wrapf(err, "update coverage of gateway %s", cell.GatewayMAC)
+		}
+	}
+	return nil
+}
+
+type featureCollection struct {
+	Type     string    `json:"type"`
+	Features []feature `json:"features"`
+}
+
+type feature struct {
+	Type       string            `json:"type"`
+	Geometry   polygon           `json:"geometry"`
+	Properties featureProperties `json:"properties"`
+}
+
+type polygon struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+type featureProperties struct {
+	GatewayID   string    `json:"gatewayID"`
+	Geohash     string    `json:"geohash"`
+	BestRSSI    int       `json:"bestRSSI"`
+	BestSNR     float64   `json:"bestSNR"`
+	UplinkCount int64     `json:"uplinkCount"`
+	LastSeenAt  time.Time `json:"lastSeenAt"`
+}
+
+// CellsToGeoJSON returns the coverage cells as GeoJSON FeatureCollection of
+// polygons, one for each cell
+func CellsToGeoJSON(cells []CoverageCell) ([]byte, error) {
+	fc := featureCollection{
+		Type:     "FeatureCollection",
+		Features: []feature{},
+	}
+	for _, cell := range cells {
+		box := geohash.BoundingBox(cell.Geohash)
+		fc.Features = append(fc.Features, feature{
+			Type: "Feature",
+			Geometry: polygon{
+				Type: "Polygon",
+				// GeoJSON positions are longitude first, the ring is closed
+				Coordinates: [][][2]float64{{
+					{box.MinLng, box.MinLat},
+					{box.MaxLng, box.MinLat},
+					{box.MaxLng, box.MaxLat},
+					{box.MinLng, box.MaxLat},
+					{box.MinLng, box.MinLat},
+				}},
+			},
+			Properties: featureProperties{
+				GatewayID:   cell.GatewayMAC.String(),
+				Geohash:     cell.Geohash,
+				BestRSSI:    cell.BestRSSI,
+				BestSNR:     cell.BestSNR,
+				UplinkCount: cell.UplinkCount,
+				LastSeenAt:  cell.LastSeenAt,
+			},
+		})
+	}
+	return json.Marshal(fc)
+}
+
+// GetGatewayCoverageGeoJSON returns coverage cells of the gateway as GeoJSON
+func GetGatewayCoverageGeoJSON(ctx context.Context, st Store, mac lorawan.EUI64) ([]byte, error) {
+	cells, err := st.GetGatewayCoverage(ctx, mac)
+	if err != nil {
+		return nil, err
+	}
+	return CellsToGeoJSON(cells)
+}
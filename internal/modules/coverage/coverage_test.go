@@ -0,0 +1,85 @@
+package coverage
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/brocaar/chirpstack-api/go/v3/gw"
+	"github.com/brocaar/lorawan"
+
+	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/coverage/data"
+)
+
+type testStore struct {
+	cells []CoverageCell
+}
+
+func (ts *testStore) UpsertCoverageCell(ctx context.Context, cell CoverageCell) error {
+	ts.cells = append(ts.cells, cell)
+	return nil
+}
+
+func (ts *testStore) GetGatewayCoverage(ctx context.Context, mac lorawan.EUI64) ([]CoverageCell, error) {
+	return ts.cells, nil
+}
+
+func TestRecordUplink(t *testing.T) {
+	st := &testStore{}
+	ctx := context.Background()
+
+	rxInfo := []*gw.UplinkRXInfo{
+		{GatewayId: []byte{1, 2, 3, 4, 5, 6, 7, 8}, Rssi: -100, LoraSnr: 2.5},
+		{GatewayId: []byte{8, 7, 6, 5, 4, 3, 2, 1}, Rssi: -80, LoraSnr: 7},
+	}
+	if err := RecordUplink(ctx, st, 0, 0, rxInfo); err != nil {
+		t.Fatal(err)
+	}
+	if len(st.cells) != 0 {
+		t.Fatalf("expected unknown location to be ignored, got %d cells", len(st.cells))
+	}
+
+	if err := RecordUplink(ctx, st, 52.520008, 13.404954, rxInfo); err != nil {
+		t.Fatal(err)
+	}
+	if len(st.cells) != 2 {
+		t.Fatalf("expected 2 cells, got %d", len(st.cells))
+	}
+	if st.cells[0].Geohash != "u33dc0c" || st.cells[0].GatewayMAC.String() != "0102030405060708" ||
+		st.cells[0].BestRSSI != -100 || st.cells[0].BestSNR != 2.5 {
+		t.Errorf("unexpected cell: %+v", st.cells[0])
+	}
+
+	b, err := GetGatewayCoverageGeoJSON(ctx, st, lorawan.EUI64{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fc struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Coordinates [][][2]float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties struct {
+				GatewayID string `json:"gatewayID"`
+				BestRSSI  int    `json:"bestRSSI"`
+			} `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(b, &fc); err != nil {
+		t.Fatal(err)
+	}
+	if fc.Type != "FeatureCollection" || len(fc.Features) != 2 {
+		t.Fatalf("unexpected GeoJSON: %s", b)
+	}
+	ring := fc.Features[1].Geometry.Coordinates[0]
+	if len(ring) != 5 || ring[0] != ring[4] {
+		t.Errorf("expected closed ring, got %v", ring)
+	}
+	if lng, lat := ring[0][0], ring[0][1]; lng > 13.404954 || lat > 52.520008 || lng < 13.4 || lat < 52.51 {
+		t.Errorf("unexpected cell corner %v", ring[0])
+	}
+	if fc.Features[1].Properties.GatewayID != "0807060504030201" || fc.Features[1].Properties.BestRSSI != -80 {
+		t.Errorf("unexpected properties: %+v", fc.Features[1].Properties)
+	}
+}
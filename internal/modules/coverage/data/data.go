@@ -0,0 +1,19 @@
+package data
+
+import (
+	"time"
+
+	"github.com/brocaar/lorawan"
+)
+
+// CoverageCell holds the best signal quality of the uplinks received by the
+// gateway from the devices located in the geohash cell.
+type CoverageCell struct {
+	GatewayMAC  lorawan.EUI64 `db:"gateway_mac"`
+	Geohash     string        `db:"geohash"`
+	BestRSSI    int           `db:"best_rssi"`
+	BestSNR     float64       `db:"best_snr"`
+	UplinkCount int64         `db:"uplink_count"`
+	FirstSeenAt time.Time     `db:"first_seen_at"`
+	LastSeenAt  time.Time     `db:"last_seen_at"`
+}
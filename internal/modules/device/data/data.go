@@ -135,3 +135,40 @@ type DevicesActiveInactive struct {
 }
 
 type DevicesDataRates map[uint32]uint32
+
+// BulkDeviceFilters select the devices of the organization for the bulk
+// update. The devices matching all the set filters are selected.
+type BulkDeviceFilters struct {
+	OrganizationID  int64
+	ApplicationID   int64
+	DeviceProfileID uuid.UUID
+	Tags            hstore.Hstore
+	DevEUIs         []lorawan.EUI64
+	// Limit is the max number of the selected devices, all the matching
+	// devices are selected if 0
+	Limit int
+}
+
+// IsEmpty returns true if no filter but the organization is set
+func (f BulkDeviceFilters) IsEmpty() bool {
+	return f.ApplicationID == 0 && f.DeviceProfileID == uuid.Nil &&
+		len(f.Tags.Map) == 0 && len(f.DevEUIs) == 0
+}
+
+// BulkDeviceUpdate are the changes applied to the selected devices. The
+// unset tags and variables are removed before the set ones are added.
+type BulkDeviceUpdate struct {
+	SetTags        hstore.Hstore
+	UnsetTags      []string
+	SetVariables   hstore.Hstore
+	UnsetVariables []string
+	// DeviceProfileID is the new device profile of the devices, uuid.Nil to
+	// keep the current one
+	DeviceProfileID uuid.UUID
+}
+
+// BulkDevice is the device selected for the bulk update
+type BulkDevice struct {
+	DevEUI          lorawan.EUI64 `db:"dev_eui"`
+	DeviceProfileID uuid.UUID     `db:"device_profile_id"`
+}
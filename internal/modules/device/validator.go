@@ -3,57 +3,62 @@ package device
 import (
 	"context"
 
-	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
-
 	"github.com/brocaar/lorawan"
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 
-	auth "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
-	cred "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	authcus "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
 )
 
 // Validator defines struct type for vadidating user access to APIs provided by this package
 type Validator struct {
-	h           *store.Handler
-	Credentials *cred.Credentials
+	h           AuthStore
+	Credentials *authcus.Credentials
 }
 
 // Validate defines methods used on struct Validator
 type Validate interface {
-	ValidateGlobalNodesAccess(ctx context.Context, flag auth.Flag, applicationID int64) (bool, error)
-	ValidateNodeAccess(ctx context.Context, flag auth.Flag, devEUI lorawan.EUI64) (bool, error)
-	ValidateDeviceQueueAccess(ctx context.Context, devEUI lorawan.EUI64, flag auth.Flag) (bool, error)
-	GetUser(ctx context.Context) (auth.User, error)
+	ValidateGlobalNodesAccess(ctx context.Context, flag authcus.Flag, applicationID int64) (bool, error)
+	ValidateNodeAccess(ctx context.Context, flag authcus.Flag, devEUI lorawan.EUI64) (bool, error)
+	ValidateDeviceQueueAccess(ctx context.Context, devEUI lorawan.EUI64, flag authcus.Flag) (bool, error)
+	GetUser(ctx context.Context) (authcus.User, error)
+
+	ValidateMulticastGroupAccess(ctx context.Context, flag authcus.Flag, multicastGroupID uuid.UUID) (bool, error)
+}
 
-	ValidateMulticastGroupAccess(ctx context.Context, flag auth.Flag, multicastGroupID uuid.UUID) (bool, error)
+// AuthStore defines db APIs used to validate the access to the devices
+type AuthStore interface {
+	AuthGetApplicationOrgID(ctx context.Context, applicationID int64) (int64, error)
+	AuthGetDeviceOrgID(ctx context.Context, devEUI lorawan.EUI64) (int64, error)
+	AuthGetMulticastGroupOrgID(ctx context.Context, id uuid.UUID) (int64, error)
 }
 
 // NewValidator returns new Validate instance for this package
-func NewValidator(h *store.Handler) Validate {
+func NewValidator(h AuthStore) Validate {
 	return &Validator{
 		h:           h,
-		Credentials: cred.NewCredentials(),
+		Credentials: authcus.NewCredentials(),
 	}
 }
 
-func (v *Validator) GetUser(ctx context.Context) (auth.User, error) {
+func (v *Validator) GetUser(ctx context.Context) (authcus.User, error) {
 	return v.Credentials.GetUser(ctx)
 }
 
 // ValidateMulticastGroupAccess validates if the client has access to the given
 // multicast-group.
-func (v *Validator) ValidateMulticastGroupAccess(ctx context.Context, flag auth.Flag, multicastGroupID uuid.UUID) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
+func (v *Validator) ValidateMulticastGroupAccess(ctx context.Context, flag authcus.Flag, multicastGroupID uuid.UUID) (bool, error) {
+	orgID, err := v.h.AuthGetMulticastGroupOrgID(ctx, multicastGroupID)
 	if err != nil {
 		return false, errors.Wrap(err, "ValidateMulticastGroupAccess")
 	}
 
 	switch flag {
-	case auth.Read:
-		return v.h.CheckReadDeviceProfileAccess(ctx, u.Email, multicastGroupID, u.ID)
-	case auth.Update, auth.Delete:
-		return v.h.CheckUpdateDeleteDeviceProfileAccess(ctx, u.Email, multicastGroupID, u.ID)
+	case authcus.Read:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceRead)
+	case authcus.Update, authcus.Delete:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermOrganizationAdmin)
 	default:
 		panic("ValidateMulticastGroupAccess: not supported flag")
 	}
@@ -62,17 +67,17 @@ func (v *Validator) ValidateMulticastGroupAccess(ctx context.Context, flag auth.
 
 // ValidateNodesAccess validates if the client has access to the global nodes
 // resource.
-func (v *Validator) ValidateGlobalNodesAccess(ctx context.Context, flag auth.Flag, applicationID int64) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
+func (v *Validator) ValidateGlobalNodesAccess(ctx context.Context, flag authcus.Flag, applicationID int64) (bool, error) {
+	orgID, err := v.h.AuthGetApplicationOrgID(ctx, applicationID)
 	if err != nil {
 		return false, errors.Wrap(err, "ValidateGlobalNodesAccess")
 	}
 
 	switch flag {
-	case auth.Create:
-		return v.h.CheckCreateNodeAccess(ctx, u.Email, applicationID, u.ID)
-	case auth.List:
-		return v.h.CheckListNodeAccess(ctx, u.Email, applicationID, u.ID)
+	case authcus.Create:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceWrite)
+	case authcus.List:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceRead)
 	default:
 		panic("ValidateGlobalNodesAccess: unsupported flag")
 	}
@@ -80,19 +85,17 @@ func (v *Validator) ValidateGlobalNodesAccess(ctx context.Context, flag auth.Fla
 }
 
 // ValidateNodeAccess validates if the client has access to the given node.
-func (v *Validator) ValidateNodeAccess(ctx context.Context, flag auth.Flag, devEUI lorawan.EUI64) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
+func (v *Validator) ValidateNodeAccess(ctx context.Context, flag authcus.Flag, devEUI lorawan.EUI64) (bool, error) {
+	orgID, err := v.h.AuthGetDeviceOrgID(ctx, devEUI)
 	if err != nil {
 		return false, errors.Wrap(err, "ValidateNodeAccess")
 	}
 
 	switch flag {
-	case auth.Read:
-		return v.h.CheckReadNodeAccess(ctx, u.Email, devEUI, u.ID)
-	case auth.Update:
-		return v.h.CheckUpdateNodeAccess(ctx, u.Email, devEUI, u.ID)
-	case auth.Delete:
-		return v.h.CheckDeleteNodeAccess(ctx, u.Email, devEUI, u.ID)
+	case authcus.Read:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceRead)
+	case authcus.Update, authcus.Delete:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceWrite)
 	default:
 		panic("ValidateNodeAccess: unsupported flag")
 	}
@@ -101,15 +104,15 @@ func (v *Validator) ValidateNodeAccess(ctx context.Context, flag auth.Flag, devE
 
 // ValidateDeviceQueueAccess validates if the client has access to the queue
 // of the given node.
-func (v *Validator) ValidateDeviceQueueAccess(ctx context.Context, devEUI lorawan.EUI64, flag auth.Flag) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
+func (v *Validator) ValidateDeviceQueueAccess(ctx context.Context, devEUI lorawan.EUI64, flag authcus.Flag) (bool, error) {
+	orgID, err := v.h.AuthGetDeviceOrgID(ctx, devEUI)
 	if err != nil {
-		return false, errors.Wrap(err, "ValidateNodeAccess")
+		return false, errors.Wrap(err, "ValidateDeviceQueueAccess")
 	}
 
 	switch flag {
-	case auth.Create, auth.List, auth.Delete:
-		return v.h.CheckCreateListDeleteDeviceQueueAccess(ctx, u.Email, devEUI, u.ID)
+	case authcus.Create, authcus.List, authcus.Delete:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceQueue)
 	default:
 		panic("ValidateNodeAccess: unsupported flag")
 	}
@@ -7,59 +7,77 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 
-	auth "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	authcus "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
 )
 
 // Validator defines struct type for vadidating user access to APIs provided by this package
 type Validator struct {
-	Credentials *auth.Credentials
+	Credentials *authcus.Credentials
+	st          AuthStore
 }
 
 // Validate defines methods used on struct Validator
 type Validate interface {
-	ValidateFUOTADeploymentAccess(ctx context.Context, flag auth.Flag, id uuid.UUID) (bool, error)
-	ValidateFUOTADeploymentsAccess(ctx context.Context, flag auth.Flag, applicationID int64, devEUI lorawan.EUI64) (bool, error)
-	GetUser(ctx context.Context) (auth.User, error)
+	ValidateFUOTADeploymentAccess(ctx context.Context, flag authcus.Flag, id uuid.UUID) (bool, error)
+	ValidateFUOTADeploymentsAccess(ctx context.Context, flag authcus.Flag, applicationID int64, devEUI lorawan.EUI64) (bool, error)
+	GetUser(ctx context.Context) (authcus.User, error)
+}
+
+// AuthStore defines db APIs used to validate the access to the fuota
+// deployments
+type AuthStore interface {
+	AuthGetApplicationOrgID(ctx context.Context, applicationID int64) (int64, error)
+	AuthGetDeviceOrgID(ctx context.Context, devEUI lorawan.EUI64) (int64, error)
+	AuthGetFUOTADeploymentOrgID(ctx context.Context, id uuid.UUID) (int64, error)
 }
 
 // NewValidator returns new Validate instance for this package
 func NewValidator() Validate {
 	return &Validator{
-		Credentials: auth.NewCredentials(),
+		Credentials: authcus.NewCredentials(),
+		st:          ctrl.st,
 	}
 }
 
-func (v *Validator) GetUser(ctx context.Context) (auth.User, error) {
+func (v *Validator) GetUser(ctx context.Context) (authcus.User, error) {
 	return v.Credentials.GetUser(ctx)
 }
 
 // ValidateFUOTADeploymentAccess validates if the client has access to the
 // given fuota deployment.
-func (v *Validator) ValidateFUOTADeploymentAccess(ctx context.Context, flag auth.Flag, id uuid.UUID) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
+func (v *Validator) ValidateFUOTADeploymentAccess(ctx context.Context, flag authcus.Flag, id uuid.UUID) (bool, error) {
+	orgID, err := v.st.AuthGetFUOTADeploymentOrgID(ctx, id)
 	if err != nil {
 		return false, errors.Wrap(err, "ValidateFUOTADeploymentAccess")
 	}
 
 	switch flag {
-	case auth.Read:
-		return ctrl.st.CheckReadFUOTADeploymentAccess(ctx, u.Email, id, u.ID)
+	case authcus.Read:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceRead)
 	default:
 		panic("ValidateFUOTADeploymentAccess: unsupported flag")
 	}
 }
 
 // ValidateFUOTADeploymentsAccess validates if the client has access to the
-// fuota deployments.
-func (v *Validator) ValidateFUOTADeploymentsAccess(ctx context.Context, flag auth.Flag, applicationID int64, devEUI lorawan.EUI64) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
+// fuota deployments of the application or, if the application is not set,
+// of the device.
+func (v *Validator) ValidateFUOTADeploymentsAccess(ctx context.Context, flag authcus.Flag, applicationID int64, devEUI lorawan.EUI64) (bool, error) {
+	var orgID int64
+	var err error
+	if applicationID > 0 {
+		orgID, err = v.st.AuthGetApplicationOrgID(ctx, applicationID)
+	} else {
+		orgID, err = v.st.AuthGetDeviceOrgID(ctx, devEUI)
+	}
 	if err != nil {
 		return false, errors.Wrap(err, "ValidateFUOTADeploymentsAccess")
 	}
 
 	switch flag {
-	case auth.Create:
-		return ctrl.st.CheckCreateFUOTADeploymentsAccess(ctx, u.Email, applicationID, devEUI, u.ID)
+	case authcus.Create:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermOrganizationAdmin)
 	default:
 		panic("ValidateFUOTADeploymentsAccess: unsupported flag")
 	}
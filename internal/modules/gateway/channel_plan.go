@@ -0,0 +1,526 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+)
+
+// Channel is the channel of the concentrator
+type Channel struct {
+	// Radio is the index of the radio receiving the channel
+	Radio int
+	// Frequency is the center frequency of the channel in Hz
+	Frequency uint32
+	// Bandwidth in Hz, SpreadFactor and DataRate are only used for the
+	// single-SF LoRa and FSK channels
+	Bandwidth    uint32
+	SpreadFactor int
+	DataRate     uint32
+}
+
+// ChannelPlan is the set of the channels the gateway listens on in a region
+type ChannelPlan struct {
+	// Name identifies the plan, e.g. AU915_2
+	Name string
+	// Region is the region of the network server the plan is used with
+	Region      string
+	Description string
+	// Radios are the center frequencies of the two radios in Hz
+	Radios [2]uint32
+	// MultiSF are the 125 kHz multi-SF channels, up to 8
+	MultiSF []Channel
+	// LoRaStd is the single-SF LoRa channel and FSK is the FSK channel, not
+	// used if the bandwidth is 0
+	LoRaStd Channel
+	FSK     Channel
+	// TxFreqMin and TxFreqMax are the bounds of the downlink frequencies
+	TxFreqMin  uint32
+	TxFreqMax  uint32
+	BeaconFreq uint32
+}
+
+// subBandPlan returns the plan of the US915 like region listening on the given
+// sub-band, which contains 8 125 kHz channels starting at firstFreq +
+// 1.6 MHz * (subBand - 1) and one 500 kHz channel in the middle
+func subBandPlan(region string, subBand int, firstFreq uint32) ChannelPlan {
+	f0 := firstFreq + uint32(subBand-1)*1600000
+	p := ChannelPlan{
+		Name:   fmt.Sprintf("%s_%d", region, subBand),
+		Region: region,
+		Description: fmt.Sprintf("%s sub-band %d, channels %d-%d and %d", region, subBand,
+			(subBand-1)*8, (subBand-1)*8+7, 64+subBand-1),
+		Radios:     [2]uint32{f0 + 400000, f0 + 1100000},
+		LoRaStd:    Channel{Radio: 0, Frequency: f0 + 700000, Bandwidth: 500000, SpreadFactor: 8},
+		TxFreqMin:  923000000,
+		TxFreqMax:  928000000,
+		BeaconFreq: 923300000,
+	}
+	for i := 0; i < 8; i++ {
+		p.MultiSF = append(p.MultiSF, Channel{Radio: i / 4, Frequency: f0 + uint32(i)*200000})
+	}
+	return p
+}
+
+// as923Plan returns the plan of the AS923 group, the frequencies of the
+// groups 2-4 are shifted by the given offset from the group 1
+func as923Plan(group int, offset int32) ChannelPlan {
+	shift := func(f uint32) uint32 {
+		return uint32(int32(f) + offset)
+	}
+	p := ChannelPlan{
+		Name:        fmt.Sprintf("AS923_%d", group),
+		Region:      "AS923",
+		Description: fmt.Sprintf("AS923-%d, channels %.1f-%.1f MHz", group, float64(shift(922000000))/1e6, float64(shift(923400000))/1e6),
+		Radios:      [2]uint32{shift(923000000), shift(922000000)},
+		LoRaStd:     Channel{Radio: 1, Frequency: shift(922100000), Bandwidth: 250000, SpreadFactor: 7},
+		FSK:         Channel{Radio: 1, Frequency: shift(921800000), Bandwidth: 125000, DataRate: 50000},
+		TxFreqMin:   shift(920000000),
+		TxFreqMax:   shift(923400000),
+		BeaconFreq:  shift(923400000),
+	}
+	for _, f := range []uint32{922600000, 922800000, 923000000, 923200000, 923400000} {
+		p.MultiSF = append(p.MultiSF, Channel{Radio: 0, Frequency: shift(f)})
+	}
+	for _, f := range []uint32{922000000, 922200000, 922400000} {
+		p.MultiSF = append(p.MultiSF, Channel{Radio: 1, Frequency: shift(f)})
+	}
+	return p
+}
+
+var channelPlans = func() map[string]ChannelPlan {
+	plans := []ChannelPlan{
+		as923Plan(1, 0),
+		as923Plan(2, -1800000),
+		as923Plan(3, -6600000),
+		as923Plan(4, -5900000),
+		{
+			Name:        "IN865",
+			Region:      "IN865",
+			Description: "IN865, the default channels and 5 channels 866.185-866.985 MHz",
+			Radios:      [2]uint32{865500000, 866585000},
+			MultiSF: []Channel{
+				{Radio: 0, Frequency: 865062500},
+				{Radio: 0, Frequency: 865402500},
+				{Radio: 0, Frequency: 865985000},
+				{Radio: 1, Frequency: 866185000},
+				{Radio: 1, Frequency: 866385000},
+				{Radio: 1, Frequency: 866585000},
+				{Radio: 1, Frequency: 866785000},
+				{Radio: 1, Frequency: 866985000},
+			},
+			TxFreqMin:  865000000,
+			TxFreqMax:  867000000,
+			BeaconFreq: 866550000,
+		},
+		{
+			Name:        "RU864",
+			Region:      "RU864",
+			Description: "RU864, the default channels and 5 channels 864.1-864.9 MHz",
+			Radios:      [2]uint32{864500000, 869000000},
+			MultiSF: []Channel{
+				{Radio: 0, Frequency: 864100000},
+				{Radio: 0, Frequency: 864300000},
+				{Radio: 0, Frequency: 864500000},
+				{Radio: 0, Frequency: 864700000},
+				{Radio: 0, Frequency: 864900000},
+				{Radio: 1, Frequency: 868900000},
+				{Radio: 1, Frequency: 869100000},
+			},
+			TxFreqMin:  864000000,
+			TxFreqMax:  870000000,
+			BeaconFreq: 869100000,
+		},
+	}
+	for sb := 1; sb <= 8; sb++ {
+		plans = append(plans, subBandPlan("AU915", sb, 915200000), subBandPlan("US915", sb, 902300000))
+	}
+	res := make(map[string]ChannelPlan)
+	for _, p := range plans {
+		res[p.Name] = p
+	}
+	return res
+}()
+
+// ChannelPlans returns the available channel plans sorted by name
+func ChannelPlans() []ChannelPlan {
+	var res []ChannelPlan
+	for _, p := range channelPlans {
+		res = append(res, p)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+// GetChannelPlan returns the channel plan with the given name
+func GetChannelPlan(name string) (ChannelPlan, error) {
+	p, ok := channelPlans[name]
+	if !ok {
+		return ChannelPlan{}, fmt.Errorf("unknown channel plan: %s", name)
+	}
+	return p, nil
+}
+
+// sx1250TxGainLUT is the TX gain table of the SX1250 radios
+var sx1250TxGainLUT = []map[string]interface{}{
+	{"rf_power": 0, "pa_gain": 0, "pwr_idx": 4},
+	{"rf_power": 2, "pa_gain": 0, "pwr_idx": 6},
+	{"rf_power": 4, "pa_gain": 0, "pwr_idx": 8},
+	{"rf_power": 6, "pa_gain": 0, "pwr_idx": 10},
+	{"rf_power": 8, "pa_gain": 0, "pwr_idx": 12},
+	{"rf_power": 10, "pa_gain": 0, "pwr_idx": 14},
+	{"rf_power": 12, "pa_gain": 1, "pwr_idx": 0},
+	{"rf_power": 14, "pa_gain": 1, "pwr_idx": 2},
+	{"rf_power": 16, "pa_gain": 1, "pwr_idx": 3},
+	{"rf_power": 18, "pa_gain": 1, "pwr_idx": 4},
+	{"rf_power": 20, "pa_gain": 1, "pwr_idx": 6},
+	{"rf_power": 22, "pa_gain": 1, "pwr_idx": 8},
+	{"rf_power": 23, "pa_gain": 1, "pwr_idx": 9},
+	{"rf_power": 24, "pa_gain": 1, "pwr_idx": 10},
+	{"rf_power": 25, "pa_gain": 1, "pwr_idx": 12},
+	{"rf_power": 26, "pa_gain": 1, "pwr_idx": 15},
+}
+
+// GenerateConfig returns the SX1302 packet forwarder config for the channel
+// plan. Like the default gateway configs stored in the DB, the config
+// contains {{ .GatewayID }} and {{ .ServerAddr }} placeholders.
+func (p ChannelPlan) GenerateConfig() (string, error) {
+	conf := map[string]interface{}{
+		"spidev_path":    "/dev/spidev0.0",
+		"lorawan_public": true,
+		"clksrc":         0,
+		"antenna_gain":   2,
+		"full_duplex":    false,
+		"precision_timestamp": map[string]interface{}{
+			"enable":         false,
+			"max_ts_metrics": 255,
+			"nb_symbols":     1,
+		},
+	}
+	for i, freq := range p.Radios {
+		radio := map[string]interface{}{
+			"enable":      true,
+			"type":        "SX1250",
+			"freq":        freq,
+			"rssi_offset": -215.4,
+			"rssi_tcomp": map[string]interface{}{
+				"coeff_a": 0, "coeff_b": 0, "coeff_c": 20.41, "coeff_d": 2162.56, "coeff_e": 0,
+			},
+			"tx_enable": i == 0,
+		}
+		if i == 0 {
+			radio["tx_freq_min"] = p.TxFreqMin
+			radio["tx_freq_max"] = p.TxFreqMax
+			radio["tx_gain_lut"] = sx1250TxGainLUT
+		}
+		conf[fmt.Sprintf("radio_%d", i)] = radio
+	}
+	ifFreq := func(ch Channel) int64 {
+		return int64(ch.Frequency) - int64(p.Radios[ch.Radio])
+	}
+	for i := 0; i < 8; i++ {
+		ch := map[string]interface{}{"enable": false, "radio": 0, "if": 0}
+		if i < len(p.MultiSF) {
+			ch = map[string]interface{}{"enable": true, "radio": p.MultiSF[i].Radio, "if": ifFreq(p.MultiSF[i])}
+		}
+		conf[fmt.Sprintf("chan_multiSF_%d", i)] = ch
+	}
+	std := map[string]interface{}{"enable": false, "radio": 0, "if": 0, "bandwidth": 250000, "spread_factor": 7}
+	if p.LoRaStd.Bandwidth != 0 {
+		std = map[string]interface{}{
+			"enable":        true,
+			"radio":         p.LoRaStd.Radio,
+			"if":            ifFreq(p.LoRaStd),
+			"bandwidth":     p.LoRaStd.Bandwidth,
+			"spread_factor": p.LoRaStd.SpreadFactor,
+		}
+	}
+	std["implicit_hdr"] = false
+	std["implicit_payload_length"] = 17
+	std["implicit_crc_en"] = false
+	std["implicit_coderate"] = 1
+	conf["chan_Lora_std"] = std
+	fsk := map[string]interface{}{"enable": false, "radio": 0, "if": 0, "bandwidth": 125000, "datarate": 50000}
+	if p.FSK.Bandwidth != 0 {
+		fsk = map[string]interface{}{
+			"enable":    true,
+			"radio":     p.FSK.Radio,
+			"if":        ifFreq(p.FSK),
+			"bandwidth": p.FSK.Bandwidth,
+			"datarate":  p.FSK.DataRate,
+		}
+	}
+	conf["chan_FSK"] = fsk
+
+	config := map[string]interface{}{
+		"SX130x_conf": conf,
+		"gateway_conf": map[string]interface{}{
+			"gateway_ID":           "{{ .GatewayID }}",
+			"server_address":       "{{ .ServerAddr }}",
+			"serv_port_up":         1700,
+			"serv_port_down":       1700,
+			"keepalive_interval":   10,
+			"stat_interval":        30,
+			"push_timeout_ms":      100,
+			"forward_crc_valid":    true,
+			"forward_crc_error":    false,
+			"forward_crc_disabled": false,
+			"gps_tty_path":         "/tmp/vgps1",
+			"ref_latitude":         0.0,
+			"ref_longitude":        0.0,
+			"ref_altitude":         0,
+			"beacon_period":        0,
+			"beacon_freq_hz":       p.BeaconFreq,
+			"beacon_datarate":      9,
+			"beacon_bw_hz":         125000,
+			"beacon_power":         14,
+			"beacon_infodesc":      0,
+			"autoquit_threshold":   10,
+		},
+	}
+	return marshalConfig(config)
+}
+
+func marshalConfig(config map[string]interface{}) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	// keep the template placeholders readable
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(config); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// concentratorKeys are the keys of the concentrator settings in the configs
+// of the SX1302 and SX1301 packet forwarders
+var concentratorKeys = []string{"SX130x_conf", "SX1301_conf"}
+
+func decodeConfig(config string) (map[string]interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(config))
+	dec.UseNumber()
+	var res map[string]interface{}
+	if err := dec.Decode(&res); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %v", err)
+	}
+	return res, nil
+}
+
+// ApplyConfigOverrides returns the config with the settings replaced by the
+// overrides. The config is returned unchanged if nothing is overridden.
+func ApplyConfigOverrides(config string, ov gw.GatewayConfigOverrides) (string, error) {
+	if ov.IsEmpty() {
+		return config, nil
+	}
+	res, err := decodeConfig(config)
+	if err != nil {
+		return "", err
+	}
+	var conf map[string]interface{}
+	for _, key := range concentratorKeys {
+		if c, ok := res[key].(map[string]interface{}); ok {
+			conf = c
+		}
+	}
+	gwConf, ok := res["gateway_conf"].(map[string]interface{})
+	if conf == nil || !ok {
+		return "", fmt.Errorf("concentrator or gateway settings are missing")
+	}
+	if ov.AntennaGain != nil {
+		conf["antenna_gain"] = *ov.AntennaGain
+	}
+	if ov.ClkSrc != nil {
+		conf["clksrc"] = *ov.ClkSrc
+	}
+	if ov.LoRaWANPublic != nil {
+		conf["lorawan_public"] = *ov.LoRaWANPublic
+	}
+	if ov.RSSIOffset != nil {
+		for _, key := range []string{"radio_0", "radio_1"} {
+			if radio, ok := conf[key].(map[string]interface{}); ok {
+				radio["rssi_offset"] = *ov.RSSIOffset
+			}
+		}
+	}
+	if ov.GPSTTYPath != nil {
+		gwConf["gps_tty_path"] = *ov.GPSTTYPath
+	}
+	if ov.RefLatitude != nil {
+		gwConf["ref_latitude"] = *ov.RefLatitude
+	}
+	if ov.RefLongitude != nil {
+		gwConf["ref_longitude"] = *ov.RefLongitude
+	}
+	if ov.RefAltitude != nil {
+		gwConf["ref_altitude"] = *ov.RefAltitude
+	}
+	return marshalConfig(res)
+}
+
+type pfRadio struct {
+	Enable    bool  `json:"enable"`
+	Freq      int64 `json:"freq"`
+	TxEnable  bool  `json:"tx_enable"`
+	TxFreqMin int64 `json:"tx_freq_min"`
+	TxFreqMax int64 `json:"tx_freq_max"`
+}
+
+type pfChannel struct {
+	Enable       bool  `json:"enable"`
+	Radio        int   `json:"radio"`
+	IF           int64 `json:"if"`
+	Bandwidth    int64 `json:"bandwidth"`
+	SpreadFactor int   `json:"spread_factor"`
+	DataRate     int64 `json:"datarate"`
+}
+
+type pfGatewayConf struct {
+	GatewayID     string `json:"gateway_ID"`
+	ServerAddress string `json:"server_address"`
+	ServPortUp    int    `json:"serv_port_up"`
+	ServPortDown  int    `json:"serv_port_down"`
+}
+
+// radioBandwidth returns the bandwidth of the radio receiving the channel with
+// the given bandwidth, the channel must fit into it
+func radioBandwidth(concentratorKey string, chanBandwidth int64) int64 {
+	if concentratorKey == "SX130x_conf" {
+		return 1600000
+	}
+	switch chanBandwidth {
+	case 500000:
+		return 1100000
+	case 250000:
+		return 1000000
+	default:
+		return 925000
+	}
+}
+
+// ValidateConfig checks that the packet forwarder config is well formed and
+// that the enabled channels can be received by the enabled radios
+func ValidateConfig(config string) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(config), &raw); err != nil {
+		return fmt.Errorf("invalid JSON: %v", err)
+	}
+	var confKey string
+	for _, key := range concentratorKeys {
+		if _, ok := raw[key]; ok {
+			if confKey != "" {
+				return fmt.Errorf("both %s and %s are set", confKey, key)
+			}
+			confKey = key
+		}
+	}
+	if confKey == "" {
+		return fmt.Errorf("concentrator settings are missing")
+	}
+	var conf map[string]json.RawMessage
+	if err := json.Unmarshal(raw[confKey], &conf); err != nil {
+		return fmt.Errorf("invalid %s: %v", confKey, err)
+	}
+
+	var radios [2]pfRadio
+	txEnabled := false
+	for i := range radios {
+		key := fmt.Sprintf("radio_%d", i)
+		if _, ok := conf[key]; !ok {
+			continue
+		}
+		if err := json.Unmarshal(conf[key], &radios[i]); err != nil {
+			return fmt.Errorf("invalid %s: %v", key, err)
+		}
+		r := radios[i]
+		if !r.Enable {
+			continue
+		}
+		if r.Freq <= 0 {
+			return fmt.Errorf("%s: frequency must be set", key)
+		}
+		if r.TxEnable {
+			txEnabled = true
+			if r.TxFreqMin != 0 && r.TxFreqMax != 0 && r.TxFreqMin >= r.TxFreqMax {
+				return fmt.Errorf("%s: tx_freq_min must be less than tx_freq_max", key)
+			}
+		}
+	}
+	if !txEnabled {
+		return fmt.Errorf("no radio has TX enabled")
+	}
+
+	enabled := 0
+	freqs := make(map[int64]string)
+	checkChannel := func(key string, defaultBandwidth int64) error {
+		data, ok := conf[key]
+		if !ok {
+			return nil
+		}
+		var ch pfChannel
+		if err := json.Unmarshal(data, &ch); err != nil {
+			return fmt.Errorf("invalid %s: %v", key, err)
+		}
+		if !ch.Enable {
+			return nil
+		}
+		if ch.Radio < 0 || ch.Radio >= len(radios) || !radios[ch.Radio].Enable {
+			return fmt.Errorf("%s: radio %d is not enabled", key, ch.Radio)
+		}
+		bw := defaultBandwidth
+		if bw == 0 {
+			bw = ch.Bandwidth
+			if bw <= 0 {
+				return fmt.Errorf("%s: bandwidth must be set", key)
+			}
+		}
+		maxIF := (radioBandwidth(confKey, bw) - bw) / 2
+		if ch.IF < -maxIF || ch.IF > maxIF {
+			return fmt.Errorf("%s: IF %d is out of the radio bandwidth, must be within ±%d", key, ch.IF, maxIF)
+		}
+		freq := radios[ch.Radio].Freq + ch.IF
+		if defaultBandwidth != 0 {
+			if other, ok := freqs[freq]; ok {
+				return fmt.Errorf("%s: frequency %d is already used by %s", key, freq, other)
+			}
+			freqs[freq] = key
+		}
+		enabled++
+		return nil
+	}
+	for i := 0; i < 8; i++ {
+		if err := checkChannel(fmt.Sprintf("chan_multiSF_%d", i), 125000); err != nil {
+			return err
+		}
+	}
+	if err := checkChannel("chan_Lora_std", 0); err != nil {
+		return err
+	}
+	if err := checkChannel("chan_FSK", 0); err != nil {
+		return err
+	}
+	if enabled == 0 {
+		return fmt.Errorf("no channel is enabled")
+	}
+
+	var gwConf pfGatewayConf
+	data, ok := raw["gateway_conf"]
+	if !ok {
+		return fmt.Errorf("gateway settings are missing")
+	}
+	if err := json.Unmarshal(data, &gwConf); err != nil {
+		return fmt.Errorf("invalid gateway_conf: %v", err)
+	}
+	if gwConf.GatewayID == "" || gwConf.ServerAddress == "" {
+		return fmt.Errorf("gateway_conf: gateway_ID and server_address must be set")
+	}
+	for _, port := range []int{gwConf.ServPortUp, gwConf.ServPortDown} {
+		if port <= 0 || port > 65535 {
+			return fmt.Errorf("gateway_conf: invalid server port %d", port)
+		}
+	}
+	return nil
+}
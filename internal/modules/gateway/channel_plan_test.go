@@ -0,0 +1,208 @@
+package gateway
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+)
+
+func TestChannelPlans(t *testing.T) {
+	plans := ChannelPlans()
+	if len(plans) != 22 {
+		t.Errorf("expected 22 channel plans, got %d", len(plans))
+	}
+	for _, p := range plans {
+		config, err := p.GenerateConfig()
+		if err != nil {
+			t.Errorf("%s: %v", p.Name, err)
+			continue
+		}
+		if err := ValidateConfig(config); err != nil {
+			t.Errorf("%s: generated config is invalid: %v", p.Name, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		region    string
+		radio0    uint32
+		firstFreq uint32
+	}{
+		{name: "US915_2", region: "US915", radio0: 904300000, firstFreq: 903900000},
+		{name: "AU915_1", region: "AU915", radio0: 915600000, firstFreq: 915200000},
+		{name: "AS923_1", region: "AS923", radio0: 923000000, firstFreq: 922600000},
+		{name: "AS923_3", region: "AS923", radio0: 916400000, firstFreq: 916000000},
+	} {
+		p, err := GetChannelPlan(tc.name)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if p.Region != tc.region {
+			t.Errorf("%s: expected region %s, got %s", tc.name, tc.region, p.Region)
+		}
+		if p.Radios[0] != tc.radio0 {
+			t.Errorf("%s: expected radio_0 at %d, got %d", tc.name, tc.radio0, p.Radios[0])
+		}
+		if p.MultiSF[0].Frequency != tc.firstFreq {
+			t.Errorf("%s: expected first channel at %d, got %d", tc.name, tc.firstFreq, p.MultiSF[0].Frequency)
+		}
+	}
+
+	if _, err := GetChannelPlan("EU433"); err == nil {
+		t.Errorf("expected an error for the unknown plan")
+	}
+}
+
+func TestValidateDefaultConfigs(t *testing.T) {
+	files, err := filepath.Glob("../../../static/gateway-config/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no default gateway configs found")
+	}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		config := strings.Replace(string(data), `\"`, `"`, -1)
+		if err := ValidateConfig(config); err != nil {
+			t.Errorf("%s: %v", filepath.Base(file), err)
+		}
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	p, err := GetChannelPlan("AU915_2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := p.GenerateConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name   string
+		modify func(conf map[string]interface{})
+	}{
+		{
+			name: "IF out of range",
+			modify: func(conf map[string]interface{}) {
+				ch := conf["SX130x_conf"].(map[string]interface{})["chan_multiSF_0"].(map[string]interface{})
+				ch["if"] = -900000
+			},
+		},
+		{
+			name: "duplicate channel",
+			modify: func(conf map[string]interface{}) {
+				sx := conf["SX130x_conf"].(map[string]interface{})
+				sx["chan_multiSF_1"] = sx["chan_multiSF_0"]
+			},
+		},
+		{
+			name: "disabled radio",
+			modify: func(conf map[string]interface{}) {
+				radio := conf["SX130x_conf"].(map[string]interface{})["radio_1"].(map[string]interface{})
+				radio["enable"] = false
+			},
+		},
+		{
+			name: "missing gateway settings",
+			modify: func(conf map[string]interface{}) {
+				delete(conf, "gateway_conf")
+			},
+		},
+	} {
+		conf, err := decodeConfig(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tc.modify(conf)
+		invalid, err := marshalConfig(conf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ValidateConfig(invalid); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+	}
+	if err := ValidateConfig("{"); err == nil {
+		t.Errorf("expected an error for invalid JSON")
+	}
+}
+
+func TestApplyConfigOverrides(t *testing.T) {
+	p, err := GetChannelPlan("IN865")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := p.GenerateConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := ApplyConfigOverrides(config, gw.GatewayConfigOverrides{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != config {
+		t.Errorf("expected the config to be unchanged")
+	}
+
+	gain, offset, lat := 3.5, -210.0, 52.5
+	clksrc, alt := uint32(1), int32(120)
+	tty := "/dev/ttyS1"
+	res, err = ApplyConfigOverrides(config, gw.GatewayConfigOverrides{
+		AntennaGain: &gain,
+		RSSIOffset:  &offset,
+		ClkSrc:      &clksrc,
+		GPSTTYPath:  &tty,
+		RefLatitude: &lat,
+		RefAltitude: &alt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateConfig(res); err != nil {
+		t.Errorf("config with overrides is invalid: %v", err)
+	}
+	var conf struct {
+		SX130x struct {
+			AntennaGain float64 `json:"antenna_gain"`
+			ClkSrc      uint32  `json:"clksrc"`
+			Radio0      struct {
+				RSSIOffset float64 `json:"rssi_offset"`
+			} `json:"radio_0"`
+			Radio1 struct {
+				RSSIOffset float64 `json:"rssi_offset"`
+			} `json:"radio_1"`
+		} `json:"SX130x_conf"`
+		GatewayConf struct {
+			GPSTTYPath   string  `json:"gps_tty_path"`
+			RefLatitude  float64 `json:"ref_latitude"`
+			RefLongitude float64 `json:"ref_longitude"`
+			RefAltitude  int32   `json:"ref_altitude"`
+			GatewayID    string  `json:"gateway_ID"`
+		} `json:"gateway_conf"`
+	}
+	if err := json.Unmarshal([]byte(res), &conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.SX130x.AntennaGain != gain || conf.SX130x.ClkSrc != clksrc ||
+		conf.SX130x.Radio0.RSSIOffset != offset || conf.SX130x.Radio1.RSSIOffset != offset {
+		t.Errorf("concentrator settings are not overridden: %+v", conf.SX130x)
+	}
+	if conf.GatewayConf.GPSTTYPath != tty || conf.GatewayConf.RefLatitude != lat ||
+		conf.GatewayConf.RefLongitude != 0 || conf.GatewayConf.RefAltitude != alt {
+		t.Errorf("gateway settings are not overridden: %+v", conf.GatewayConf)
+	}
+	if conf.GatewayConf.GatewayID != "{{ .GatewayID }}" {
+		t.Errorf("expected the gateway ID placeholder to be kept, got %q", conf.GatewayConf.GatewayID)
+	}
+}
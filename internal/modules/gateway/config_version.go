@@ -0,0 +1,78 @@
+package gateway
+
+import (
+	"context"
+	/* #nosec */
+	"crypto/md5"
+	"fmt"
+
+	"github.com/brocaar/lorawan"
+	"github.com/pmezard/go-difflib/difflib"
+
+	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/types"
+)
+
+// ConfigVersionStore defines db API used by gateway config versioning
+type ConfigVersionStore interface {
+	GetGateway(ctx context.Context, mac lorawan.EUI64, forUpdate bool) (gw.Gateway, error)
+	UpdateGatewayConfigByGwID(ctx context.Context, config string, mac lorawan.EUI64) error
+
+	AddGatewayConfigVersion(ctx context.Context, cv *gw.GatewayConfigVersion) error
+	GetGatewayConfigVersion(ctx context.Context, mac lorawan.EUI64, version int) (gw.GatewayConfigVersion, error)
+	AcknowledgeGatewayConfigVersion(ctx context.Context, mac lorawan.EUI64, hash types.MD5SUM) error
+}
+
+// SetGatewayConfig replaces the config of the gateway and stores it as a new
+// version. If the config is the same as the current one nothing is changed
+// and nil is returned. Should be called inside a transaction.
+func SetGatewayConfig(ctx context.Context, st ConfigVersionStore, mac lorawan.EUI64,
+	config, author, reason string) (*gw.GatewayConfigVersion, error) {
+	gateway, err := st.GetGateway(ctx, mac, true)
+	if err != nil {
+		return nil, err
+	}
+	if gateway.Config == config {
+		return nil, nil
+	}
+
+	if err := st.UpdateGatewayConfigByGwID(ctx, config, mac); err != nil {
+		return nil, err
+	}
+	cv := &gw.GatewayConfigVersion{
+		GatewayMAC: mac,
+		Config:     config,
+		/* #nosec */
+		ConfigHash: md5.Sum([]byte(config)),
+		Author:     author,
+		Reason:     reason,
+	}
+	if err := st.AddGatewayConfigVersion(ctx, cv); err != nil {
+		return nil, err
+	}
+	return cv, nil
+}
+
+// RestoreGatewayConfigVersion makes the given version of the config the
+// current config of the gateway. The restored config is stored as a new
+// version.
+func RestoreGatewayConfigVersion(ctx context.Context, st ConfigVersionStore, mac lorawan.EUI64,
+	version int, author string) (*gw.GatewayConfigVersion, error) {
+	cv, err := st.GetGatewayConfigVersion(ctx, mac, version)
+	if err != nil {
+		return nil, err
+	}
+	return SetGatewayConfig(ctx, st, mac, cv.Config, author, fmt.Sprintf("restore version %d", version))
+}
+
+// DiffGatewayConfigVersions returns unified diff between two versions of the
+// gateway config
+func DiffGatewayConfigVersions(from, to gw.GatewayConfigVersion) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.Config),
+		B:        difflib.SplitLines(to.Config),
+		FromFile: fmt.Sprintf("version %d", from.Version),
+		ToFile:   fmt.Sprintf("version %d", to.Version),
+		Context:  3,
+	})
+}
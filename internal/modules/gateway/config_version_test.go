@@ -0,0 +1,124 @@
+package gateway
+
+import (
+	"context"
+	/* #nosec */
+	"crypto/md5"
+	"strings"
+	"testing"
+
+	"github.com/brocaar/lorawan"
+
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/types"
+)
+
+// configVersionStore keeps gateways and their config versions in memory
+type configVersionStore struct {
+	gateways map[lorawan.EUI64]gw.Gateway
+	versions map[lorawan.EUI64][]gw.GatewayConfigVersion
+}
+
+func (s *configVersionStore) GetGateway(ctx context.Context, mac lorawan.EUI64, forUpdate bool) (gw.Gateway, error) {
+	gateway, ok := s.gateways[mac]
+	if !ok {
+		return gateway, errHandler.ErrDoesNotExist
+	}
+	return gateway, nil
+}
+
+func (s *configVersionStore) UpdateGatewayConfigByGwID(ctx context.Context, config string, mac lorawan.EUI64) error {
+	gateway, ok := s.gateways[mac]
+	if !ok {
+		return errHandler.ErrDoesNotExist
+	}
+	gateway.Config = config
+	s.gateways[mac] = gateway
+	return nil
+}
+
+func (s *configVersionStore) AddGatewayConfigVersion(ctx context.Context, cv *gw.GatewayConfigVersion) error {
+	cv.Version = len(s.versions[cv.GatewayMAC]) + 1
+	s.versions[cv.GatewayMAC] = append(s.versions[cv.GatewayMAC], *cv)
+	return nil
+}
+
+func (s *configVersionStore) GetGatewayConfigVersion(ctx context.Context, mac lorawan.EUI64, version int) (gw.GatewayConfigVersion, error) {
+	list := s.versions[mac]
+	if version < 1 || version > len(list) {
+		return gw.GatewayConfigVersion{}, errHandler.ErrDoesNotExist
+	}
+	return list[version-1], nil
+}
+
+func (s *configVersionStore) AcknowledgeGatewayConfigVersion(ctx context.Context, mac lorawan.EUI64, hash types.MD5SUM) error {
+	return nil
+}
+
+func TestGatewayConfigVersions(t *testing.T) {
+	ctx := context.Background()
+	mac := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	st := &configVersionStore{
+		gateways: map[lorawan.EUI64]gw.Gateway{mac: {MAC: mac}},
+		versions: make(map[lorawan.EUI64][]gw.GatewayConfigVersion),
+	}
+
+	first := "{\n  \"rssi_offset\": -166,\n  \"lorawan_public\": true\n}\n"
+	cv, err := SetGatewayConfig(ctx, st, mac, first, "alice@example.com", "initial")
+	if err != nil {
+		t.Fatal(err)
+	}
+	/* #nosec */
+	if cv.Version != 1 || cv.ConfigHash != md5.Sum([]byte(first)) || cv.Author != "alice@example.com" {
+		t.Fatalf("unexpected version: %+v", cv)
+	}
+
+	// the same config doesn't produce a new version
+	cv, err = SetGatewayConfig(ctx, st, mac, first, "bob@example.com", "again")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cv != nil || len(st.versions[mac]) != 1 {
+		t.Fatalf("expected no new version, got %+v", cv)
+	}
+
+	second := "{\n  \"rssi_offset\": -160,\n  \"lorawan_public\": true\n}\n"
+	if _, err := SetGatewayConfig(ctx, st, mac, second, "bob@example.com", "tune rssi"); err != nil {
+		t.Fatal(err)
+	}
+	if st.gateways[mac].Config != second {
+		t.Fatalf("expected the gateway config to be updated")
+	}
+
+	diff, err := DiffGatewayConfigVersions(st.versions[mac][0], st.versions[mac][1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"--- version 1",
+		"+++ version 2",
+		"-  \"rssi_offset\": -166,",
+		"+  \"rssi_offset\": -160,",
+	} {
+		if !strings.Contains(diff, line+"\n") {
+			t.Errorf("diff doesn't contain %q:\n%s", line, diff)
+		}
+	}
+
+	// restoring the first version stores it as the third one
+	cv, err = RestoreGatewayConfigVersion(ctx, st, mac, 1, "carol@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cv.Version != 3 || cv.Config != first || cv.Reason != "restore version 1" {
+		t.Fatalf("unexpected restored version: %+v", cv)
+	}
+	if st.gateways[mac].Config != first {
+		t.Fatalf("expected the gateway config to be restored")
+	}
+
+	if _, err := RestoreGatewayConfigVersion(ctx, st, mac, 4, "carol@example.com"); err != errHandler.ErrDoesNotExist {
+		t.Fatalf("expected ErrDoesNotExist, got %v", err)
+	}
+}
@@ -30,6 +30,8 @@ type GatewayBindStruct struct {
 		TLSCert string `mapstructure:"rsa_tls_cert"`
 		TLSKey  string `mapstructure:"rsa_tls_key"`
 	} `mapstructure:"old_gateway"`
+
+	FirmwareRollout FirmwareRolloutConfig `mapstructure:"firmware_rollout"`
 }
 
 var (
@@ -105,6 +107,138 @@ type GatewayFirmware struct {
 	FirmwareHash types.MD5SUM `db:"md5_hash"`
 }
 
+// Firmware rollout statuses.
+const (
+	FirmwareRolloutRunning    = "running"
+	FirmwareRolloutPaused     = "paused"
+	FirmwareRolloutCompleted  = "completed"
+	FirmwareRolloutRolledBack = "rolled_back"
+)
+
+// Statuses of a gateway taking part in a firmware rollout.
+const (
+	FirmwareRolloutGatewayPending   = "pending"
+	FirmwareRolloutGatewayOffered   = "offered"
+	FirmwareRolloutGatewaySucceeded = "succeeded"
+	// Gateway kept sending heartbeats after it was offered the firmware but
+	// never reported the firmware hash.
+	FirmwareRolloutGatewayUnconfirmed = "unconfirmed"
+)
+
+// FirmwareRolloutConfig defines the default parameters of firmware rollout
+// campaigns created when the provisioning server publishes new firmware.
+type FirmwareRolloutConfig struct {
+	// Percentage of the auto-updating gateways of a model that receive the
+	// new firmware first.
+	CanaryPercentage int `mapstructure:"canary_percentage"`
+	// Rollout is paused when more than this percentage of the gateways that
+	// were offered the new firmware stop sending heartbeats.
+	MaxSilentPercentage int `mapstructure:"max_silent_percentage"`
+	// Gateway that hasn't sent heartbeat for this time after being offered
+	// the firmware is considered to be silent, the one that keeps sending
+	// heartbeats for this time without reporting the firmware hash is
+	// considered to be unconfirmed.
+	SilentTimeout time.Duration `mapstructure:"silent_timeout"`
+	// How often running rollouts are evaluated.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+}
+
+// FirmwareRollout represents a staged rollout of new firmware for the
+// gateways of one model.
+type FirmwareRollout struct {
+	ID                   int64         `db:"id"`
+	Model                string        `db:"model"`
+	ResourceLink         string        `db:"resource_link"`
+	FirmwareHash         types.MD5SUM  `db:"md5_hash"`
+	PreviousResourceLink string        `db:"previous_resource_link"`
+	PreviousFirmwareHash types.MD5SUM  `db:"previous_md5_hash"`
+	Percentage           int           `db:"percentage"`
+	MaxSilentPercentage  int           `db:"max_silent_percentage"`
+	SilentTimeout        time.Duration `db:"silent_timeout"`
+	Status               string        `db:"status"`
+	CreatedAt            time.Time     `db:"created_at"`
+	UpdatedAt            time.Time     `db:"updated_at"`
+}
+
+// FirmwareRolloutGateway represents a gateway selected for a firmware
+// rollout.
+type FirmwareRolloutGateway struct {
+	RolloutID     int64         `db:"rollout_id"`
+	GatewayMAC    lorawan.EUI64 `db:"gateway_mac"`
+	Canary        bool          `db:"canary"`
+	Status        string        `db:"status"`
+	OfferedAt     *time.Time    `db:"offered_at"`
+	ConfirmedAt   *time.Time    `db:"confirmed_at"`
+	LastHeartbeat int64         `db:"last_heartbeat"`
+}
+
+// FirmwareRolloutSummary holds the counters of gateways of a rollout.
+type FirmwareRolloutSummary struct {
+	Total       int `db:"total"`
+	Pending     int `db:"pending"`
+	Offered     int `db:"offered"`
+	Succeeded   int `db:"succeeded"`
+	Unconfirmed int `db:"unconfirmed"`
+}
+
+// GatewayConfigVersion represents a version of the gateway config.
+type GatewayConfigVersion struct {
+	GatewayMAC     lorawan.EUI64 `db:"gateway_mac"`
+	Version        int           `db:"version"`
+	Config         string        `db:"config"`
+	ConfigHash     types.MD5SUM  `db:"config_hash"`
+	Author         string        `db:"author"`
+	Reason         string        `db:"reason"`
+	CreatedAt      time.Time     `db:"created_at"`
+	AcknowledgedAt *time.Time    `db:"acknowledged_at"`
+}
+
+// GatewayConfigOverrides are the packet forwarder settings replacing the ones
+// of the default gateway config, nil values are not replaced.
+type GatewayConfigOverrides struct {
+	AntennaGain   *float64 `json:"antenna_gain,omitempty"`
+	RSSIOffset    *float64 `json:"rssi_offset,omitempty"`
+	ClkSrc        *uint32  `json:"clksrc,omitempty"`
+	LoRaWANPublic *bool    `json:"lorawan_public,omitempty"`
+	GPSTTYPath    *string  `json:"gps_tty_path,omitempty"`
+	RefLatitude   *float64 `json:"ref_latitude,omitempty"`
+	RefLongitude  *float64 `json:"ref_longitude,omitempty"`
+	RefAltitude   *int32   `json:"ref_altitude,omitempty"`
+}
+
+// IsEmpty returns true if no setting is overridden
+func (o GatewayConfigOverrides) IsEmpty() bool {
+	return o == GatewayConfigOverrides{}
+}
+
+// Backhaul types reported by gateways.
+const (
+	BackhaulEthernet = "ethernet"
+	BackhaulWiFi     = "wifi"
+	BackhaulCellular = "cellular"
+)
+
+// HeartbeatStatistics holds the statistics reported by the gateway in the
+// heartbeat. Fields that the gateway didn't report are nil.
+type HeartbeatStatistics struct {
+	// CPU load in percent
+	CPULoad *float64
+	// Memory usage in percent
+	MemoryUsage *float64
+	// Temperature in degrees Celsius
+	Temperature *float64
+	// Uptime in seconds
+	Uptime *float64
+	// One of the backhaul types, empty if unknown
+	Backhaul string
+}
+
+// IsEmpty returns true if the gateway didn't report any statistics
+func (s HeartbeatStatistics) IsEmpty() bool {
+	return s.CPULoad == nil && s.MemoryUsage == nil && s.Temperature == nil &&
+		s.Uptime == nil && s.Backhaul == ""
+}
+
 // GatewayLocation represents a gateway location.
 type GatewayLocation struct {
 	Latitude  float64 `db:"latitude"`
@@ -134,6 +268,26 @@ type GatewayPingRX struct {
 	Altitude   float64       `db:"altitude"`
 }
 
+// GatewayPingLinkStats holds the reception statistics of the pings sent by
+// one gateway and received by another one during a period.
+type GatewayPingLinkStats struct {
+	SenderMAC   lorawan.EUI64 `db:"sender_mac"`
+	ReceiverMAC lorawan.EUI64 `db:"receiver_mac"`
+	Period      time.Time     `db:"period"`
+	Received    int           `db:"received"`
+	AvgRSSI     float64       `db:"avg_rssi"`
+	AvgSNR      float64       `db:"avg_snr"`
+	BestRSSI    int           `db:"best_rssi"`
+}
+
+// GatewayPingSentStats holds the number of pings sent by the gateway during
+// a period.
+type GatewayPingSentStats struct {
+	GatewayMAC lorawan.EUI64 `db:"gateway_mac"`
+	Period     time.Time     `db:"period"`
+	Sent       int           `db:"sent"`
+}
+
 // GPSPoint contains a GPS point.
 type GPSPoint struct {
 	Latitude  float64
@@ -0,0 +1,401 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
+	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/types"
+)
+
+const (
+	defaultCanaryPercentage    = 10
+	defaultMaxSilentPercentage = 20
+	defaultSilentTimeout       = 30 * time.Minute
+	defaultRolloutInterval     = 5 * time.Minute
+)
+
+var errNoRolloutGateways = errors.New("no gateways selected for the rollout")
+
+// ErrFirmwareRolloutState is returned when the requested operation is not
+// allowed in the current status of the rollout
+var ErrFirmwareRolloutState = errors.New("operation is not allowed in the current rollout status")
+
+// RolloutStore defines db API used by firmware rollouts
+type RolloutStore interface {
+	GetGatewayFirmware(ctx context.Context, model string, forUpdate bool) (gwFw gw.GatewayFirmware, err error)
+	UpdateGatewayFirmware(ctx context.Context, gwFw *gw.GatewayFirmware) (model string, err error)
+
+	CreateFirmwareRollout(ctx context.Context, rollout *gw.FirmwareRollout) error
+	GetFirmwareRollout(ctx context.Context, id int64, forUpdate bool) (gw.FirmwareRollout, error)
+	GetLatestFirmwareRollout(ctx context.Context, model string) (gw.FirmwareRollout, error)
+	GetFirmwareRolloutsByStatus(ctx context.Context, status string) ([]gw.FirmwareRollout, error)
+	UpdateFirmwareRolloutStatus(ctx context.Context, id int64, status string) error
+	GetFirmwareRolloutCandidates(ctx context.Context, model string) ([]lorawan.EUI64, error)
+	AddFirmwareRolloutGateways(ctx context.Context, rolloutID int64, macs []lorawan.EUI64, canary bool) error
+	GetFirmwareRolloutGateway(ctx context.Context, rolloutID int64, mac lorawan.EUI64) (gw.FirmwareRolloutGateway, error)
+	SetFirmwareRolloutGatewayOffered(ctx context.Context, rolloutID int64, mac lorawan.EUI64) error
+	SetFirmwareRolloutGatewaySucceeded(ctx context.Context, rolloutID int64, mac lorawan.EUI64) error
+	GetFirmwareRolloutSummary(ctx context.Context, rolloutID int64) (gw.FirmwareRolloutSummary, error)
+	GetFirmwareRolloutSilentCount(ctx context.Context, rolloutID int64, silentTimeout time.Duration) (int, error)
+	SetFirmwareRolloutGatewaysUnconfirmed(ctx context.Context, rolloutID int64, timeout time.Duration) (int, error)
+}
+
+// RolloutConfigWithDefaults returns the rollout config with the unset or
+// invalid values replaced by the defaults
+func RolloutConfigWithDefaults(conf gw.FirmwareRolloutConfig) gw.FirmwareRolloutConfig {
+	if conf.CanaryPercentage <= 0 || conf.CanaryPercentage > 100 {
+		conf.CanaryPercentage = defaultCanaryPercentage
+	}
+	if conf.MaxSilentPercentage <= 0 || conf.MaxSilentPercentage > 100 {
+		conf.MaxSilentPercentage = defaultMaxSilentPercentage
+	}
+	if conf.SilentTimeout <= 0 {
+		conf.SilentTimeout = defaultSilentTimeout
+	}
+	if conf.CheckInterval <= 0 {
+		conf.CheckInterval = defaultRolloutInterval
+	}
+	return conf
+}
+
+// CreateFirmwareRollout starts a new rollout campaign for the firmware given
+// in rollout. The firmware is offered to the given percentage of the
+// auto-updating gateways of the model and to all the gateways in the canary
+// list. The previous firmware of the rollout is taken from the current
+// firmware record of the model.
+func CreateFirmwareRollout(ctx context.Context, st RolloutStore, rollout *gw.FirmwareRollout, canaries []lorawan.EUI64) error {
+	if rollout.Percentage < 0 || rollout.Percentage > 100 {
+		return fmt.Errorf("invalid percentage: %d", rollout.Percentage)
+	}
+	if rollout.MaxSilentPercentage <= 0 || rollout.MaxSilentPercentage > 100 {
+		return fmt.Errorf("invalid max silent percentage: %d", rollout.MaxSilentPercentage)
+	}
+
+	latest, err := st.GetLatestFirmwareRollout(ctx, rollout.Model)
+	if err == nil {
+		if latest.Status == gw.FirmwareRolloutRunning || latest.Status == gw.FirmwareRolloutPaused {
+			return errors.Wrapf(errHandler.ErrAlreadyExists, "rollout %d for model %s is in progress", latest.ID, rollout.Model)
+		}
+	} else if err != errHandler.ErrDoesNotExist {
+		return err
+	}
+
+	current, err := st.GetGatewayFirmware(ctx, rollout.Model, false)
+	if err != nil {
+		return errors.Wrapf(err, "get firmware for model %s", rollout.Model)
+	}
+	rollout.PreviousResourceLink = current.ResourceLink
+	rollout.PreviousFirmwareHash = current.FirmwareHash
+	rollout.Status = gw.FirmwareRolloutRunning
+
+	candidates, err := st.GetFirmwareRolloutCandidates(ctx, rollout.Model)
+	if err != nil {
+		return err
+	}
+	// round up, so that a small fleet still gets at least one gateway
+	selected := candidates[:(len(candidates)*rollout.Percentage+99)/100]
+	if len(selected) == 0 && len(canaries) == 0 {
+		return errNoRolloutGateways
+	}
+
+	if err := st.CreateFirmwareRollout(ctx, rollout); err != nil {
+		return err
+	}
+	if err := st.AddFirmwareRolloutGateways(ctx, rollout.ID, canaries, true); err != nil {
+		return err
+	}
+	if err := st.AddFirmwareRolloutGateways(ctx, rollout.ID, selected, false); err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"rollout_id": rollout.ID,
+		"model":      rollout.Model,
+		"canaries":   len(canaries),
+		"selected":   len(selected),
+	}).Info("firmware rollout started")
+	return nil
+}
+
+// PauseFirmwareRollout stops offering the firmware of the rollout to the
+// gateways that haven't received it yet
+func PauseFirmwareRollout(ctx context.Context, st RolloutStore, id int64) error {
+	rollout, err := st.GetFirmwareRollout(ctx, id, true)
+	if err != nil {
+		return err
+	}
+	if rollout.Status != gw.FirmwareRolloutRunning {
+		return errors.Wrapf(ErrFirmwareRolloutState, "rollout is %s", rollout.Status)
+	}
+	return st.UpdateFirmwareRolloutStatus(ctx, id, gw.FirmwareRolloutPaused)
+}
+
+// ResumeFirmwareRollout continues paused rollout
+func ResumeFirmwareRollout(ctx context.Context, st RolloutStore, id int64) error {
+	rollout, err := st.GetFirmwareRollout(ctx, id, true)
+	if err != nil {
+		return err
+	}
+	if rollout.Status != gw.FirmwareRolloutPaused {
+		return errors.Wrapf(ErrFirmwareRolloutState, "rollout is %s", rollout.Status)
+	}
+	return st.UpdateFirmwareRolloutStatus(ctx, id, gw.FirmwareRolloutRunning)
+}
+
+// RollbackFirmwareRollout aborts the rollout and restores the firmware record
+// of the model to the one that was current before the rollout. Gateways that
+// have already received the new firmware will be offered the previous one
+// with their next heartbeat.
+func RollbackFirmwareRollout(ctx context.Context, st RolloutStore, id int64) error {
+	rollout, err := st.GetFirmwareRollout(ctx, id, true)
+	if err != nil {
+		return err
+	}
+	if rollout.Status == gw.FirmwareRolloutRolledBack {
+		return errors.Wrapf(ErrFirmwareRolloutState, "rollout is %s", rollout.Status)
+	}
+	if rollout.PreviousResourceLink == "" {
+		return errors.Wrapf(ErrFirmwareRolloutState, "no previous firmware for model %s", rollout.Model)
+	}
+	if rollout.Status == gw.FirmwareRolloutCompleted {
+		latest, err := st.GetLatestFirmwareRollout(ctx, rollout.Model)
+		if err != nil {
+			return err
+		}
+		if latest.ID != rollout.ID {
+			return errors.Wrapf(ErrFirmwareRolloutState, "rollout %d has superseded this rollout", latest.ID)
+		}
+	}
+
+	if _, err := st.UpdateGatewayFirmware(ctx, &gw.GatewayFirmware{
+		Model:        rollout.Model,
+		ResourceLink: rollout.PreviousResourceLink,
+		FirmwareHash: rollout.PreviousFirmwareHash,
+	}); err != nil {
+		return err
+	}
+	if err := st.UpdateFirmwareRolloutStatus(ctx, id, gw.FirmwareRolloutRolledBack); err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"rollout_id": rollout.ID,
+		"model":      rollout.Model,
+	}).Warn("firmware rollout rolled back")
+	return nil
+}
+
+// firmwareForGateway returns the firmware the gateway should be running. If
+// the gateway takes part in a running rollout for its model it gets the
+// firmware of the rollout, otherwise the current firmware of the model. While
+// the rollout is paused the gateways that have already been offered the
+// firmware of the rollout keep it, so they are not told to downgrade, but it
+// is not offered to any more gateways.
+func firmwareForGateway(ctx context.Context, st RolloutStore, gateway *gw.Gateway) (gw.GatewayFirmware, error) {
+	firmware, err := st.GetGatewayFirmware(ctx, gateway.Model, false)
+	if err != nil {
+		return firmware, err
+	}
+
+	rollout, rg, err := gatewayRollout(ctx, st, gateway)
+	if err != nil || rollout == nil {
+		return firmware, err
+	}
+	if rollout.Status == gw.FirmwareRolloutPaused && rg.Status == gw.FirmwareRolloutGatewayPending {
+		return firmware, nil
+	}
+	if rg.Status == gw.FirmwareRolloutGatewayPending &&
+		!bytes.Equal(rollout.FirmwareHash[:], gateway.FirmwareHash[:]) {
+		if err := st.SetFirmwareRolloutGatewayOffered(ctx, rollout.ID, gateway.MAC); err != nil {
+			return firmware, err
+		}
+	}
+
+	return gw.GatewayFirmware{
+		Model:        rollout.Model,
+		ResourceLink: rollout.ResourceLink,
+		FirmwareHash: rollout.FirmwareHash,
+	}, nil
+}
+
+// confirmFirmware records that gateway reported running the firmware with the
+// given hash
+func confirmFirmware(ctx context.Context, st RolloutStore, gateway *gw.Gateway, hash types.MD5SUM) error {
+	rollout, rg, err := gatewayRollout(ctx, st, gateway)
+	if err != nil || rollout == nil || rg.Status == gw.FirmwareRolloutGatewaySucceeded {
+		return err
+	}
+	if !bytes.Equal(rollout.FirmwareHash[:], hash[:]) {
+		return nil
+	}
+	return st.SetFirmwareRolloutGatewaySucceeded(ctx, rollout.ID, gateway.MAC)
+}
+
+// gatewayRollout returns the running or paused rollout for the model of the
+// gateway if the gateway has been selected for it
+func gatewayRollout(ctx context.Context, st RolloutStore, gateway *gw.Gateway) (*gw.FirmwareRollout, *gw.FirmwareRolloutGateway, error) {
+	rollout, err := st.GetLatestFirmwareRollout(ctx, gateway.Model)
+	if err != nil {
+		if err == errHandler.ErrDoesNotExist {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	if rollout.Status != gw.FirmwareRolloutRunning && rollout.Status != gw.FirmwareRolloutPaused {
+		return nil, nil, nil
+	}
+	rg, err := st.GetFirmwareRolloutGateway(ctx, rollout.ID, gateway.MAC)
+	if err != nil {
+		if err == errHandler.ErrDoesNotExist {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	return &rollout, &rg, nil
+}
+
+// startRolloutForNewFirmware is called when provisioning server reports the
+// firmware for the model. If the firmware is new a rollout campaign is
+// started instead of updating the firmware of the whole fleet at once.
+func (c *controller) startRolloutForNewFirmware(ctx context.Context, current, next gw.GatewayFirmware) error {
+	if bytes.Equal(current.FirmwareHash[:], next.FirmwareHash[:]) {
+		if current.ResourceLink != next.ResourceLink {
+			_, err := c.st.UpdateGatewayFirmware(ctx, &next)
+			return err
+		}
+		return nil
+	}
+	// nothing to compare the new firmware against, nothing to roll back to
+	if current.ResourceLink == "" {
+		_, err := c.st.UpdateGatewayFirmware(ctx, &next)
+		return err
+	}
+
+	latest, err := c.st.GetLatestFirmwareRollout(ctx, next.Model)
+	if err == nil {
+		if bytes.Equal(latest.FirmwareHash[:], next.FirmwareHash[:]) {
+			// this firmware is being rolled out or has been rolled back
+			return nil
+		}
+		if latest.Status == gw.FirmwareRolloutRunning || latest.Status == gw.FirmwareRolloutPaused {
+			log.WithFields(log.Fields{
+				"rollout_id": latest.ID,
+				"model":      next.Model,
+			}).Warn("new firmware is available, but previous rollout is in progress")
+			return nil
+		}
+	} else if err != errHandler.ErrDoesNotExist {
+		return err
+	}
+
+	err = CreateFirmwareRollout(ctx, c.st, &gw.FirmwareRollout{
+		Model:               next.Model,
+		ResourceLink:        next.ResourceLink,
+		FirmwareHash:        next.FirmwareHash,
+		Percentage:          c.rollout.CanaryPercentage,
+		MaxSilentPercentage: c.rollout.MaxSilentPercentage,
+		SilentTimeout:       c.rollout.SilentTimeout,
+	}, nil)
+	if err == errNoRolloutGateways {
+		// no gateway of this model updates automatically
+		_, err = c.st.UpdateGatewayFirmware(ctx, &next)
+	}
+	return err
+}
+
+// firmwareRolloutLoop periodically checks the state of running rollouts
+func (c *controller) firmwareRolloutLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.rollout.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if !leader.IsLeader(firmwareRolloutJob) {
+			continue
+		}
+		if err := c.checkFirmwareRollouts(ctx); err != nil {
+			log.WithError(err).Error("check firmware rollouts error")
+		}
+	}
+}
+
+// checkFirmwareRollouts pauses running rollouts if too many of the gateways
+// went silent after receiving the new firmware and completes the rollouts
+// when all the participating gateways reported the new firmware hash. The
+// gateways that don't report the hash but keep sending heartbeats for the
+// silent timeout after receiving the firmware are counted as unconfirmed, so
+// they don't block the rollout forever.
+func (c *controller) checkFirmwareRollouts(ctx context.Context) error {
+	rollouts, err := c.st.GetFirmwareRolloutsByStatus(ctx, gw.FirmwareRolloutRunning)
+	if err != nil {
+		return err
+	}
+	for _, rollout := range rollouts {
+		logger := log.WithFields(log.Fields{
+			"rollout_id": rollout.ID,
+			"model":      rollout.Model,
+		})
+		summary, err := c.st.GetFirmwareRolloutSummary(ctx, rollout.ID)
+		if err != nil {
+			return err
+		}
+		silent, err := c.st.GetFirmwareRolloutSilentCount(ctx, rollout.ID, rollout.SilentTimeout)
+		if err != nil {
+			return err
+		}
+
+		offered := summary.Offered + summary.Succeeded + summary.Unconfirmed
+		if silent > 0 && silent*100 > offered*rollout.MaxSilentPercentage {
+			logger.WithFields(log.Fields{
+				"offered": offered,
+				"silent":  silent,
+			}).Error("too many gateways went silent after firmware update, pausing rollout")
+			if err := c.st.UpdateFirmwareRolloutStatus(ctx, rollout.ID, gw.FirmwareRolloutPaused); err != nil {
+				return err
+			}
+			continue
+		}
+
+		unconfirmed, err := c.st.SetFirmwareRolloutGatewaysUnconfirmed(ctx, rollout.ID, rollout.SilentTimeout)
+		if err != nil {
+			return err
+		}
+		if unconfirmed > 0 {
+			logger.WithField("unconfirmed", unconfirmed).Warn("gateways didn't report the firmware hash after the firmware update")
+			summary.Offered -= unconfirmed
+			summary.Unconfirmed += unconfirmed
+		}
+		if summary.Pending != 0 || summary.Offered != 0 || summary.Succeeded+summary.Unconfirmed == 0 {
+			continue
+		}
+		// all the canaries are running the new firmware, release it to the
+		// rest of the fleet
+		if _, err := c.st.UpdateGatewayFirmware(ctx, &gw.GatewayFirmware{
+			Model:        rollout.Model,
+			ResourceLink: rollout.ResourceLink,
+			FirmwareHash: rollout.FirmwareHash,
+		}); err != nil {
+			return err
+		}
+		if err := c.st.UpdateFirmwareRolloutStatus(ctx, rollout.ID, gw.FirmwareRolloutCompleted); err != nil {
+			return err
+		}
+		logger.WithFields(log.Fields{
+			"succeeded":   summary.Succeeded,
+			"unconfirmed": summary.Unconfirmed,
+		}).Info("firmware rollout completed")
+	}
+	return nil
+}
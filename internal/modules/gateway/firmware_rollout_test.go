@@ -0,0 +1,394 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brocaar/lorawan"
+
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/types"
+)
+
+// rolloutStore keeps the rollout state in memory, the methods of Store that
+// are not used by rollouts are left unimplemented
+type rolloutStore struct {
+	Store
+
+	firmware   map[string]gw.GatewayFirmware
+	candidates map[string][]lorawan.EUI64
+	rollouts   []gw.FirmwareRollout
+	gateways   map[int64]map[lorawan.EUI64]*gw.FirmwareRolloutGateway
+	silent     int
+	// gateways that keep sending heartbeats without reporting the hash
+	unconfirmed map[lorawan.EUI64]bool
+}
+
+func newRolloutStore() *rolloutStore {
+	return &rolloutStore{
+		firmware:   make(map[string]gw.GatewayFirmware),
+		candidates: make(map[string][]lorawan.EUI64),
+		gateways:   make(map[int64]map[lorawan.EUI64]*gw.FirmwareRolloutGateway),
+	}
+}
+
+func (s *rolloutStore) GetGatewayFirmware(ctx context.Context, model string, forUpdate bool) (gw.GatewayFirmware, error) {
+	fw, ok := s.firmware[model]
+	if !ok {
+		return fw, errHandler.ErrDoesNotExist
+	}
+	return fw, nil
+}
+
+func (s *rolloutStore) UpdateGatewayFirmware(ctx context.Context, gwFw *gw.GatewayFirmware) (string, error) {
+	s.firmware[gwFw.Model] = *gwFw
+	return gwFw.Model, nil
+}
+
+func (s *rolloutStore) CreateFirmwareRollout(ctx context.Context, rollout *gw.FirmwareRollout) error {
+	rollout.ID = int64(len(s.rollouts) + 1)
+	s.rollouts = append(s.rollouts, *rollout)
+	s.gateways[rollout.ID] = make(map[lorawan.EUI64]*gw.FirmwareRolloutGateway)
+	return nil
+}
+
+func (s *rolloutStore) GetFirmwareRollout(ctx context.Context, id int64, forUpdate bool) (gw.FirmwareRollout, error) {
+	if id < 1 || int(id) > len(s.rollouts) {
+		return gw.FirmwareRollout{}, errHandler.ErrDoesNotExist
+	}
+	return s.rollouts[id-1], nil
+}
+
+func (s *rolloutStore) GetLatestFirmwareRollout(ctx context.Context, model string) (gw.FirmwareRollout, error) {
+	for i := len(s.rollouts) - 1; i >= 0; i-- {
+		if s.rollouts[i].Model == model {
+			return s.rollouts[i], nil
+		}
+	}
+	return gw.FirmwareRollout{}, errHandler.ErrDoesNotExist
+}
+
+func (s *rolloutStore) GetFirmwareRolloutsByStatus(ctx context.Context, status string) ([]gw.FirmwareRollout, error) {
+	var list []gw.FirmwareRollout
+	for _, r := range s.rollouts {
+		if r.Status == status {
+			list = append(list, r)
+		}
+	}
+	return list, nil
+}
+
+func (s *rolloutStore) UpdateFirmwareRolloutStatus(ctx context.Context, id int64, status string) error {
+	if id < 1 || int(id) > len(s.rollouts) {
+		return errHandler.ErrDoesNotExist
+	}
+	s.rollouts[id-1].Status = status
+	return nil
+}
+
+func (s *rolloutStore) GetFirmwareRolloutCandidates(ctx context.Context, model string) ([]lorawan.EUI64, error) {
+	return s.candidates[model], nil
+}
+
+func (s *rolloutStore) AddFirmwareRolloutGateways(ctx context.Context, rolloutID int64, macs []lorawan.EUI64, canary bool) error {
+	for _, mac := range macs {
+		if rg, ok := s.gateways[rolloutID][mac]; ok {
+			rg.Canary = rg.Canary || canary
+			continue
+		}
+		s.gateways[rolloutID][mac] = &gw.FirmwareRolloutGateway{
+			RolloutID:  rolloutID,
+			GatewayMAC: mac,
+			Canary:     canary,
+			Status:     gw.FirmwareRolloutGatewayPending,
+		}
+	}
+	return nil
+}
+
+func (s *rolloutStore) GetFirmwareRolloutGateway(ctx context.Context, rolloutID int64, mac lorawan.EUI64) (gw.FirmwareRolloutGateway, error) {
+	rg, ok := s.gateways[rolloutID][mac]
+	if !ok {
+		return gw.FirmwareRolloutGateway{}, errHandler.ErrDoesNotExist
+	}
+	return *rg, nil
+}
+
+func (s *rolloutStore) SetFirmwareRolloutGatewayOffered(ctx context.Context, rolloutID int64, mac lorawan.EUI64) error {
+	if rg, ok := s.gateways[rolloutID][mac]; ok && rg.Status == gw.FirmwareRolloutGatewayPending {
+		rg.Status = gw.FirmwareRolloutGatewayOffered
+	}
+	return nil
+}
+
+func (s *rolloutStore) SetFirmwareRolloutGatewaySucceeded(ctx context.Context, rolloutID int64, mac lorawan.EUI64) error {
+	if rg, ok := s.gateways[rolloutID][mac]; ok {
+		rg.Status = gw.FirmwareRolloutGatewaySucceeded
+	}
+	return nil
+}
+
+func (s *rolloutStore) GetFirmwareRolloutSummary(ctx context.Context, rolloutID int64) (gw.FirmwareRolloutSummary, error) {
+	var summary gw.FirmwareRolloutSummary
+	for _, rg := range s.gateways[rolloutID] {
+		summary.Total++
+		switch rg.Status {
+		case gw.FirmwareRolloutGatewayPending:
+			summary.Pending++
+		case gw.FirmwareRolloutGatewayOffered:
+			summary.Offered++
+		case gw.FirmwareRolloutGatewaySucceeded:
+			summary.Succeeded++
+		case gw.FirmwareRolloutGatewayUnconfirmed:
+			summary.Unconfirmed++
+		}
+	}
+	return summary, nil
+}
+
+func (s *rolloutStore) GetFirmwareRolloutSilentCount(ctx context.Context, rolloutID int64, silentTimeout time.Duration) (int, error) {
+	return s.silent, nil
+}
+
+func (s *rolloutStore) SetFirmwareRolloutGatewaysUnconfirmed(ctx context.Context, rolloutID int64, timeout time.Duration) (int, error) {
+	var count int
+	for mac, rg := range s.gateways[rolloutID] {
+		if rg.Status == gw.FirmwareRolloutGatewayOffered && s.unconfirmed[mac] {
+			rg.Status = gw.FirmwareRolloutGatewayUnconfirmed
+			count++
+		}
+	}
+	return count, nil
+}
+
+func TestRolloutConfigWithDefaults(t *testing.T) {
+	conf := RolloutConfigWithDefaults(gw.FirmwareRolloutConfig{})
+	if conf.CanaryPercentage != defaultCanaryPercentage ||
+		conf.MaxSilentPercentage != defaultMaxSilentPercentage ||
+		conf.SilentTimeout != defaultSilentTimeout ||
+		conf.CheckInterval != defaultRolloutInterval {
+		t.Errorf("defaults are not applied: %+v", conf)
+	}
+	conf = RolloutConfigWithDefaults(gw.FirmwareRolloutConfig{MaxSilentPercentage: 50, SilentTimeout: time.Hour})
+	if conf.MaxSilentPercentage != 50 || conf.SilentTimeout != time.Hour {
+		t.Errorf("configured values are replaced: %+v", conf)
+	}
+}
+
+func TestFirmwareRollout(t *testing.T) {
+	ctx := context.Background()
+	oldHash := types.MD5SUM{1}
+	newHash := types.MD5SUM{2}
+	var macs []lorawan.EUI64
+	for i := byte(1); i <= 10; i++ {
+		macs = append(macs, lorawan.EUI64{i})
+	}
+	canary := lorawan.EUI64{0xca}
+
+	st := newRolloutStore()
+	st.firmware["MX1901"] = gw.GatewayFirmware{Model: "MX1901", ResourceLink: "old", FirmwareHash: oldHash}
+	st.candidates["MX1901"] = macs
+	ctrl := &controller{st: st, rollout: RolloutConfigWithDefaults(gw.FirmwareRolloutConfig{})}
+
+	// a new firmware is staged to 10% of the fleet
+	if err := ctrl.startRolloutForNewFirmware(ctx, st.firmware["MX1901"],
+		gw.GatewayFirmware{Model: "MX1901", ResourceLink: "new", FirmwareHash: newHash}); err != nil {
+		t.Fatal(err)
+	}
+	if len(st.rollouts) != 1 {
+		t.Fatalf("expected a rollout, got %d", len(st.rollouts))
+	}
+	rollout := st.rollouts[0]
+	if rollout.Status != gw.FirmwareRolloutRunning || rollout.PreviousResourceLink != "old" ||
+		rollout.MaxSilentPercentage != defaultMaxSilentPercentage {
+		t.Fatalf("unexpected rollout: %+v", rollout)
+	}
+	if len(st.gateways[rollout.ID]) != 1 || st.gateways[rollout.ID][macs[0]] == nil {
+		t.Fatalf("expected only the first gateway to be selected, got %d", len(st.gateways[rollout.ID]))
+	}
+	if st.firmware["MX1901"].ResourceLink != "old" {
+		t.Fatalf("firmware of the model must not change before the rollout completes")
+	}
+
+	// a second rollout for the model can't be started while this one runs
+	err := CreateFirmwareRollout(ctx, st, &gw.FirmwareRollout{
+		Model: "MX1901", ResourceLink: "newer", FirmwareHash: types.MD5SUM{3},
+		Percentage: 100, MaxSilentPercentage: 10,
+	}, []lorawan.EUI64{canary})
+	if !errors.Is(err, errHandler.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists, got %v", err)
+	}
+
+	// selected gateway gets the new firmware, the others keep the old one
+	selected := &gw.Gateway{MAC: macs[0], Model: "MX1901", FirmwareHash: oldHash}
+	fw, err := firmwareForGateway(ctx, st, selected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fw.ResourceLink != "new" || st.gateways[rollout.ID][macs[0]].Status != gw.FirmwareRolloutGatewayOffered {
+		t.Fatalf("expected the new firmware to be offered, got %+v", fw)
+	}
+	fw, err = firmwareForGateway(ctx, st, &gw.Gateway{MAC: macs[1], Model: "MX1901", FirmwareHash: oldHash})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fw.ResourceLink != "old" {
+		t.Fatalf("expected the old firmware, got %+v", fw)
+	}
+
+	// too many gateways went silent, the rollout is paused
+	st.silent = 1
+	if err := ctrl.checkFirmwareRollouts(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if st.rollouts[0].Status != gw.FirmwareRolloutPaused {
+		t.Fatalf("expected the rollout to be paused, got %s", st.rollouts[0].Status)
+	}
+	// the gateway that got the new firmware is not told to downgrade
+	selected.FirmwareHash = newHash
+	fw, err = firmwareForGateway(ctx, st, selected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fw.ResourceLink != "new" {
+		t.Fatalf("paused rollout must keep the new firmware of the offered gateway, got %+v", fw)
+	}
+	// but the new firmware is not offered to the pending gateways
+	if err := st.AddFirmwareRolloutGateways(ctx, rollout.ID, macs[1:2], false); err != nil {
+		t.Fatal(err)
+	}
+	pending := &gw.Gateway{MAC: macs[1], Model: "MX1901", FirmwareHash: oldHash}
+	fw, err = firmwareForGateway(ctx, st, pending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fw.ResourceLink != "old" || st.gateways[rollout.ID][macs[1]].Status != gw.FirmwareRolloutGatewayPending {
+		t.Fatalf("paused rollout must not offer the new firmware, got %+v", fw)
+	}
+	if err := PauseFirmwareRollout(ctx, st, rollout.ID); !errors.Is(err, ErrFirmwareRolloutState) {
+		t.Fatalf("expected ErrFirmwareRolloutState, got %v", err)
+	}
+
+	// the rollout is resumed and completes once the gateway confirms the
+	// new firmware
+	st.silent = 0
+	if err := ResumeFirmwareRollout(ctx, st, rollout.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := ResumeFirmwareRollout(ctx, st, rollout.ID); !errors.Is(err, ErrFirmwareRolloutState) {
+		t.Fatalf("expected ErrFirmwareRolloutState, got %v", err)
+	}
+	if err := ctrl.checkFirmwareRollouts(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if st.rollouts[0].Status != gw.FirmwareRolloutRunning {
+		t.Fatalf("rollout must keep running until the gateways confirm, got %s", st.rollouts[0].Status)
+	}
+	// the resumed rollout offers the new firmware to the pending gateway
+	fw, err = firmwareForGateway(ctx, st, pending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fw.ResourceLink != "new" || st.gateways[rollout.ID][macs[1]].Status != gw.FirmwareRolloutGatewayOffered {
+		t.Fatalf("expected the new firmware to be offered, got %+v", fw)
+	}
+	for _, g := range []*gw.Gateway{selected, pending} {
+		if err := confirmFirmware(ctx, st, g, newHash); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ctrl.checkFirmwareRollouts(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if st.rollouts[0].Status != gw.FirmwareRolloutCompleted {
+		t.Fatalf("expected the rollout to be completed, got %s", st.rollouts[0].Status)
+	}
+	if st.firmware["MX1901"].ResourceLink != "new" {
+		t.Fatalf("expected the new firmware to be released to the fleet, got %+v", st.firmware["MX1901"])
+	}
+
+	// the completed rollout can be rolled back once
+	if err := RollbackFirmwareRollout(ctx, st, rollout.ID); err != nil {
+		t.Fatal(err)
+	}
+	if st.rollouts[0].Status != gw.FirmwareRolloutRolledBack || st.firmware["MX1901"].ResourceLink != "old" {
+		t.Fatalf("expected the old firmware to be restored, got %+v", st.firmware["MX1901"])
+	}
+	if err := RollbackFirmwareRollout(ctx, st, rollout.ID); !errors.Is(err, ErrFirmwareRolloutState) {
+		t.Fatalf("expected ErrFirmwareRolloutState, got %v", err)
+	}
+}
+
+func TestCreateFirmwareRolloutNoGateways(t *testing.T) {
+	ctx := context.Background()
+	st := newRolloutStore()
+	st.firmware["MX1901"] = gw.GatewayFirmware{Model: "MX1901", ResourceLink: "old", FirmwareHash: types.MD5SUM{1}}
+	ctrl := &controller{st: st, rollout: RolloutConfigWithDefaults(gw.FirmwareRolloutConfig{})}
+
+	// no gateway updates automatically, the firmware is released right away
+	if err := ctrl.startRolloutForNewFirmware(ctx, st.firmware["MX1901"],
+		gw.GatewayFirmware{Model: "MX1901", ResourceLink: "new", FirmwareHash: types.MD5SUM{2}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(st.rollouts) != 0 || st.firmware["MX1901"].ResourceLink != "new" {
+		t.Fatalf("expected the firmware to be updated without a rollout")
+	}
+
+	err := CreateFirmwareRollout(ctx, st, &gw.FirmwareRollout{
+		Model: "MX1901", ResourceLink: "newer", FirmwareHash: types.MD5SUM{3}, Percentage: 50,
+	}, nil)
+	if err == nil {
+		t.Fatalf("expected invalid max silent percentage error")
+	}
+}
+
+func TestFirmwareRolloutUnconfirmed(t *testing.T) {
+	ctx := context.Background()
+	newHash := types.MD5SUM{2}
+	macs := []lorawan.EUI64{{1}, {2}}
+
+	st := newRolloutStore()
+	st.firmware["MX1901"] = gw.GatewayFirmware{Model: "MX1901", ResourceLink: "old", FirmwareHash: types.MD5SUM{1}}
+	ctrl := &controller{st: st, rollout: RolloutConfigWithDefaults(gw.FirmwareRolloutConfig{})}
+	rollout := gw.FirmwareRollout{
+		Model: "MX1901", ResourceLink: "new", FirmwareHash: newHash, Percentage: 100, MaxSilentPercentage: 10,
+	}
+	if err := CreateFirmwareRollout(ctx, st, &rollout, macs); err != nil {
+		t.Fatal(err)
+	}
+	gateways := []*gw.Gateway{
+		{MAC: macs[0], Model: "MX1901"},
+		{MAC: macs[1], Model: "MX1901"},
+	}
+	for _, g := range gateways {
+		if _, err := firmwareForGateway(ctx, st, g); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := confirmFirmware(ctx, st, gateways[0], newHash); err != nil {
+		t.Fatal(err)
+	}
+
+	// the second gateway doesn't report the firmware hash
+	if err := ctrl.checkFirmwareRollouts(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if st.rollouts[0].Status != gw.FirmwareRolloutRunning {
+		t.Fatalf("rollout must keep running until the timeout, got %s", st.rollouts[0].Status)
+	}
+	// it keeps sending heartbeats after the timeout, so it doesn't block the
+	// rollout anymore
+	st.unconfirmed = map[lorawan.EUI64]bool{macs[1]: true}
+	if err := ctrl.checkFirmwareRollouts(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if st.gateways[rollout.ID][macs[1]].Status != gw.FirmwareRolloutGatewayUnconfirmed {
+		t.Fatalf("expected the gateway to be unconfirmed, got %s", st.gateways[rollout.ID][macs[1]].Status)
+	}
+	if st.rollouts[0].Status != gw.FirmwareRolloutCompleted || st.firmware["MX1901"].ResourceLink != "new" {
+		t.Fatalf("expected the rollout to be completed, got %s", st.rollouts[0].Status)
+	}
+}
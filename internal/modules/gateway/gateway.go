@@ -2,6 +2,8 @@ package gateway
 
 import (
 	"context"
+	/* #nosec */
+	"crypto/md5"
 	"fmt"
 	"net"
 	"strings"
@@ -30,6 +32,7 @@ import (
 	org "github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
 	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
 	"github.com/mxc-foundation/lpwan-app-server/internal/logging"
 	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
 	"github.com/mxc-foundation/lpwan-app-server/internal/mxpcli"
@@ -54,12 +57,12 @@ type controller struct {
 	psCli      psPb.ProvisionClient
 	serverAddr string
 	st         Store
+	rollout    gw.FirmwareRolloutConfig
 }
 
 // Store defines db API used by gateway server
 type Store interface {
 	GetGatewayFirmwareList(ctx context.Context) (list []gw.GatewayFirmware, err error)
-	UpdateGatewayFirmware(ctx context.Context, gwFw *gw.GatewayFirmware) (model string, err error)
 	GetOrganization(ctx context.Context, id int64, forUpdate bool) (org.Organization, error)
 	GetGatewayCount(ctx context.Context, filters gw.GatewayFilters) (int, error)
 	CreateGateway(ctx context.Context, gw *gw.Gateway) error
@@ -68,7 +71,6 @@ type Store interface {
 	DeleteGateway(ctx context.Context, mac lorawan.EUI64) error
 	GetGateway(ctx context.Context, mac lorawan.EUI64, forUpdate bool) (gw.Gateway, error)
 
-	GetGatewayFirmware(ctx context.Context, model string, forUpdate bool) (gwFw gw.GatewayFirmware, err error)
 	UpdateGatewayAttributes(ctx context.Context, mac lorawan.EUI64, firmware types.MD5SUM,
 		osVersion, statistics string) error
 	UpdateGatewayHeartbeat(ctx context.Context, mac lorawan.EUI64, firstHeartBeat, lastHeartBeat int64) error
@@ -76,6 +78,10 @@ type Store interface {
 	Tx(ctx context.Context, f func(context.Context, *pgstore.PgStore) error) error
 
 	InTx() bool
+
+	RolloutStore
+	ConfigVersionStore
+	PingStore
 }
 
 func extractPort(bindStr string) (string, error) {
@@ -128,10 +134,12 @@ func Start(st Store, ServerAddr string, psCli *pscli.Client,
 		bindNew: conf.NewGateway.Bind,
 		psCli:   psCli.GetPServerClient(),
 		st:      st,
+		rollout: RolloutConfigWithDefaults(conf.FirmwareRollout),
 	}
 	if err := ctrl.scheduleUpdateFirmwareFromProvisioningServer(context.Background(), updateSchedule); err != nil {
 		return nil, nil, err
 	}
+	go ctrl.firmwareRolloutLoop(context.Background())
 
 	return old, new, nil
 }
@@ -170,13 +178,15 @@ func (s *Server) listenWithCredentials(service, bind, caCert, tlsCert, tlsKey st
 }
 
 // UpdateFirmware respond to manual operation to update gateway firmware from provisioining server
-func UpdateFirmware(ctx context.Context, st Store, bindOldGateway, bindNewGateway, serverAddr string, psCli psPb.ProvisionClient) error {
+func UpdateFirmware(ctx context.Context, st Store, bindOldGateway, bindNewGateway, serverAddr string, psCli psPb.ProvisionClient,
+	rolloutConf gw.FirmwareRolloutConfig) error {
 	ctrl := &controller{
 		bindOld:    bindOldGateway,
 		bindNew:    bindNewGateway,
 		psCli:      psCli,
 		serverAddr: serverAddr,
 		st:         st,
+		rollout:    RolloutConfigWithDefaults(rolloutConf),
 	}
 	return ctrl.updateFirmwareFromProvisioningServer(ctx)
 }
@@ -223,21 +233,28 @@ func (c *controller) updateFirmwareFromProvisioningServer(ctx context.Context) e
 			FirmwareHash: md5sum,
 		}
 
-		model, _ := c.st.UpdateGatewayFirmware(ctx, &gatewayFw)
-		if model == "" {
-			log.Warnf("No row updated for gateway_firmware at model=%s", v.Model)
+		if err := c.startRolloutForNewFirmware(ctx, v, gatewayFw); err != nil {
+			log.WithError(err).Errorf("Failed to update firmware for gateway model: %s", v.Model)
 		}
-
 	}
 
 	return nil
 }
 
+// leader election jobs
+const (
+	firmwareUpdateJob  = "gateway-firmware-update"
+	firmwareRolloutJob = "gateway-firmware-rollout"
+)
+
 func (c *controller) scheduleUpdateFirmwareFromProvisioningServer(ctx context.Context, updateSchedule string) error {
 	log.Info("Start schedule to update gateway firmware...")
 
 	cron := cron.New()
 	err := cron.AddFunc(updateSchedule, func() {
+		if !leader.IsLeader(firmwareUpdateJob) {
+			return
+		}
 		if err := c.updateFirmwareFromProvisioningServer(ctx); err != nil {
 			log.WithError(err).Error("update firmware on schdule error")
 		}
@@ -310,6 +327,19 @@ func AddGateway(ctx context.Context, st Store, gateway *gw.Gateway, createReq ns
 		return helpers.ErrToRPCError(err)
 	}
 
+	if gateway.Config != "" {
+		err = st.AddGatewayConfigVersion(ctx, &gw.GatewayConfigVersion{
+			GatewayMAC: gateway.MAC,
+			Config:     gateway.Config,
+			/* #nosec */
+			ConfigHash: md5.Sum([]byte(gateway.Config)),
+			Reason:     "initial version",
+		})
+		if err != nil {
+			return helpers.ErrToRPCError(err)
+		}
+	}
+
 	return nil
 }
 
@@ -138,11 +138,24 @@ func (a *HeartbeatAPI) checkStatusAndFirmwareUpdate(ctx context.Context, gateway
 
 	if !bytes.Equal(configHash[:], b[:]) {
 		response.Config = gateway.Config
+	} else if err := a.st.AcknowledgeGatewayConfigVersion(ctx, gateway.MAC, b); err != nil {
+		logrus.WithError(err).Errorf("Failed to acknowledge config for gateway: %s", gateway.MAC)
+	}
+
+	// gateways that report the hash of their firmware confirm the firmware
+	// rollout
+	if req.FirmwareHash != "" {
+		var reported types.MD5SUM
+		if err := reported.UnmarshalText([]byte(req.FirmwareHash)); err != nil {
+			logrus.WithError(err).Errorf("Failed to unmarshal firmware hash: %s", req.FirmwareHash)
+		} else if err := confirmFirmware(ctx, a.st, gateway, reported); err != nil {
+			logrus.WithError(err).Errorf("Failed to confirm firmware for gateway: %s", gateway.MAC)
+		}
 	}
 
 	// check if firmware updated
 	if gateway.AutoUpdateFirmware {
-		firmware, err := a.st.GetGatewayFirmware(ctx, gateway.Model, false)
+		firmware, err := firmwareForGateway(ctx, a.st, gateway)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to get firmware information for model: %s", gateway.Model)
 		}
@@ -157,6 +170,10 @@ func (a *HeartbeatAPI) checkStatusAndFirmwareUpdate(ctx context.Context, gateway
 	updatedGateway.osVersion = req.OsVersion
 	updatedGateway.statistics = req.Statistics
 
+	if err := saveHeartbeatStatistics(ctx, gateway.MAC, req.Statistics); err != nil {
+		logrus.WithError(err).Warnf("Failed to save heartbeat statistics for gateway: %s", gateway.MAC)
+	}
+
 	if !bytes.Equal(updatedGateway.firmwareHash[:], gateway.FirmwareHash[:]) ||
 		updatedGateway.osVersion != gateway.OsVersion ||
 		updatedGateway.statistics != gateway.Statistics {
@@ -0,0 +1,167 @@
+package gateway
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/pkg/errors"
+
+	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+)
+
+// Ping matrix periods
+const (
+	PingPeriodHour = "hour"
+	PingPeriodDay  = "day"
+)
+
+// PingStore defines the db APIs used by the ping matrix
+type PingStore interface {
+	GetGatewayPingLinkStats(ctx context.Context, organizationID int64, start, end time.Time,
+		period string) ([]gw.GatewayPingLinkStats, error)
+	GetGatewayPingSentStats(ctx context.Context, organizationID int64, start, end time.Time,
+		period string) ([]gw.GatewayPingSentStats, error)
+}
+
+// PingLinkPoint holds the quality of the link between two gateways during
+// one period
+type PingLinkPoint struct {
+	Period   time.Time
+	Sent     int
+	Received int
+	AvgRSSI  float64
+	AvgSNR   float64
+	BestRSSI int
+}
+
+// PingLink holds the quality of the link between the gateway sending the
+// pings and the gateway receiving them
+type PingLink struct {
+	SenderMAC   lorawan.EUI64
+	ReceiverMAC lorawan.EUI64
+	Sent        int
+	Received    int
+	AvgRSSI     float64
+	AvgSNR      float64
+	// RSSIChange is the difference between the average RSSI of the last and
+	// of the first periods in which the pings were received, a negative
+	// value means that the reception degrades
+	RSSIChange float64
+	Trend      []PingLinkPoint
+}
+
+// GetPingMatrix returns for every pair of gateways of the organization that
+// heard each other between start and end the quality of the link aggregated
+// by period
+func GetPingMatrix(ctx context.Context, st PingStore, organizationID int64, start, end time.Time,
+	period string) ([]PingLink, error) {
+	if period != PingPeriodHour && period != PingPeriodDay {
+		return nil, errors.Errorf("invalid period: %s", period)
+	}
+	sent, err := st.GetGatewayPingSentStats(ctx, organizationID, start, end, period)
+	if err != nil {
+		return nil, errors.Wrap(err, "get sent pings error")
+	}
+	received, err := st.GetGatewayPingLinkStats(ctx, organizationID, start, end, period)
+	if err != nil {
+		return nil, errors.Wrap(err, "get received pings error")
+	}
+	return BuildPingMatrix(sent, received), nil
+}
+
+type pingSentKey struct {
+	mac    lorawan.EUI64
+	period int64
+}
+
+type pingLinkKey struct {
+	sender   lorawan.EUI64
+	receiver lorawan.EUI64
+}
+
+// BuildPingMatrix combines the number of sent pings with the reception
+// statistics into the list of links between gateways. The points of every
+// link are ordered by period and include the periods in which the sender
+// has sent pings that were not received.
+func BuildPingMatrix(sent []gw.GatewayPingSentStats, received []gw.GatewayPingLinkStats) []PingLink {
+	sentByPeriod := make(map[pingSentKey]int)
+	periods := make(map[lorawan.EUI64][]time.Time)
+	for _, s := range sent {
+		sentByPeriod[pingSentKey{mac: s.GatewayMAC, period: s.Period.Unix()}] += s.Sent
+		periods[s.GatewayMAC] = append(periods[s.GatewayMAC], s.Period)
+	}
+
+	var keys []pingLinkKey
+	points := make(map[pingLinkKey]map[int64]PingLinkPoint)
+	for _, r := range received {
+		k := pingLinkKey{sender: r.SenderMAC, receiver: r.ReceiverMAC}
+		if points[k] == nil {
+			points[k] = make(map[int64]PingLinkPoint)
+			keys = append(keys, k)
+		}
+		points[k][r.Period.Unix()] = PingLinkPoint{
+			Period:   r.Period,
+			Received: r.Received,
+			AvgRSSI:  r.AvgRSSI,
+			AvgSNR:   r.AvgSNR,
+			BestRSSI: r.BestRSSI,
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].sender != keys[j].sender {
+			return keys[i].sender.String() < keys[j].sender.String()
+		}
+		return keys[i].receiver.String() < keys[j].receiver.String()
+	})
+
+	links := make([]PingLink, 0, len(keys))
+	for _, k := range keys {
+		byPeriod := points[k]
+		// periods in which the sender has sent pings nobody heard
+		for _, p := range periods[k.sender] {
+			if _, ok := byPeriod[p.Unix()]; !ok {
+				byPeriod[p.Unix()] = PingLinkPoint{Period: p}
+			}
+		}
+
+		link := PingLink{SenderMAC: k.sender, ReceiverMAC: k.receiver}
+		var rssiSum, snrSum float64
+		var first, last *PingLinkPoint
+		for _, pt := range byPeriod {
+			pt.Sent = sentByPeriod[pingSentKey{mac: k.sender, period: pt.Period.Unix()}]
+			if pt.Sent < pt.Received {
+				pt.Sent = pt.Received
+			}
+			link.Sent += pt.Sent
+			link.Received += pt.Received
+			rssiSum += pt.AvgRSSI * float64(pt.Received)
+			snrSum += pt.AvgSNR * float64(pt.Received)
+			link.Trend = append(link.Trend, pt)
+		}
+		sort.Slice(link.Trend, func(i, j int) bool {
+			return link.Trend[i].Period.Before(link.Trend[j].Period)
+		})
+		for i := range link.Trend {
+			if link.Trend[i].Received == 0 {
+				continue
+			}
+			if first == nil {
+				first = &link.Trend[i]
+			}
+			last = &link.Trend[i]
+		}
+		if link.Received > 0 {
+			link.AvgRSSI = rssiSum / float64(link.Received)
+			link.AvgSNR = snrSum / float64(link.Received)
+		}
+		if first != nil {
+			link.RSSIChange = last.AvgRSSI - first.AvgRSSI
+		}
+		links = append(links, link)
+	}
+
+	return links
+}
@@ -0,0 +1,65 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brocaar/lorawan"
+
+	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+)
+
+func TestBuildPingMatrix(t *testing.T) {
+	a := lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}
+	b := lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+	t2 := t1.Add(time.Hour)
+
+	sent := []gw.GatewayPingSentStats{
+		{GatewayMAC: a, Period: t0, Sent: 2},
+		{GatewayMAC: a, Period: t1, Sent: 2},
+		{GatewayMAC: a, Period: t2, Sent: 2},
+		{GatewayMAC: b, Period: t0, Sent: 1},
+	}
+	received := []gw.GatewayPingLinkStats{
+		{SenderMAC: b, ReceiverMAC: a, Period: t0, Received: 1, AvgRSSI: -90, AvgSNR: 5, BestRSSI: -90},
+		{SenderMAC: a, ReceiverMAC: b, Period: t2, Received: 1, AvgRSSI: -110, AvgSNR: -2, BestRSSI: -110},
+		{SenderMAC: a, ReceiverMAC: b, Period: t0, Received: 2, AvgRSSI: -80, AvgSNR: 8, BestRSSI: -78},
+	}
+
+	links := BuildPingMatrix(sent, received)
+	if len(links) != 2 {
+		t.Fatalf("expected 2 links, got %d", len(links))
+	}
+
+	ab := links[0]
+	if ab.SenderMAC != a || ab.ReceiverMAC != b {
+		t.Fatalf("unexpected first link %s -> %s", ab.SenderMAC, ab.ReceiverMAC)
+	}
+	if ab.Sent != 6 || ab.Received != 3 {
+		t.Errorf("expected 6 sent and 3 received, got %d and %d", ab.Sent, ab.Received)
+	}
+	if ab.AvgRSSI != -90 {
+		t.Errorf("expected average rssi -90, got %f", ab.AvgRSSI)
+	}
+	if ab.RSSIChange != -30 {
+		t.Errorf("expected rssi change -30, got %f", ab.RSSIChange)
+	}
+	if len(ab.Trend) != 3 {
+		t.Fatalf("expected 3 points, got %d", len(ab.Trend))
+	}
+	for i, period := range []time.Time{t0, t1, t2} {
+		if !ab.Trend[i].Period.Equal(period) {
+			t.Errorf("point %d: expected period %s, got %s", i, period, ab.Trend[i].Period)
+		}
+	}
+	if ab.Trend[1].Sent != 2 || ab.Trend[1].Received != 0 {
+		t.Errorf("expected unheard period, got %+v", ab.Trend[1])
+	}
+
+	ba := links[1]
+	if ba.Sent != 1 || ba.Received != 1 || ba.RSSIChange != 0 {
+		t.Errorf("unexpected second link %+v", ba)
+	}
+}
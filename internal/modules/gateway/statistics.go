@@ -0,0 +1,175 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brocaar/lorawan"
+
+	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+	metricsmod "github.com/mxc-foundation/lpwan-app-server/internal/modules/metrics"
+)
+
+// Names of the metrics saved from heartbeat statistics. Gauges are saved as
+// sums together with the number of samples, so that averages can be
+// calculated for any aggregation interval.
+const (
+	MetricHeartbeats  = "hb_count"
+	MetricCPULoad     = "cpu_load"
+	MetricMemoryUsage = "memory_usage"
+	MetricTemperature = "temperature"
+	MetricUptime      = "uptime"
+
+	metricSamplesSuffix  = "_samples"
+	metricBackhaulPrefix = "backhaul_"
+	backhaulOther        = "other"
+)
+
+// numericStatistics are the fields of the heartbeat statistics that are
+// parsed as numbers
+var numericStatistics = map[string]bool{
+	"cpu_load":     true,
+	"memory_usage": true,
+	"memory_used":  true,
+	"memory_total": true,
+	"temperature":  true,
+	"uptime":       true,
+}
+
+// ParseHeartbeatStatistics parses statistics string sent by the gateway in
+// heartbeat. The statistics are expected to be a JSON object, numbers can be
+// sent either as JSON numbers or as strings. Unknown fields are ignored.
+func ParseHeartbeatStatistics(statistics string) (gw.HeartbeatStatistics, error) {
+	var res gw.HeartbeatStatistics
+	if strings.TrimSpace(statistics) == "" {
+		return res, nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(statistics), &fields); err != nil {
+		return res, fmt.Errorf("invalid statistics: %v", err)
+	}
+
+	numbers := make(map[string]float64)
+	for k, v := range fields {
+		k = strings.ToLower(k)
+		if k == "backhaul" {
+			if val, ok := v.(string); ok {
+				res.Backhaul = normalizeBackhaul(val)
+			}
+			continue
+		}
+		if !numericStatistics[k] {
+			continue
+		}
+		switch val := v.(type) {
+		case float64:
+			numbers[k] = val
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(val, "%")), 64)
+			if err != nil {
+				return res, fmt.Errorf("statistics field %s: %v", k, err)
+			}
+			numbers[k] = f
+		}
+	}
+
+	if v, ok := numbers["cpu_load"]; ok {
+		res.CPULoad = &v
+	}
+	if v, ok := numbers["memory_usage"]; ok {
+		res.MemoryUsage = &v
+	} else if used, ok := numbers["memory_used"]; ok {
+		if total := numbers["memory_total"]; total > 0 {
+			v := used * 100 / total
+			res.MemoryUsage = &v
+		}
+	}
+	if v, ok := numbers["temperature"]; ok {
+		res.Temperature = &v
+	}
+	if v, ok := numbers["uptime"]; ok {
+		res.Uptime = &v
+	}
+	return res, nil
+}
+
+func normalizeBackhaul(backhaul string) string {
+	switch strings.ToLower(strings.TrimSpace(backhaul)) {
+	case "":
+		return ""
+	case "ethernet", "eth", "lan":
+		return gw.BackhaulEthernet
+	case "wifi", "wi-fi", "wlan":
+		return gw.BackhaulWiFi
+	case "cellular", "lte", "4g", "3g", "5g":
+		return gw.BackhaulCellular
+	default:
+		return backhaulOther
+	}
+}
+
+// heartbeatMetrics converts the statistics into metrics record
+func heartbeatMetrics(ts time.Time, stats gw.HeartbeatStatistics) metricsmod.MetricsRecord {
+	record := metricsmod.MetricsRecord{
+		Time: ts,
+		Metrics: map[string]float64{
+			MetricHeartbeats: 1,
+		},
+	}
+	gauges := map[string]*float64{
+		MetricCPULoad:     stats.CPULoad,
+		MetricMemoryUsage: stats.MemoryUsage,
+		MetricTemperature: stats.Temperature,
+		MetricUptime:      stats.Uptime,
+	}
+	for name, v := range gauges {
+		if v == nil {
+			continue
+		}
+		record.Metrics[name] = *v
+		record.Metrics[name+metricSamplesSuffix] = 1
+	}
+	if stats.Backhaul != "" {
+		record.Metrics[metricBackhaulPrefix+stats.Backhaul] = 1
+	}
+	return record
+}
+
+// saveHeartbeatStatistics parses statistics and saves them as the gateway
+// metrics. Heartbeats without statistics are not saved.
+func saveHeartbeatStatistics(ctx context.Context, mac lorawan.EUI64, statistics string) error {
+	stats, err := ParseHeartbeatStatistics(statistics)
+	if err != nil || stats.IsEmpty() {
+		return err
+	}
+	return metricsmod.SaveMetrics(ctx, "gw:"+mac.String(), heartbeatMetrics(time.Now(), stats))
+}
+
+// AverageMetric returns the average of the gauge saved from the heartbeat
+// statistics, 0 if there were no samples
+func AverageMetric(metrics map[string]float64, name string) float64 {
+	samples := metrics[name+metricSamplesSuffix]
+	if samples == 0 {
+		return 0
+	}
+	return metrics[name] / samples
+}
+
+// PrevalentBackhaul returns the backhaul type reported in most of the
+// heartbeats
+func PrevalentBackhaul(metrics map[string]float64) string {
+	var backhaul string
+	var max float64
+	for _, b := range []string{gw.BackhaulEthernet, gw.BackhaulWiFi, gw.BackhaulCellular, backhaulOther} {
+		if v := metrics[metricBackhaulPrefix+b]; v > max {
+			backhaul = b
+			max = v
+		}
+	}
+	return backhaul
+}
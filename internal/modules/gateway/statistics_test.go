@@ -0,0 +1,138 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	gw "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+)
+
+func ptr(f float64) *float64 {
+	return &f
+}
+
+func equalFloatPtr(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func TestParseHeartbeatStatistics(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		statistics string
+		expected   gw.HeartbeatStatistics
+		err        bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:       "numbers",
+			statistics: `{"cpu_load": 12.5, "memory_usage": 40, "temperature": 51.2, "uptime": 3600, "backhaul": "eth"}`,
+			expected: gw.HeartbeatStatistics{
+				CPULoad:     ptr(12.5),
+				MemoryUsage: ptr(40),
+				Temperature: ptr(51.2),
+				Uptime:      ptr(3600),
+				Backhaul:    gw.BackhaulEthernet,
+			},
+		},
+		{
+			name:       "strings and memory totals",
+			statistics: `{"CPU_LOAD": "7%", "memory_used": 64, "memory_total": 256, "backhaul": "LTE", "foo": true}`,
+			expected: gw.HeartbeatStatistics{
+				CPULoad:     ptr(7),
+				MemoryUsage: ptr(25),
+				Backhaul:    gw.BackhaulCellular,
+			},
+		},
+		{
+			name:       "unknown string fields",
+			statistics: `{"cpu_load": 5, "firmware": "v1.2.3", "model": "MX1901", "backhaul": 1}`,
+			expected: gw.HeartbeatStatistics{
+				CPULoad: ptr(5),
+			},
+		},
+		{
+			name:       "not json",
+			statistics: "cpu=10",
+			err:        true,
+		},
+		{
+			name:       "bad number",
+			statistics: `{"temperature": "hot"}`,
+			err:        true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			stats, err := ParseHeartbeatStatistics(tc.statistics)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", stats)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalFloatPtr(stats.CPULoad, tc.expected.CPULoad) ||
+				!equalFloatPtr(stats.MemoryUsage, tc.expected.MemoryUsage) ||
+				!equalFloatPtr(stats.Temperature, tc.expected.Temperature) ||
+				!equalFloatPtr(stats.Uptime, tc.expected.Uptime) ||
+				stats.Backhaul != tc.expected.Backhaul {
+				t.Errorf("unexpected statistics: %+v", stats)
+			}
+		})
+	}
+}
+
+func TestHeartbeatMetricsAggregation(t *testing.T) {
+	// emulate two heartbeats aggregated into the same interval
+	total := make(map[string]float64)
+	for _, stats := range []gw.HeartbeatStatistics{
+		{CPULoad: ptr(10), Backhaul: gw.BackhaulWiFi},
+		{CPULoad: ptr(30), Temperature: ptr(40), Backhaul: gw.BackhaulEthernet},
+		{Backhaul: gw.BackhaulWiFi},
+	} {
+		for k, v := range heartbeatMetrics(time.Now(), stats).Metrics {
+			total[k] += v
+		}
+	}
+
+	if total[MetricHeartbeats] != 3 {
+		t.Errorf("expected 3 heartbeats, got %v", total[MetricHeartbeats])
+	}
+	if avg := AverageMetric(total, MetricCPULoad); avg != 20 {
+		t.Errorf("expected average cpu load 20, got %v", avg)
+	}
+	if avg := AverageMetric(total, MetricTemperature); avg != 40 {
+		t.Errorf("expected average temperature 40, got %v", avg)
+	}
+	if avg := AverageMetric(total, MetricUptime); avg != 0 {
+		t.Errorf("expected no uptime, got %v", avg)
+	}
+	if b := PrevalentBackhaul(total); b != gw.BackhaulWiFi {
+		t.Errorf("expected wifi backhaul, got %s", b)
+	}
+}
+
+func TestHeartbeatStatisticsIsEmpty(t *testing.T) {
+	for statistics, empty := range map[string]bool{
+		"":                         true,
+		`{"firmware": "v1.2.3"}`:   true,
+		`{"backhaul": "wifi"}`:     false,
+		`{"uptime": "3600"}`:       false,
+		`{"temperature": null}`:    true,
+		`{"cpu_load": 0, "x": {}}`: false,
+	} {
+		stats, err := ParseHeartbeatStatistics(statistics)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats.IsEmpty() != empty {
+			t.Errorf("%s: expected empty %v, got %+v", statistics, empty, stats)
+		}
+	}
+}
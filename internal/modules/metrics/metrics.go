@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/brocaar/lorawan"
 	"github.com/go-redis/redis/v7"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -35,7 +36,8 @@ const (
 	AggregationDay    AggregationInterval = "DAY"
 	AggregationMonth  AggregationInterval = "MONTH"
 
-	metricsKeyTempl = "lora:as:metrics:{%s}:%s:%d" // metrics key (identifier | aggregation | timestamp)
+	metricsKeyTempl  = "lora:as:metrics:{%s}:%s:%d" // metrics key (identifier | aggregation | timestamp)
+	metricsKeysTempl = "lora:as:metrics:{%s}:*"     // all the metrics keys of the identifier
 )
 
 // MetricsRecord holds a single metrics record.
@@ -283,3 +285,36 @@ func GetMetrics(ctx context.Context, agg AggregationInterval, name string, start
 
 	return out, nil
 }
+
+// DeleteMetrics removes the metrics of all the aggregation intervals for the
+// given name, or only counts them if dryRun is true. Returns the number of
+// the removed records.
+func DeleteMetrics(ctx context.Context, name string, dryRun bool) (int64, error) {
+	keys, err := rs.RedisClient().Keys(fmt.Sprintf(metricsKeysTempl, name)).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "keys error")
+	}
+	if dryRun || len(keys) == 0 {
+		return int64(len(keys)), nil
+	}
+	n, err := rs.RedisClient().Del(keys...).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "del error")
+	}
+
+	log.WithFields(log.Fields{
+		"name":   name,
+		"ctx_id": ctx.Value(logging.ContextIDKey),
+	}).Info("metrics deleted")
+
+	return n, nil
+}
+
+// GatewayMetricsStore gives access to the metrics of the gateways
+type GatewayMetricsStore struct{}
+
+// DeleteGatewayMetrics removes the metrics of the gateway, or only counts
+// them if dryRun is true
+func (GatewayMetricsStore) DeleteGatewayMetrics(ctx context.Context, mac lorawan.EUI64, dryRun bool) (int64, error) {
+	return DeleteMetrics(ctx, "gw:"+mac.String(), dryRun)
+}
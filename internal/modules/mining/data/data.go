@@ -1,6 +1,10 @@
 package data
 
-import "github.com/brocaar/lorawan"
+import (
+	"time"
+
+	"github.com/brocaar/lorawan"
+)
 
 // Config contains mining configuration
 type Config struct {
@@ -13,10 +17,36 @@ type Config struct {
 	GwOnlineLimit int64 `mapstructure:"gw_online_limit"`
 	// Period is the length of the mining period in seconds
 	Period int64 `mapstructure:"period"`
+	// SubmissionMaxAttempts is how many times the submission of the mining
+	// period to m2m is tried before it's marked as failed
+	SubmissionMaxAttempts int `mapstructure:"submission_max_attempts"`
 }
 
 type GatewayMining struct {
-	GatewayMac lorawan.EUI64 `db:"mac"`
-	OwnerOrgID int64         `db:"organization_id"`
-	StcOrgID   *int64        `db:"stc_org_id"`
+	GatewayMac lorawan.EUI64 `db:"mac" json:"gatewayMac"`
+	OwnerOrgID int64         `db:"organization_id" json:"ownerOrgID"`
+	StcOrgID   *int64        `db:"stc_org_id" json:"stcOrgID,omitempty"`
+}
+
+// Mining submission statuses
+const (
+	SubmissionPending = "pending"
+	SubmissionSent    = "sent"
+	SubmissionFailed  = "failed"
+)
+
+// Submission is the mining of one period that is to be sent to m2m
+type Submission struct {
+	ID             int64           `db:"id"`
+	IdempotencyKey string          `db:"idempotency_key"`
+	PeriodStart    time.Time       `db:"period_start"`
+	PeriodSeconds  int64           `db:"period_seconds"`
+	Gateways       []GatewayMining `db:"-"`
+	Status         string          `db:"status"`
+	Attempts       int             `db:"attempts"`
+	LastError      string          `db:"last_error"`
+	NextAttemptAt  time.Time       `db:"next_attempt_at"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+	SentAt         *time.Time      `db:"sent_at"`
 }
@@ -2,11 +2,14 @@ package mining
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/mxc-foundation/lpwan-app-server/internal/mxpcli"
 	mgr "github.com/mxc-foundation/lpwan-app-server/internal/system_manager"
 
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
+
 	log "github.com/sirupsen/logrus"
 
 	api "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
@@ -23,6 +26,15 @@ func init() {
 
 const moduleName = "mining"
 
+// submissionRetryInterval defines how often the pending mining submissions
+// are sent to m2m
+const submissionRetryInterval = time.Minute
+
+// maximum delay between the attempts to send the mining submission
+const maxSubmissionBackoff = time.Hour
+
+const defaultSubmissionMaxAttempts = 48
+
 // controller regularly checks what gateways should be paid for mining and
 // sends request to m2m to pay them
 type controller struct {
@@ -30,6 +42,9 @@ type controller struct {
 	m2mClient api.MiningServiceClient
 	st        *store.Handler
 	enableSTC bool
+	// id of the application server, used to make idempotency keys unique
+	// across supernodes
+	serverID string
 
 	moduleUp bool
 }
@@ -42,6 +57,10 @@ func SettingsSetup(name string, s config.Config) error {
 	ctrl = &controller{
 		s:         s.ApplicationServer.MiningSetUp,
 		enableSTC: s.General.EnableSTC,
+		serverID:  s.ApplicationServer.ID,
+	}
+	if ctrl.s.SubmissionMaxAttempts <= 0 {
+		ctrl.s.SubmissionMaxAttempts = defaultSubmissionMaxAttempts
 	}
 	return nil
 }
@@ -66,74 +85,174 @@ func Setup(name string, h *store.Handler) (err error) {
 	ctrl.st = h
 	ctrl.m2mClient = mxpcli.Global.GetMiningServiceClient()
 
-	go func() {
-		period := time.Duration(ctrl.s.Period) * time.Second
-		for {
-			nextRun := time.Now().Add(period).Truncate(period)
-			time.Sleep(time.Until(nextRun))
-			if err := ctrl.submitMining(context.Background()); err != nil {
+	go ctrl.run()
+
+	return nil
+}
+
+// run creates the mining submission at the end of every mining period and
+// sends the pending submissions to m2m, including the ones left over from
+// before the restart
+func (ctrl *controller) run() {
+	period := time.Duration(ctrl.s.Period) * time.Second
+	nextRun := time.Now().Add(period).Truncate(period)
+	retry := time.NewTicker(submissionRetryInterval)
+	defer retry.Stop()
+	for {
+		select {
+		case <-time.After(time.Until(nextRun)):
+			periodStart := nextRun.Add(-period)
+			nextRun = nextRun.Add(period)
+			if !leader.IsLeader(moduleName) {
+				continue
+			}
+			if err := ctrl.submitMining(context.Background(), periodStart); err != nil {
 				log.WithError(err).Error("couldn't submit mining")
 			}
+		case <-retry.C:
+			if !leader.IsLeader(moduleName) {
+				continue
+			}
 		}
-	}()
+		err := deliverSubmissions(context.Background(), ctrl.st, ctrl.m2mClient, ctrl.s.SubmissionMaxAttempts, time.Now())
+		if err != nil {
+			log.WithError(err).Error("couldn't send mining submissions")
+		}
+	}
+}
 
-	return nil
+// idempotencyKey returns the key that identifies the mining of the period
+func (ctrl *controller) idempotencyKey(periodStart time.Time) string {
+	return fmt.Sprintf("%s:%d", ctrl.serverID, periodStart.Unix())
 }
 
-func (ctrl *controller) submitMining(ctx context.Context) error {
+// submitMining stores the mining of the period as the pending submission,
+// the first heartbeats of the gateways are reset in the same transaction so
+// the mining is neither lost nor paid twice if the server restarts
+func (ctrl *controller) submitMining(ctx context.Context, periodStart time.Time) error {
 	current_time := time.Now().Unix()
 	log.Infof("processing mining")
 
-	// get the gateway list that should receive the mining tokens
-	miningGws, err := ctrl.st.GetGatewayMiningList(
-		ctx, current_time, ctrl.s.GwOnlineLimit,
-	)
+	return ctrl.st.Tx(ctx, func(ctx context.Context, handler *store.Handler) error {
+		// get the gateway list that should receive the mining tokens
+		miningGws, err := handler.GetGatewayMiningList(
+			ctx, current_time, ctrl.s.GwOnlineLimit,
+		)
+		if err != nil {
+			log.WithError(err).Error("Cannot get mining gateway list from DB.")
+			return err
+		}
+
+		if len(miningGws) == 0 {
+			return nil
+		}
+
+		submission := Submission{
+			IdempotencyKey: ctrl.idempotencyKey(periodStart),
+			PeriodStart:    periodStart,
+			PeriodSeconds:  ctrl.s.Period,
+			Status:         SubmissionPending,
+			NextAttemptAt:  time.Now(),
+		}
+		for _, v := range miningGws {
+			if v.StcOrgID != nil && !ctrl.enableSTC {
+				v.StcOrgID = nil
+			}
+			submission.Gateways = append(submission.Gateways, v)
+		}
+		created, err := handler.CreateMiningSubmission(ctx, &submission)
+		if err != nil {
+			return fmt.Errorf("create mining submission: %w", err)
+		}
+		if !created {
+			log.Warnf("mining for the period %s has been submitted already", periodStart)
+			return nil
+		}
+
+		// update the first heartbeat = 0
+		for _, v := range miningGws {
+			if err := handler.UpdateFirstHeartbeatToZero(ctx, v.GatewayMac); err != nil {
+				return fmt.Errorf("update first heartbeat to zero: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// SubmissionStore defines db APIs used to send the mining submissions
+type SubmissionStore interface {
+	GetDueMiningSubmissions(ctx context.Context, now time.Time, limit int) ([]Submission, error)
+	UpdateMiningSubmission(ctx context.Context, s *Submission) error
+}
+
+// deliverSubmissions sends the pending mining submissions that are due to
+// m2m. If sending fails the submission is retried later with exponential
+// backoff, after maxAttempts the submission is marked as failed.
+func deliverSubmissions(ctx context.Context, st SubmissionStore, cli api.MiningServiceClient,
+	maxAttempts int, now time.Time) error {
+	submissions, err := st.GetDueMiningSubmissions(ctx, now, 100)
 	if err != nil {
-		log.WithError(err).Error("Cannot get mining gateway list from DB.")
 		return err
 	}
+	for i := range submissions {
+		s := &submissions[i]
+		s.Attempts++
+		if err := sendSubmission(ctx, cli, s); err != nil {
+			log.WithError(err).WithFields(log.Fields{
+				"idempotency_key": s.IdempotencyKey,
+				"attempts":        s.Attempts,
+			}).Error("send mining request to m2m error")
+			s.LastError = err.Error()
+			s.NextAttemptAt = now.Add(submissionBackoff(s.Attempts))
+			if s.Attempts >= maxAttempts {
+				s.Status = SubmissionFailed
+			}
+		} else {
+			s.Status = SubmissionSent
+			s.LastError = ""
+			s.SentAt = &now
+		}
+		if err := st.UpdateMiningSubmission(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	if len(miningGws) == 0 {
-		return nil
+// submissionBackoff returns the delay before the next attempt to send the
+// submission
+func submissionBackoff(attempts int) time.Duration {
+	backoff := submissionRetryInterval
+	for i := 1; i < attempts && backoff < maxSubmissionBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxSubmissionBackoff {
+		backoff = maxSubmissionBackoff
 	}
+	return backoff
+}
 
+func sendSubmission(ctx context.Context, cli api.MiningServiceClient, s *Submission) error {
 	var gws []*api.GatewayMining
-
-	// update the first heartbeat = 0
-	for _, v := range miningGws {
-		err := ctrl.st.UpdateFirstHeartbeatToZero(ctx, v.GatewayMac)
-		if err != nil {
-			log.WithError(err).Error("tokenMining/update first heartbeat to zero error")
-		}
+	for _, v := range s.Gateways {
 		gw := api.GatewayMining{
 			GatewayMac: v.GatewayMac.String(),
 			OwnerOrgId: v.OwnerOrgID,
 			StcOrgId:   0,
 		}
-		if v.StcOrgID != nil && ctrl.enableSTC {
+		if v.StcOrgID != nil {
 			gw.StcOrgId = *v.StcOrgID
 		}
-
 		gws = append(gws, &gw)
 	}
 
-	// if error, resend after one minute
-	for {
-		if err := ctrl.sendMining(ctx, gws); err != nil {
-			log.WithError(err).Error("send mining request to m2m error")
-			time.Sleep(60 * time.Second)
-			continue
-		}
-		break
-	}
-
-	return nil
-}
-
-func (ctrl *controller) sendMining(ctx context.Context, gws []*api.GatewayMining) error {
-	_, err := ctrl.m2mClient.Mining(ctx, &api.MiningRequest{
-		GatewayMining: gws,
-		PeriodSeconds: ctrl.s.Period,
+	// if the request reaches m2m but the response is lost the submission is
+	// sent again, m2m doesn't pay the period again for the same key
+	_, err := cli.Mining(ctx, &api.MiningRequest{
+		GatewayMining:  gws,
+		PeriodSeconds:  s.PeriodSeconds,
+		IdempotencyKey: s.IdempotencyKey,
 	})
 
 	return err
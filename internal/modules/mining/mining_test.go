@@ -0,0 +1,94 @@
+package mining
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
+	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/mining/data"
+)
+
+type testSubmissionStore struct {
+	due     []Submission
+	updated []Submission
+}
+
+func (ts *testSubmissionStore) GetDueMiningSubmissions(ctx context.Context, now time.Time, limit int) ([]Submission, error) {
+	return ts.due, nil
+}
+
+func (ts *testSubmissionStore) UpdateMiningSubmission(ctx context.Context, s *Submission) error {
+	ts.updated = append(ts.updated, *s)
+	return nil
+}
+
+type testMiningClient struct {
+	api.MiningServiceClient
+	err  error
+	keys []string
+}
+
+func (tc *testMiningClient) Mining(ctx context.Context, in *api.MiningRequest, opts ...grpc.CallOption) (*api.MiningResponse, error) {
+	tc.keys = append(tc.keys, in.IdempotencyKey)
+	return &api.MiningResponse{}, tc.err
+}
+
+func TestDeliverSubmissions(t *testing.T) {
+	now := time.Now()
+	ctx := context.Background()
+
+	st := &testSubmissionStore{due: []Submission{
+		{ID: 1, IdempotencyKey: "as:1", Status: SubmissionPending},
+	}}
+	cli := &testMiningClient{}
+	if err := deliverSubmissions(ctx, st, cli, 3, now); err != nil {
+		t.Fatal(err)
+	}
+	if len(cli.keys) != 1 || cli.keys[0] != "as:1" {
+		t.Errorf("expected idempotency key as:1, got %v", cli.keys)
+	}
+	if len(st.updated) != 1 || st.updated[0].Status != SubmissionSent || st.updated[0].SentAt == nil {
+		t.Errorf("expected submission to be sent, got %+v", st.updated)
+	}
+
+	st = &testSubmissionStore{due: []Submission{
+		{ID: 2, IdempotencyKey: "as:2", Status: SubmissionPending, Attempts: 1},
+	}}
+	cli = &testMiningClient{err: errors.New("unavailable")}
+	if err := deliverSubmissions(ctx, st, cli, 3, now); err != nil {
+		t.Fatal(err)
+	}
+	s := st.updated[0]
+	if s.Status != SubmissionPending || s.Attempts != 2 || s.LastError != "unavailable" {
+		t.Errorf("expected submission to be pending, got %+v", s)
+	}
+	if !s.NextAttemptAt.Equal(now.Add(2 * time.Minute)) {
+		t.Errorf("expected next attempt in 2 minutes, got %s", s.NextAttemptAt.Sub(now))
+	}
+
+	st = &testSubmissionStore{due: []Submission{s}}
+	if err := deliverSubmissions(ctx, st, cli, 3, now); err != nil {
+		t.Fatal(err)
+	}
+	if st.updated[0].Status != SubmissionFailed {
+		t.Errorf("expected submission to fail, got %+v", st.updated[0])
+	}
+}
+
+func TestSubmissionBackoff(t *testing.T) {
+	for attempts, expected := range map[int]time.Duration{
+		1:  time.Minute,
+		2:  2 * time.Minute,
+		4:  8 * time.Minute,
+		7:  time.Hour,
+		40: time.Hour,
+	} {
+		if got := submissionBackoff(attempts); got != expected {
+			t.Errorf("attempts %d: expected %s, got %s", attempts, expected, got)
+		}
+	}
+}
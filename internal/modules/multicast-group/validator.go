@@ -8,49 +8,57 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 
-	auth "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	authcus "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
 )
 
 // Validator defines struct type for vadidating user access to APIs provided by this package
 type Validator struct {
-	Credentials *auth.Credentials
+	Credentials *authcus.Credentials
+	st          AuthStore
 }
 
 // Validate defines methods used on struct Validator
 type Validate interface {
-	ValidateMulticastGroupsAccess(ctx context.Context, flag auth.Flag, organizationID int64) (bool, error)
-	ValidateMulticastGroupAccess(ctx context.Context, flag auth.Flag, multicastGroupID uuid.UUID) (bool, error)
-	ValidateMulticastGroupQueueAccess(ctx context.Context, flag auth.Flag, multicastGroupID uuid.UUID) (bool, error)
-	GetUser(ctx context.Context) (auth.User, error)
+	ValidateMulticastGroupsAccess(ctx context.Context, flag authcus.Flag, organizationID int64) (bool, error)
+	ValidateMulticastGroupAccess(ctx context.Context, flag authcus.Flag, multicastGroupID uuid.UUID) (bool, error)
+	ValidateMulticastGroupQueueAccess(ctx context.Context, flag authcus.Flag, multicastGroupID uuid.UUID) (bool, error)
+	GetUser(ctx context.Context) (authcus.User, error)
 
-	ValidateNodeAccess(ctx context.Context, flag auth.Flag, devEUI lorawan.EUI64) (bool, error)
+	ValidateNodeAccess(ctx context.Context, flag authcus.Flag, devEUI lorawan.EUI64) (bool, error)
+}
+
+// AuthStore defines db APIs used to validate the access to the multicast
+// groups
+type AuthStore interface {
+	AuthGetDeviceOrgID(ctx context.Context, devEUI lorawan.EUI64) (int64, error)
+	AuthGetMulticastGroupOrgID(ctx context.Context, id uuid.UUID) (int64, error)
 }
 
 // NewValidator returns new Validate instance for this package
 func NewValidator() Validate {
 	return &Validator{
-		Credentials: auth.NewCredentials(),
+		Credentials: authcus.NewCredentials(),
+		st:          ctrl.st,
 	}
 }
 
-func (v *Validator) GetUser(ctx context.Context) (auth.User, error) {
+func (v *Validator) GetUser(ctx context.Context) (authcus.User, error) {
 	return v.Credentials.GetUser(ctx)
 }
 
 // ValidateNodeAccess validates if the client has access to the given node.
-func (v *Validator) ValidateNodeAccess(ctx context.Context, flag auth.Flag, devEUI lorawan.EUI64) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
+func (v *Validator) ValidateNodeAccess(ctx context.Context, flag authcus.Flag, devEUI lorawan.EUI64) (bool, error) {
+	orgID, err := v.st.AuthGetDeviceOrgID(ctx, devEUI)
 	if err != nil {
 		return false, errors.Wrap(err, "ValidateNodeAccess")
 	}
 
 	switch flag {
-	case auth.Read:
-		return ctrl.st.CheckReadNodeAccess(ctx, u.Email, devEUI, u.ID)
-	case auth.Update:
-		return ctrl.st.CheckUpdateNodeAccess(ctx, u.Email, devEUI, u.ID)
-	case auth.Delete:
-		return ctrl.st.CheckDeleteNodeAccess(ctx, u.Email, devEUI, u.ID)
+	case authcus.Read:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceRead)
+	case authcus.Update, authcus.Delete:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceWrite)
 	default:
 		panic("ValidateNodeAccess: unsupported flag")
 	}
@@ -59,17 +67,12 @@ func (v *Validator) ValidateNodeAccess(ctx context.Context, flag auth.Flag, devE
 
 // ValidateMulticastGroupsAccess validates if the client has access to the
 // multicast-groups.
-func (v *Validator) ValidateMulticastGroupsAccess(ctx context.Context, flag auth.Flag, organizationID int64) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
-	if err != nil {
-		return false, errors.Wrap(err, "ValidateMulticastGroupsAccess")
-	}
-
+func (v *Validator) ValidateMulticastGroupsAccess(ctx context.Context, flag authcus.Flag, organizationID int64) (bool, error) {
 	switch flag {
-	case auth.Create:
-		return ctrl.st.CheckCreateServiceProfilesAccess(ctx, u.Email, organizationID, u.ID)
-	case auth.List:
-		return ctrl.st.CheckListServiceProfilesAccess(ctx, u.Email, organizationID, u.ID)
+	case authcus.Create:
+		return v.Credentials.HasPermission(ctx, organizationID, auth.PermOrganizationAdmin)
+	case authcus.List:
+		return v.Credentials.HasPermission(ctx, organizationID, auth.PermDeviceRead)
 	default:
 		panic("ValidateMulticastGroupsAccess: not supported flag")
 	}
@@ -78,17 +81,17 @@ func (v *Validator) ValidateMulticastGroupsAccess(ctx context.Context, flag auth
 
 // ValidateMulticastGroupAccess validates if the client has access to the given
 // multicast-group.
-func (v *Validator) ValidateMulticastGroupAccess(ctx context.Context, flag auth.Flag, multicastGroupID uuid.UUID) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
+func (v *Validator) ValidateMulticastGroupAccess(ctx context.Context, flag authcus.Flag, multicastGroupID uuid.UUID) (bool, error) {
+	orgID, err := v.st.AuthGetMulticastGroupOrgID(ctx, multicastGroupID)
 	if err != nil {
 		return false, errors.Wrap(err, "ValidateMulticastGroupAccess")
 	}
 
 	switch flag {
-	case auth.Read:
-		return ctrl.st.CheckReadDeviceProfileAccess(ctx, u.Email, multicastGroupID, u.ID)
-	case auth.Update, auth.Delete:
-		return ctrl.st.CheckUpdateDeleteDeviceProfileAccess(ctx, u.Email, multicastGroupID, u.ID)
+	case authcus.Read:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceRead)
+	case authcus.Update, authcus.Delete:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermOrganizationAdmin)
 	default:
 		panic("ValidateMulticastGroupAccess: not supported flag")
 	}
@@ -97,15 +100,15 @@ func (v *Validator) ValidateMulticastGroupAccess(ctx context.Context, flag auth.
 
 // ValidateMulticastGroupQueueAccess validates if the client has access to
 // the given multicast-group queue.
-func (v *Validator) ValidateMulticastGroupQueueAccess(ctx context.Context, flag auth.Flag, multicastGroupID uuid.UUID) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
+func (v *Validator) ValidateMulticastGroupQueueAccess(ctx context.Context, flag authcus.Flag, multicastGroupID uuid.UUID) (bool, error) {
+	orgID, err := v.st.AuthGetMulticastGroupOrgID(ctx, multicastGroupID)
 	if err != nil {
 		return false, errors.Wrap(err, "ValidateMulticastGroupQueueAccess")
 	}
 
 	switch flag {
-	case auth.Create, auth.Read, auth.List, auth.Delete:
-		return ctrl.st.CheckMulticastGroupQueueAccess(ctx, u.Email, multicastGroupID, u.ID)
+	case authcus.Create, authcus.Read, authcus.List, authcus.Delete:
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceQueue)
 	default:
 		panic("ValidateMulticastGroupQueueAccess: not supported flag")
 	}
@@ -0,0 +1,114 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+)
+
+// the keys share the same hash tag so the scripts work with redis cluster
+const (
+	devProvSessionKeyTempl   = "lora:as:{devprovision}:session:%016x"
+	devProvGwContextKeyTempl = "lora:as:{devprovision}:gw-context:%016x"
+	devProvSessionsKey       = "lora:as:{devprovision}:sessions"
+)
+
+// createSessionScript stores the session unless it exists or the max number
+// of the sessions is reached. The sorted set keeps the ids of the sessions
+// scored by their expiration time.
+const createSessionScript = `
+redis.call("zremrangebyscore", KEYS[2], "-inf", ARGV[3])
+if redis.call("exists", KEYS[1]) == 1 then
+	return 0
+end
+if redis.call("zcard", KEYS[2]) >= tonumber(ARGV[4]) then
+	return 0
+end
+redis.call("set", KEYS[1], ARGV[1], "PX", ARGV[2])
+redis.call("zadd", KEYS[2], tonumber(ARGV[3]) + tonumber(ARGV[2]), ARGV[5])
+return 1
+`
+
+// updateSessionScript replaces the data of the session keeping its ttl
+const updateSessionScript = `
+local ttl = redis.call("pttl", KEYS[1])
+if ttl <= 0 then
+	return 0
+end
+redis.call("set", KEYS[1], ARGV[1], "PX", ttl)
+return 1
+`
+
+// setGwContextScript saves the gateway context of the session unless it's
+// the same as the saved one, the context expires with the session
+const setGwContextScript = `
+local ttl = redis.call("pttl", KEYS[1])
+if ttl <= 0 then
+	return 0
+end
+if redis.call("get", KEYS[2]) == ARGV[1] then
+	return 0
+end
+redis.call("set", KEYS[2], ARGV[1], "PX", ttl)
+return 1
+`
+
+// DevProvisionSessionStore keeps the device provisioning sessions in redis,
+// so they are shared by all the replicas and survive restarts. The sessions
+// are encrypted by the device provisioning before they are stored.
+type DevProvisionSessionStore struct {
+	store RedisStore
+}
+
+// NewDevProvisionSessionStore returns a new device provisioning session store
+func NewDevProvisionSessionStore(store RedisStore) *DevProvisionSessionStore {
+	return &DevProvisionSessionStore{store: store}
+}
+
+// GetSession returns the data of the session, or nil if the session doesn't
+// exist or has expired
+func (s *DevProvisionSessionStore) GetSession(ctx context.Context, id uint64) ([]byte, error) {
+	data, err := s.store.Get(fmt.Sprintf(devProvSessionKeyTempl, id)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// CreateSession stores the session unless it already exists or the number
+// of the sessions has reached maxSessions
+func (s *DevProvisionSessionStore) CreateSession(ctx context.Context, id uint64, data []byte, ttl time.Duration,
+	maxSessions int) (bool, error) {
+	res, err := s.store.Eval(createSessionScript,
+		[]string{fmt.Sprintf(devProvSessionKeyTempl, id), devProvSessionsKey},
+		data, ttl.Milliseconds(), time.Now().UnixNano()/int64(time.Millisecond), maxSessions,
+		fmt.Sprintf("%016x", id)).Int64()
+	if err != nil {
+		return false, err
+	}
+	return res == 1, nil
+}
+
+// UpdateSession replaces the data of the existing session
+func (s *DevProvisionSessionStore) UpdateSession(ctx context.Context, id uint64, data []byte) error {
+	return s.store.Eval(updateSessionScript, []string{fmt.Sprintf(devProvSessionKeyTempl, id)}, data).Err()
+}
+
+// SetSessionGatewayContext saves the context of the gateway that has received
+// the last frame of the session, it returns false if the session doesn't
+// exist or the context is the same as the saved one
+func (s *DevProvisionSessionStore) SetSessionGatewayContext(ctx context.Context, id uint64,
+	gwContext []byte) (bool, error) {
+	res, err := s.store.Eval(setGwContextScript,
+		[]string{fmt.Sprintf(devProvSessionKeyTempl, id), fmt.Sprintf(devProvGwContextKeyTempl, id)},
+		gwContext).Int64()
+	if err != nil {
+		return false, err
+	}
+	return res == 1, nil
+}
@@ -70,3 +70,7 @@ func (r client) Keys(pattern string) RedisStringSliceCmd {
 func (r client) Ping() RedisStatusCmd {
 	return r.rc.Ping()
 }
+
+func (r client) Eval(script string, keys []string, args ...interface{}) RedisCmd {
+	return r.rc.Eval(script, keys, args...)
+}
@@ -0,0 +1,70 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+const lockKeyTempl = "lora:as:lock:%s"
+
+// lockScript sets the lock if it's not set or extends it if it's held by
+// the same owner
+const lockScript = `
+local owner = redis.call("get", KEYS[1])
+if owner == ARGV[1] then
+	redis.call("pexpire", KEYS[1], ARGV[2])
+	return 1
+end
+if not owner then
+	redis.call("set", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+return 0
+`
+
+// unlockScript deletes the lock if it's held by the owner
+const unlockScript = `
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`
+
+// Locker implements distributed locks that expire unless they are extended
+// by their owner
+type Locker struct {
+	store RedisStore
+	owner string
+}
+
+// NewLocker returns a new locker, locks held by the locker are identified by
+// a random owner id
+func NewLocker(store RedisStore) (*Locker, error) {
+	owner, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	return &Locker{
+		store: store,
+		owner: owner.String(),
+	}, nil
+}
+
+// Lock acquires the lock or extends it if it's already held by the locker.
+// It returns false if the lock is held by someone else.
+func (l *Locker) Lock(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	res, err := l.store.Eval(lockScript, []string{fmt.Sprintf(lockKeyTempl, name)},
+		l.owner, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, err
+	}
+	return res == 1, nil
+}
+
+// Unlock releases the lock if it's held by the locker
+func (l *Locker) Unlock(ctx context.Context, name string) error {
+	return l.store.Eval(unlockScript, []string{fmt.Sprintf(lockKeyTempl, name)}, l.owner).Err()
+}
@@ -20,6 +20,7 @@ type RedisStore interface {
 	HGetAll(key string) RedisStringStringMapCmd
 	Keys(pattern string) RedisStringSliceCmd
 	Ping() RedisStatusCmd
+	Eval(script string, keys []string, args ...interface{}) RedisCmd
 }
 
 type RedisCmder interface {
@@ -37,6 +38,13 @@ type RedisPipeliner interface {
 	HIncrByFloat(key string, field string, incr float64) *redis.FloatCmd
 	HGetAll(key string) *redis.StringStringMapCmd
 }
+type RedisCmd interface {
+	Val() interface{}
+	Result() (interface{}, error)
+	Int64() (int64, error)
+	String() string
+	Err() error
+}
 type RedisFloatCmd interface {
 	Val() float64
 	Result() (float64, error)
@@ -25,8 +25,7 @@ type Store interface {
 	GetNetworkServerForServiceProfileID(ctx context.Context, id uuid.UUID) (nsd.NetworkServer, error)
 	DeleteServiceProfile(ctx context.Context, id uuid.UUID) error
 
-	CheckReadServiceProfileAccess(ctx context.Context, username string, id uuid.UUID, userID int64) (bool, error)
-	CheckUpdateDeleteServiceProfileAccess(ctx context.Context, username string, id uuid.UUID, userID int64) (bool, error)
+	AuthGetServiceProfileOrgID(ctx context.Context, id uuid.UUID) (int64, error)
 }
 
 // CreateServiceProfile creates the given service-profile.
@@ -6,41 +6,45 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 
-	auth "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	authcus "github.com/mxc-foundation/lpwan-app-server/internal/authentication"
 )
 
 // Validator defines struct type for vadidating user access to APIs provided by this package
 type Validator struct {
-	Credentials *auth.Credentials
+	Credentials *authcus.Credentials
 	st          Store
 }
 
 // Validate defines methods used on struct Validator
 type Validate interface {
-	ValidateServiceProfileAccess(ctx context.Context, flag auth.Flag, id uuid.UUID) (bool, error)
+	ValidateServiceProfileAccess(ctx context.Context, flag authcus.Flag, id uuid.UUID) (bool, error)
 }
 
 // NewValidator returns new Validate instance for this package
 func NewValidator(st Store) Validate {
 	return &Validator{
-		Credentials: auth.NewCredentials(),
+		Credentials: authcus.NewCredentials(),
 		st:          st,
 	}
 }
 
 // ValidateServiceProfileAccess validates if the client has access to the
 // given service-profile.
-func (v *Validator) ValidateServiceProfileAccess(ctx context.Context, flag auth.Flag, id uuid.UUID) (bool, error) {
-	u, err := v.Credentials.GetUser(ctx)
-	if err != nil {
-		return false, errors.Wrap(err, "ValidateServiceProfileAccess")
-	}
-
+func (v *Validator) ValidateServiceProfileAccess(ctx context.Context, flag authcus.Flag, id uuid.UUID) (bool, error) {
 	switch flag {
-	case auth.Read:
-		return v.st.CheckReadServiceProfileAccess(ctx, u.Email, id, u.ID)
-	case auth.Update, auth.Delete:
-		return v.st.CheckUpdateDeleteServiceProfileAccess(ctx, u.Email, id, u.ID)
+	case authcus.Read:
+		orgID, err := v.st.AuthGetServiceProfileOrgID(ctx, id)
+		if err != nil {
+			return false, errors.Wrap(err, "ValidateServiceProfileAccess")
+		}
+		return v.Credentials.HasPermission(ctx, orgID, auth.PermDeviceRead)
+	case authcus.Update, authcus.Delete:
+		// only the global admin can modify the service profiles
+		if err := v.Credentials.IsGlobalAdmin(ctx); err != nil {
+			return false, errors.Wrap(err, "ValidateServiceProfileAccess")
+		}
+		return true, nil
 	default:
 		panic("ValidateServiceProfileAccess: not supported flag")
 	}
@@ -14,7 +14,6 @@ type Validator struct {
 // Validate defines methods used on struct Validator
 type Validate interface {
 	IsGlobalAdmin(ctx context.Context, opts ...cred.Option) error
-	IsOrgAdmin(ctx context.Context, orgID int64, opts ...cred.Option) error
 }
 
 // NewValidator returns new Validate instance for this package
@@ -27,7 +26,3 @@ func NewValidator() Validate {
 func (v *Validator) IsGlobalAdmin(ctx context.Context, opts ...cred.Option) error {
 	return v.Credentials.IsGlobalAdmin(ctx, opts...)
 }
-
-func (v *Validator) IsOrgAdmin(ctx context.Context, orgID int64, opts ...cred.Option) error {
-	return v.Credentials.IsOrgAdmin(ctx, orgID, opts...)
-}
@@ -0,0 +1,297 @@
+// Package orgtransfer exports the organization with all its objects into the
+// encrypted archive and imports the archive into another supernode
+package orgtransfer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/brocaar/chirpstack-api/go/v3/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Version is the version of the archive format written by Export. The
+// archives of the newer versions are rejected by Import.
+const Version = 1
+
+// MinPassphraseLength is the minimal length of the passphrase the archive is
+// encrypted with
+const MinPassphraseLength = 12
+
+// archiveMagic starts every archive
+var archiveMagic = []byte("LPWANORG")
+
+const (
+	saltSize  = 16
+	nonceSize = 12
+	keySize   = 32
+)
+
+// errors returned when the archive can't be read
+var (
+	ErrInvalidArchive     = errors.New("not an organization archive")
+	ErrUnsupportedVersion = errors.New("unsupported archive version")
+	ErrInvalidPassphrase  = errors.New("invalid passphrase or corrupted archive")
+)
+
+// Archive is the content of the organization archive. The IDs are the IDs in
+// the source supernode, the references between the objects use them.
+type Archive struct {
+	Version         int              `json:"version"`
+	ExportedAt      time.Time        `json:"exported_at"`
+	Organization    Organization     `json:"organization"`
+	Roles           []Role           `json:"roles"`
+	Users           []User           `json:"users"`
+	ServiceProfiles []ServiceProfile `json:"service_profiles"`
+	DeviceProfiles  []DeviceProfile  `json:"device_profiles"`
+	Applications    []Application    `json:"applications"`
+	Devices         []Device         `json:"devices"`
+	Gateways        []Gateway        `json:"gateways"`
+	MulticastGroups []MulticastGroup `json:"multicast_groups"`
+}
+
+// Organization is the exported organization
+type Organization struct {
+	ID              int64  `json:"id"`
+	Name            string `json:"name"`
+	DisplayName     string `json:"display_name"`
+	CanHaveGateways bool   `json:"can_have_gateways"`
+	MaxDeviceCount  int    `json:"max_device_count"`
+	MaxGatewayCount int    `json:"max_gateway_count"`
+}
+
+// Role is the custom role of the organization
+type Role struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// User is the user of the organization. The users are identified by the
+// email, they are not created by the import.
+type User struct {
+	Email          string `json:"email"`
+	IsAdmin        bool   `json:"is_admin"`
+	IsDeviceAdmin  bool   `json:"is_device_admin"`
+	IsGatewayAdmin bool   `json:"is_gateway_admin"`
+	RoleID         *int64 `json:"role_id,omitempty"`
+}
+
+// ServiceProfile is the service-profile with its network-server part
+type ServiceProfile struct {
+	ID             uuid.UUID          `json:"id"`
+	Name           string             `json:"name"`
+	ServiceProfile *ns.ServiceProfile `json:"service_profile"`
+}
+
+// DeviceProfile is the device-profile with its network-server part
+type DeviceProfile struct {
+	ID                   uuid.UUID         `json:"id"`
+	Name                 string            `json:"name"`
+	PayloadCodec         string            `json:"payload_codec"`
+	PayloadEncoderScript string            `json:"payload_encoder_script"`
+	PayloadDecoderScript string            `json:"payload_decoder_script"`
+	Tags                 map[string]string `json:"tags"`
+	UplinkInterval       time.Duration     `json:"uplink_interval"`
+	DeviceProfile        *ns.DeviceProfile `json:"device_profile"`
+}
+
+// Application is the application with its integrations
+type Application struct {
+	ID                   int64         `json:"id"`
+	Name                 string        `json:"name"`
+	Description          string        `json:"description"`
+	ServiceProfileID     uuid.UUID     `json:"service_profile_id"`
+	PayloadCodec         string        `json:"payload_codec"`
+	PayloadEncoderScript string        `json:"payload_encoder_script"`
+	PayloadDecoderScript string        `json:"payload_decoder_script"`
+	Integrations         []Integration `json:"integrations"`
+}
+
+// Integration is the integration of the application
+type Integration struct {
+	Kind     string          `json:"kind"`
+	Settings json.RawMessage `json:"settings"`
+}
+
+// Device is the device with its keys and the current activation
+type Device struct {
+	DevEUI            lorawan.EUI64     `json:"dev_eui"`
+	ApplicationID     int64             `json:"application_id"`
+	DeviceProfileID   uuid.UUID         `json:"device_profile_id"`
+	Name              string            `json:"name"`
+	Description       string            `json:"description"`
+	SkipFCntCheck     bool              `json:"skip_fcnt_check"`
+	ReferenceAltitude float64           `json:"reference_altitude"`
+	IsDisabled        bool              `json:"is_disabled"`
+	Latitude          *float64          `json:"latitude,omitempty"`
+	Longitude         *float64          `json:"longitude,omitempty"`
+	Altitude          *float64          `json:"altitude,omitempty"`
+	Variables         map[string]string `json:"variables"`
+	Tags              map[string]string `json:"tags"`
+	Model             string            `json:"model"`
+	SerialNumber      string            `json:"serial_number"`
+	Manufacturer      string            `json:"manufacturer"`
+	Keys              *DeviceKeys       `json:"keys,omitempty"`
+	Activation        *DeviceActivation `json:"activation,omitempty"`
+}
+
+// DeviceKeys are the root keys of the device
+type DeviceKeys struct {
+	NwkKey    lorawan.AES128Key `json:"nwk_key"`
+	AppKey    lorawan.AES128Key `json:"app_key"`
+	GenAppKey lorawan.AES128Key `json:"gen_app_key"`
+	JoinNonce int               `json:"join_nonce"`
+}
+
+// DeviceActivation is the session of the activated device
+type DeviceActivation struct {
+	DevAddr     lorawan.DevAddr   `json:"dev_addr"`
+	AppSKey     lorawan.AES128Key `json:"app_s_key"`
+	NwkSEncKey  lorawan.AES128Key `json:"nwk_s_enc_key"`
+	SNwkSIntKey lorawan.AES128Key `json:"s_nwk_s_int_key"`
+	FNwkSIntKey lorawan.AES128Key `json:"f_nwk_s_int_key"`
+	FCntUp      uint32            `json:"f_cnt_up"`
+	NFCntDown   uint32            `json:"n_f_cnt_down"`
+	AFCntDown   uint32            `json:"a_f_cnt_down"`
+}
+
+// Gateway is the gateway with its network-server part
+type Gateway struct {
+	MAC                lorawan.EUI64     `json:"mac"`
+	Name               string            `json:"name"`
+	Description        string            `json:"description"`
+	Ping               bool              `json:"ping"`
+	Latitude           float64           `json:"latitude"`
+	Longitude          float64           `json:"longitude"`
+	Altitude           float64           `json:"altitude"`
+	Tags               map[string]string `json:"tags"`
+	Metadata           map[string]string `json:"metadata"`
+	Model              string            `json:"model"`
+	Config             string            `json:"config"`
+	SerialNumber       string            `json:"serial_number"`
+	AutoUpdateFirmware bool              `json:"auto_update_firmware"`
+	Gateway            *ns.Gateway       `json:"gateway"`
+}
+
+// MulticastGroup is the multicast-group with its devices
+type MulticastGroup struct {
+	ID               uuid.UUID          `json:"id"`
+	Name             string             `json:"name"`
+	MCAppSKey        lorawan.AES128Key  `json:"mc_app_s_key"`
+	MCKey            lorawan.AES128Key  `json:"mc_key"`
+	ServiceProfileID uuid.UUID          `json:"service_profile_id"`
+	MulticastGroup   *ns.MulticastGroup `json:"multicast_group"`
+	DevEUIs          []lorawan.EUI64    `json:"dev_euis"`
+}
+
+// CheckPassphrase returns an error if the passphrase is too weak to encrypt
+// the archive
+func CheckPassphrase(passphrase string) error {
+	if len(passphrase) < MinPassphraseLength {
+		return fmt.Errorf("passphrase must be at least %d characters long", MinPassphraseLength)
+	}
+	return nil
+}
+
+// deriveKey derives the encryption key from the passphrase
+func deriveKey(passphrase string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, keySize)
+}
+
+// Encrypt encodes the archive and encrypts it with the passphrase. The
+// result is the magic, the version, the salt of the key derivation, the
+// nonce and the gzipped JSON encrypted with AES-256-GCM.
+func Encrypt(a *Archive, passphrase string) ([]byte, error) {
+	var plain bytes.Buffer
+	zw := gzip.NewWriter(&plain)
+	if err := json.NewEncoder(zw).Encode(a); err != nil {
+		return nil, fmt.Errorf("couldn't encode archive: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("couldn't compress archive: %w", err)
+	}
+
+	salt := make([]byte, saltSize)
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, len(archiveMagic)+1+saltSize+nonceSize)
+	header = append(header, archiveMagic...)
+	header = append(header, byte(a.Version))
+	header = append(header, salt...)
+	header = append(header, nonce...)
+	// the header is authenticated too
+	return gcm.Seal(header, nonce, plain.Bytes(), header), nil
+}
+
+// Decrypt decrypts the archive with the passphrase and decodes it
+func Decrypt(data []byte, passphrase string) (*Archive, error) {
+	headerSize := len(archiveMagic) + 1 + saltSize + nonceSize
+	if len(data) < headerSize || !bytes.Equal(data[:len(archiveMagic)], archiveMagic) {
+		return nil, ErrInvalidArchive
+	}
+	version := int(data[len(archiveMagic)])
+	if version < 1 || version > Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+	salt := data[len(archiveMagic)+1 : len(archiveMagic)+1+saltSize]
+	nonce := data[len(archiveMagic)+1+saltSize : headerSize]
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := gcm.Open(nil, nonce, data[headerSize:], data[:headerSize])
+	if err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(plain))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decompress archive: %w", err)
+	}
+	js, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decompress archive: %w", err)
+	}
+	var a Archive
+	if err := json.Unmarshal(js, &a); err != nil {
+		return nil, fmt.Errorf("couldn't decode archive: %w", err)
+	}
+	if a.Version != version {
+		return nil, ErrInvalidArchive
+	}
+	return &a, nil
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := deriveKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,104 @@
+package orgtransfer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brocaar/chirpstack-api/go/v3/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+)
+
+func testArchive() *Archive {
+	spID := uuid.Must(uuid.NewV4())
+	return &Archive{
+		Version:    Version,
+		ExportedAt: time.Now().UTC().Truncate(time.Second),
+		Organization: Organization{
+			ID:              7,
+			Name:            "acme",
+			DisplayName:     "ACME",
+			CanHaveGateways: true,
+		},
+		ServiceProfiles: []ServiceProfile{{
+			ID:             spID,
+			Name:           "default",
+			ServiceProfile: &ns.ServiceProfile{AddGwMetadata: true, DrMax: 5},
+		}},
+		Devices: []Device{{
+			DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			Name:   "sensor",
+			Tags:   map[string]string{"floor": "2"},
+			Keys:   &DeviceKeys{AppKey: lorawan.AES128Key{1}, JoinNonce: 3},
+			Activation: &DeviceActivation{
+				DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+				AppSKey: lorawan.AES128Key{2},
+				FCntUp:  10,
+			},
+		}},
+	}
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	a := testArchive()
+	data, err := Encrypt(a, "correct horse battery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Decrypt(data, "correct horse battery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Organization != a.Organization || !got.ExportedAt.Equal(a.ExportedAt) {
+		t.Errorf("expected organization %+v, got %+v", a.Organization, got.Organization)
+	}
+	if len(got.ServiceProfiles) != 1 || got.ServiceProfiles[0].ID != a.ServiceProfiles[0].ID ||
+		got.ServiceProfiles[0].ServiceProfile.DrMax != 5 {
+		t.Errorf("unexpected service-profiles: %+v", got.ServiceProfiles)
+	}
+	if len(got.Devices) != 1 {
+		t.Fatalf("expected 1 device, got %d", len(got.Devices))
+	}
+	d := got.Devices[0]
+	if d.DevEUI != a.Devices[0].DevEUI || d.Tags["floor"] != "2" || *d.Keys != *a.Devices[0].Keys ||
+		*d.Activation != *a.Devices[0].Activation {
+		t.Errorf("unexpected device: %+v", d)
+	}
+}
+
+func TestDecryptErrors(t *testing.T) {
+	data, err := Encrypt(testArchive(), "correct horse battery")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Decrypt(data, "wrong horse battery"); !errors.Is(err, ErrInvalidPassphrase) {
+		t.Errorf("expected invalid passphrase, got %v", err)
+	}
+	if _, err := Decrypt([]byte("not an archive at all, just some text"), "x"); !errors.Is(err, ErrInvalidArchive) {
+		t.Errorf("expected invalid archive, got %v", err)
+	}
+
+	future := append([]byte(nil), data...)
+	future[len(archiveMagic)] = Version + 1
+	if _, err := Decrypt(future, "correct horse battery"); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("expected unsupported version, got %v", err)
+	}
+
+	// the header is authenticated, the modified salt can't be decrypted
+	tampered := append([]byte(nil), data...)
+	tampered[len(archiveMagic)+1] ^= 0xff
+	if _, err := Decrypt(tampered, "correct horse battery"); !errors.Is(err, ErrInvalidPassphrase) {
+		t.Errorf("expected invalid passphrase, got %v", err)
+	}
+}
+
+func TestCheckPassphrase(t *testing.T) {
+	if err := CheckPassphrase("short"); err == nil {
+		t.Error("expected short passphrase to be rejected")
+	}
+	if err := CheckPassphrase("long enough passphrase"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,361 @@
+package orgtransfer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/brocaar/chirpstack-api/go/v3/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq/hstore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/mxpcli"
+	"github.com/mxc-foundation/lpwan-app-server/internal/nscli"
+	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
+)
+
+// Transfer exports the organizations from this supernode and imports the
+// organizations exported from the other supernodes
+type Transfer struct {
+	st                  *store.Handler
+	nsCli               *nscli.Client
+	mxpCli              *mxpcli.Client
+	applicationServerID uuid.UUID
+}
+
+// New creates a new Transfer
+func New(h *store.Handler, nsCli *nscli.Client, mxpCli *mxpcli.Client, applicationServerID uuid.UUID) *Transfer {
+	return &Transfer{
+		st:                  h,
+		nsCli:               nsCli,
+		mxpCli:              mxpCli,
+		applicationServerID: applicationServerID,
+	}
+}
+
+// Export collects the organization with its users, roles, profiles,
+// applications, devices, gateways and multicast-groups into the archive. The
+// network-server parts of the objects are fetched from the network-servers.
+func (t *Transfer) Export(ctx context.Context, orgID int64) (*Archive, error) {
+	org, err := t.st.GetOrganization(ctx, orgID, false)
+	if err != nil {
+		return nil, err
+	}
+	a := &Archive{
+		Version:    Version,
+		ExportedAt: time.Now().UTC(),
+		Organization: Organization{
+			ID:              org.ID,
+			Name:            org.Name,
+			DisplayName:     org.DisplayName,
+			CanHaveGateways: org.CanHaveGateways,
+			MaxDeviceCount:  org.MaxDeviceCount,
+			MaxGatewayCount: org.MaxGatewayCount,
+		},
+	}
+	for _, f := range []func(context.Context, *Archive) error{
+		t.exportUsers,
+		t.exportServiceProfiles,
+		t.exportDeviceProfiles,
+		t.exportApplications,
+		t.exportDevices,
+		t.exportGateways,
+		t.exportMulticastGroups,
+	} {
+		if err := f(ctx, a); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+func (t *Transfer) exportUsers(ctx context.Context, a *Archive) error {
+	roles, err := t.st.GetOrganizationRoles(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		a.Roles = append(a.Roles, Role{
+			ID:          r.ID,
+			Name:        r.Name,
+			Description: r.Description,
+			Permissions: r.Permissions.Names(),
+		})
+	}
+	count, err := t.st.GetOrganizationUserCount(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+	users, err := t.st.GetOrganizationUsers(ctx, a.Organization.ID, count, 0)
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		a.Users = append(a.Users, User{
+			Email:          u.Email,
+			IsAdmin:        u.IsAdmin,
+			IsDeviceAdmin:  u.IsDeviceAdmin,
+			IsGatewayAdmin: u.IsGatewayAdmin,
+			RoleID:         u.RoleID,
+		})
+	}
+	return nil
+}
+
+func (t *Transfer) exportServiceProfiles(ctx context.Context, a *Archive) error {
+	ids, err := t.st.GetOrganizationServiceProfileIDs(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		sp, err := t.st.GetServiceProfile(ctx, id)
+		if err != nil {
+			return err
+		}
+		nsClient, err := t.nsCli.GetNetworkServerServiceClient(sp.NetworkServerID)
+		if err != nil {
+			return err
+		}
+		resp, err := nsClient.GetServiceProfile(ctx, &ns.GetServiceProfileRequest{Id: id.Bytes()})
+		if err != nil {
+			return fmt.Errorf("get service-profile %s error: %w", id, err)
+		}
+		a.ServiceProfiles = append(a.ServiceProfiles, ServiceProfile{
+			ID:             id,
+			Name:           sp.Name,
+			ServiceProfile: resp.ServiceProfile,
+		})
+	}
+	return nil
+}
+
+func (t *Transfer) exportDeviceProfiles(ctx context.Context, a *Archive) error {
+	ids, err := t.st.GetOrganizationDeviceProfileIDs(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		dp, err := t.st.GetDeviceProfile(ctx, id, false)
+		if err != nil {
+			return err
+		}
+		nsClient, err := t.nsCli.GetNetworkServerServiceClient(dp.NetworkServerID)
+		if err != nil {
+			return err
+		}
+		resp, err := nsClient.GetDeviceProfile(ctx, &ns.GetDeviceProfileRequest{Id: id.Bytes()})
+		if err != nil {
+			return fmt.Errorf("get device-profile %s error: %w", id, err)
+		}
+		a.DeviceProfiles = append(a.DeviceProfiles, DeviceProfile{
+			ID:                   id,
+			Name:                 dp.Name,
+			PayloadCodec:         dp.PayloadCodec,
+			PayloadEncoderScript: dp.PayloadEncoderScript,
+			PayloadDecoderScript: dp.PayloadDecoderScript,
+			Tags:                 hstoreToMap(dp.Tags),
+			UplinkInterval:       dp.UplinkInterval,
+			DeviceProfile:        resp.DeviceProfile,
+		})
+	}
+	return nil
+}
+
+func (t *Transfer) exportApplications(ctx context.Context, a *Archive) error {
+	apps, err := t.st.GetOrganizationApplications(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+	integrations, err := t.st.GetOrganizationIntegrations(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+	for _, app := range apps {
+		ea := Application{
+			ID:                   app.ID,
+			Name:                 app.Name,
+			Description:          app.Description,
+			ServiceProfileID:     app.ServiceProfileID,
+			PayloadCodec:         app.PayloadCodec,
+			PayloadEncoderScript: app.PayloadEncoderScript,
+			PayloadDecoderScript: app.PayloadDecoderScript,
+		}
+		for _, i := range integrations {
+			if i.ApplicationID == app.ID {
+				ea.Integrations = append(ea.Integrations, Integration{Kind: i.Kind, Settings: i.Settings})
+			}
+		}
+		a.Applications = append(a.Applications, ea)
+	}
+	return nil
+}
+
+func (t *Transfer) exportDevices(ctx context.Context, a *Archive) error {
+	devices, err := t.st.GetOrganizationDevices(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+	keys, err := t.st.GetOrganizationDeviceKeys(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+	devKeys := make(map[lorawan.EUI64]*DeviceKeys)
+	for _, k := range keys {
+		devKeys[k.DevEUI] = &DeviceKeys{
+			NwkKey:    k.NwkKey,
+			AppKey:    k.AppKey,
+			GenAppKey: k.GenAppKey,
+			JoinNonce: k.JoinNonce,
+		}
+	}
+	for _, d := range devices {
+		n, err := t.st.GetNetworkServerForDevEUI(ctx, d.DevEUI)
+		if err != nil {
+			return err
+		}
+		nsClient, err := t.nsCli.GetNetworkServerServiceClient(n.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := nsClient.GetDevice(ctx, &ns.GetDeviceRequest{DevEui: d.DevEUI[:]})
+		if err != nil {
+			return fmt.Errorf("get device %s error: %w", d.DevEUI, err)
+		}
+		ed := Device{
+			DevEUI:          d.DevEUI,
+			ApplicationID:   d.ApplicationID,
+			DeviceProfileID: d.DeviceProfileID,
+			Name:            d.Name,
+			Description:     d.Description,
+			Latitude:        d.Latitude,
+			Longitude:       d.Longitude,
+			Altitude:        d.Altitude,
+			Variables:       hstoreToMap(d.Variables),
+			Tags:            hstoreToMap(d.Tags),
+			Model:           d.Model,
+			SerialNumber:    d.SerialNumber,
+			Manufacturer:    d.Manufacturer,
+			Keys:            devKeys[d.DevEUI],
+		}
+		if resp.Device != nil {
+			ed.SkipFCntCheck = resp.Device.SkipFCntCheck
+			ed.ReferenceAltitude = resp.Device.ReferenceAltitude
+			ed.IsDisabled = resp.Device.IsDisabled
+		}
+
+		act, err := nsClient.GetDeviceActivation(ctx, &ns.GetDeviceActivationRequest{DevEui: d.DevEUI[:]})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return fmt.Errorf("get device %s activation error: %w", d.DevEUI, err)
+		}
+		if err == nil && act.DeviceActivation != nil {
+			da := act.DeviceActivation
+			ed.Activation = &DeviceActivation{
+				AppSKey:   d.AppSKey,
+				FCntUp:    da.FCntUp,
+				NFCntDown: da.NFCntDown,
+				AFCntDown: da.AFCntDown,
+			}
+			copy(ed.Activation.DevAddr[:], da.DevAddr)
+			copy(ed.Activation.NwkSEncKey[:], da.NwkSEncKey)
+			copy(ed.Activation.SNwkSIntKey[:], da.SNwkSIntKey)
+			copy(ed.Activation.FNwkSIntKey[:], da.FNwkSIntKey)
+		}
+		a.Devices = append(a.Devices, ed)
+	}
+	return nil
+}
+
+func (t *Transfer) exportGateways(ctx context.Context, a *Archive) error {
+	gws, err := t.st.GetOrganizationGateways(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+	for _, gw := range gws {
+		nsClient, err := t.nsCli.GetNetworkServerServiceClient(gw.NetworkServerID)
+		if err != nil {
+			return err
+		}
+		resp, err := nsClient.GetGateway(ctx, &ns.GetGatewayRequest{Id: gw.MAC[:]})
+		if err != nil {
+			return fmt.Errorf("get gateway %s error: %w", gw.MAC, err)
+		}
+		a.Gateways = append(a.Gateways, Gateway{
+			MAC:                gw.MAC,
+			Name:               gw.Name,
+			Description:        gw.Description,
+			Ping:               gw.Ping,
+			Latitude:           gw.Latitude,
+			Longitude:          gw.Longitude,
+			Altitude:           gw.Altitude,
+			Tags:               hstoreToMap(gw.Tags),
+			Metadata:           hstoreToMap(gw.Metadata),
+			Model:              gw.Model,
+			Config:             gw.Config,
+			SerialNumber:       gw.SerialNumber,
+			AutoUpdateFirmware: gw.AutoUpdateFirmware,
+			Gateway:            resp.Gateway,
+		})
+	}
+	return nil
+}
+
+func (t *Transfer) exportMulticastGroups(ctx context.Context, a *Archive) error {
+	ids, err := t.st.GetOrganizationMulticastGroupIDs(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+	devices, err := t.st.GetOrganizationMulticastGroupDevices(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		mg, err := t.st.GetMulticastGroup(ctx, id, false)
+		if err != nil {
+			return err
+		}
+		n, err := t.st.GetNetworkServerForServiceProfileID(ctx, mg.ServiceProfileID)
+		if err != nil {
+			return err
+		}
+		nsClient, err := t.nsCli.GetNetworkServerServiceClient(n.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := nsClient.GetMulticastGroup(ctx, &ns.GetMulticastGroupRequest{Id: id.Bytes()})
+		if err != nil {
+			return fmt.Errorf("get multicast-group %s error: %w", id, err)
+		}
+		a.MulticastGroups = append(a.MulticastGroups, MulticastGroup{
+			ID:               id,
+			Name:             mg.Name,
+			MCAppSKey:        mg.MCAppSKey,
+			MCKey:            mg.MCKey,
+			ServiceProfileID: mg.ServiceProfileID,
+			MulticastGroup:   resp.MulticastGroup,
+			DevEUIs:          devices[id],
+		})
+	}
+	return nil
+}
+
+func hstoreToMap(h hstore.Hstore) map[string]string {
+	m := make(map[string]string)
+	for k, v := range h.Map {
+		if v.Valid {
+			m[k] = v.String
+		}
+	}
+	return m
+}
+
+func mapToHstore(m map[string]string) hstore.Hstore {
+	h := hstore.Hstore{Map: make(map[string]sql.NullString)}
+	for k, v := range m {
+		h.Map[k] = sql.NullString{Valid: true, String: v}
+	}
+	return h
+}
@@ -0,0 +1,694 @@
+package orgtransfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brocaar/chirpstack-api/go/v3/common"
+	"github.com/brocaar/chirpstack-api/go/v3/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/device"
+	dps "github.com/mxc-foundation/lpwan-app-server/internal/api/external/dp"
+	nsd "github.com/mxc-foundation/lpwan-app-server/internal/api/external/ns"
+	orgd "github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/logging"
+	appd "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
+	devd "github.com/mxc-foundation/lpwan-app-server/internal/modules/device/data"
+	gatewaymod "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway"
+	gwd "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+	mgd "github.com/mxc-foundation/lpwan-app-server/internal/modules/multicast-group/data"
+	spmod "github.com/mxc-foundation/lpwan-app-server/internal/modules/service-profile"
+	spd "github.com/mxc-foundation/lpwan-app-server/internal/modules/service-profile/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/role"
+	"github.com/mxc-foundation/lpwan-app-server/internal/storage/store"
+	"github.com/mxc-foundation/lpwan-app-server/internal/types"
+)
+
+// ImportOptions are the options of the import
+type ImportOptions struct {
+	// NetworkServerID is the network-server the profiles, devices and
+	// gateways are created at, the default network-server if 0
+	NetworkServerID int64
+	// Name replaces the name of the exported organization if not empty
+	Name string
+	// DryRun only reports the conflicts, nothing is created
+	DryRun bool
+}
+
+// Conflict is the object of the archive that can't be imported as is
+type Conflict struct {
+	Kind   string
+	ID     string
+	Reason string
+}
+
+// ImportResult is the result of the import
+type ImportResult struct {
+	// OrganizationID is the ID of the created organization, 0 for the dry
+	// run
+	OrganizationID int64
+	// Conflicts are the objects that were skipped or changed
+	Conflicts []Conflict
+}
+
+// importer keeps the IDs of the objects created in this supernode by the
+// IDs of the exported objects
+type importer struct {
+	*Transfer
+	st       *store.Handler
+	n        nsd.NetworkServer
+	nsClient ns.NetworkServerServiceClient
+	result   *ImportResult
+
+	skipDevices     map[lorawan.EUI64]bool
+	skipGateways    map[lorawan.EUI64]bool
+	missingUsers    map[string]bool
+	gatewayProfiles map[uuid.UUID][]byte
+
+	roles           map[int64]int64
+	serviceProfiles map[uuid.UUID]uuid.UUID
+	deviceProfiles  map[uuid.UUID]uuid.UUID
+	applications    map[int64]appd.Application
+	devices         map[lorawan.EUI64]bool
+
+	// created are the objects created at the network-server and m2m, they
+	// are not rolled back with the database transaction
+	created []createdObject
+}
+
+// createdObject is the object created outside of the database, with the
+// function that deletes it if the import fails
+type createdObject struct {
+	kind   string
+	id     string
+	delete func(ctx context.Context) error
+}
+
+func (im *importer) conflict(kind, id, reason string) {
+	im.result.Conflicts = append(im.result.Conflicts, Conflict{Kind: kind, ID: id, Reason: reason})
+}
+
+// Import creates the organization from the archive. The profiles, devices
+// and gateways are created at the given network-server and get new IDs, all
+// the references between the objects are updated. The users must exist in
+// this supernode already, they are found by the email. The devices and the
+// gateways that already exist are skipped. All the skipped and changed
+// objects are reported as the conflicts. If the organization with the same
+// name exists, nothing is imported and ErrAlreadyExists is returned. If the
+// import fails, the objects already created at the network-server and m2m
+// are deleted as they are not rolled back with the database.
+func (t *Transfer) Import(ctx context.Context, a *Archive, opts ImportOptions) (*ImportResult, error) {
+	name := a.Organization.Name
+	if opts.Name != "" {
+		name = opts.Name
+	}
+	var n nsd.NetworkServer
+	var err error
+	if opts.NetworkServerID != 0 {
+		n, err = t.st.GetNetworkServer(ctx, opts.NetworkServerID)
+	} else {
+		n, err = t.st.GetDefaultNetworkServer(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get network-server error: %w", err)
+	}
+	nsClient, err := t.nsCli.GetNetworkServerServiceClient(n.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	im := &importer{
+		Transfer:        t,
+		st:              t.st,
+		n:               n,
+		nsClient:        nsClient,
+		result:          &ImportResult{},
+		skipDevices:     make(map[lorawan.EUI64]bool),
+		skipGateways:    make(map[lorawan.EUI64]bool),
+		missingUsers:    make(map[string]bool),
+		gatewayProfiles: make(map[uuid.UUID][]byte),
+		roles:           make(map[int64]int64),
+		serviceProfiles: make(map[uuid.UUID]uuid.UUID),
+		deviceProfiles:  make(map[uuid.UUID]uuid.UUID),
+		applications:    make(map[int64]appd.Application),
+		devices:         make(map[lorawan.EUI64]bool),
+	}
+	nameExists, err := im.checkConflicts(ctx, a, name)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun {
+		return im.result, nil
+	}
+	if nameExists {
+		return nil, errHandler.ErrAlreadyExists
+	}
+
+	err = t.st.Tx(ctx, func(ctx context.Context, h *store.Handler) error {
+		im.st = h
+		return im.run(ctx, a, name)
+	})
+	if err != nil {
+		im.deleteCreated(ctx)
+		return nil, err
+	}
+	log.WithFields(log.Fields{
+		"organization_id": im.result.OrganizationID,
+		"exported_id":     a.Organization.ID,
+		"conflicts":       len(im.result.Conflicts),
+		"ctx_id":          ctx.Value(logging.ContextIDKey),
+	}).Info("organization imported")
+	return im.result, nil
+}
+
+// deleteCreated deletes the objects created at the network-server and m2m
+// in the reverse order, so the devices are deleted before their profiles.
+// The deletion continues on errors, the objects that couldn't be deleted are
+// logged to be removed manually.
+func (im *importer) deleteCreated(ctx context.Context) {
+	// the request may have been cancelled, which is often why the import
+	// failed, the cleanup is done anyway
+	delCtx := context.Background()
+	for i := len(im.created) - 1; i >= 0; i-- {
+		obj := im.created[i]
+		err := obj.delete(delCtx)
+		if err != nil && status.Code(err) != codes.NotFound {
+			log.WithError(err).WithFields(log.Fields{
+				"kind":   obj.kind,
+				"id":     obj.id,
+				"ctx_id": ctx.Value(logging.ContextIDKey),
+			}).Error("couldn't delete the object created by the failed import")
+		}
+	}
+	im.created = nil
+}
+
+func (im *importer) createdServiceProfile(id uuid.UUID) {
+	im.created = append(im.created, createdObject{kind: "service-profile", id: id.String(),
+		delete: func(ctx context.Context) error {
+			_, err := im.nsClient.DeleteServiceProfile(ctx, &ns.DeleteServiceProfileRequest{Id: id.Bytes()})
+			return err
+		}})
+}
+
+func (im *importer) createdDeviceProfile(id uuid.UUID) {
+	im.created = append(im.created, createdObject{kind: "device-profile", id: id.String(),
+		delete: func(ctx context.Context) error {
+			_, err := im.nsClient.DeleteDeviceProfile(ctx, &ns.DeleteDeviceProfileRequest{Id: id.Bytes()})
+			return err
+		}})
+}
+
+func (im *importer) createdDevice(devEUI lorawan.EUI64, mxpCli pb.DSDeviceServiceClient) {
+	im.created = append(im.created, createdObject{kind: "device", id: devEUI.String(),
+		delete: func(ctx context.Context) error {
+			_, err := mxpCli.DeleteDeviceInM2MServer(ctx, &pb.DeleteDeviceInM2MServerRequest{DevEui: devEUI.String()})
+			if err != nil && status.Code(err) != codes.NotFound {
+				return err
+			}
+			_, err = im.nsClient.DeleteDevice(ctx, &ns.DeleteDeviceRequest{DevEui: devEUI[:]})
+			return err
+		}})
+}
+
+func (im *importer) createdGateway(mac lorawan.EUI64, mxpCli pb.GSGatewayServiceClient) {
+	im.created = append(im.created, createdObject{kind: "gateway", id: mac.String(),
+		delete: func(ctx context.Context) error {
+			_, err := mxpCli.DeleteGatewayInM2MServer(ctx, &pb.DeleteGatewayInM2MServerRequest{MacAddress: mac.String()})
+			if err != nil && status.Code(err) != codes.NotFound {
+				return err
+			}
+			_, err = im.nsClient.DeleteGateway(ctx, &ns.DeleteGatewayRequest{Id: mac[:]})
+			return err
+		}})
+}
+
+func (im *importer) createdMulticastGroup(id uuid.UUID) {
+	im.created = append(im.created, createdObject{kind: "multicast-group", id: id.String(),
+		delete: func(ctx context.Context) error {
+			_, err := im.nsClient.DeleteMulticastGroup(ctx, &ns.DeleteMulticastGroupRequest{Id: id.Bytes()})
+			return err
+		}})
+}
+
+// checkConflicts finds the objects of the archive that can't be imported as
+// is, it returns true if the organization name is taken
+func (im *importer) checkConflicts(ctx context.Context, a *Archive, name string) (bool, error) {
+	nameExists, err := im.st.OrganizationNameExists(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if nameExists {
+		im.conflict("organization", name, "organization with this name already exists")
+	}
+	for _, u := range a.Users {
+		_, err := im.st.GetUserByEmail(ctx, u.Email)
+		if errors.Is(err, errHandler.ErrDoesNotExist) {
+			im.missingUsers[u.Email] = true
+			im.conflict("user", u.Email, "user does not exist, skipped")
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+	for _, d := range a.Devices {
+		_, err := im.st.GetDevice(ctx, d.DevEUI, false)
+		if err == nil {
+			im.skipDevices[d.DevEUI] = true
+			im.conflict("device", d.DevEUI.String(), "device already exists, skipped")
+			continue
+		}
+		if !errors.Is(err, errHandler.ErrDoesNotExist) {
+			return false, err
+		}
+	}
+	for _, gw := range a.Gateways {
+		_, err := im.st.GetGateway(ctx, gw.MAC, false)
+		if err == nil {
+			im.skipGateways[gw.MAC] = true
+			im.conflict("gateway", gw.MAC.String(), "gateway already exists, skipped")
+			continue
+		}
+		if !errors.Is(err, errHandler.ErrDoesNotExist) {
+			return false, err
+		}
+		if err := im.resolveGatewayProfile(ctx, gw); err != nil {
+			return false, err
+		}
+	}
+	return nameExists, nil
+}
+
+// resolveGatewayProfile finds the gateway-profile for the gateway at the
+// target network-server. The gateway-profiles are not exported as they are
+// shared by the organizations, so the profile with the same ID is used if it
+// exists at the network-server, otherwise the default one.
+func (im *importer) resolveGatewayProfile(ctx context.Context, gw Gateway) error {
+	if gw.Gateway == nil || len(gw.Gateway.GatewayProfileId) == 0 {
+		return nil
+	}
+	gpID, err := uuid.FromBytes(gw.Gateway.GatewayProfileId)
+	if err != nil {
+		return fmt.Errorf("%w: gateway %s has invalid gateway-profile", ErrInvalidArchive, gw.MAC)
+	}
+	if _, ok := im.gatewayProfiles[gpID]; ok {
+		return nil
+	}
+	gp, err := im.st.GetGatewayProfile(ctx, gpID)
+	if err == nil && gp.NetworkServerID == im.n.ID {
+		im.gatewayProfiles[gpID] = gpID.Bytes()
+		return nil
+	}
+	if err != nil && !errors.Is(err, errHandler.ErrDoesNotExist) {
+		return err
+	}
+	defID, defNSID, err := im.st.GetDefaultGatewayProfile(ctx)
+	if err != nil && !errors.Is(err, errHandler.ErrDoesNotExist) {
+		return err
+	}
+	if err == nil && defNSID == im.n.ID {
+		im.gatewayProfiles[gpID] = defID.Bytes()
+		im.conflict("gateway-profile", gpID.String(), "gateway-profile not found, default gateway-profile is used")
+		return nil
+	}
+	im.gatewayProfiles[gpID] = nil
+	im.conflict("gateway-profile", gpID.String(), "gateway-profile not found, gateways have no gateway-profile")
+	return nil
+}
+
+func (im *importer) run(ctx context.Context, a *Archive, name string) error {
+	org := orgd.Organization{
+		Name:            name,
+		DisplayName:     a.Organization.DisplayName,
+		CanHaveGateways: a.Organization.CanHaveGateways,
+		MaxDeviceCount:  a.Organization.MaxDeviceCount,
+		MaxGatewayCount: a.Organization.MaxGatewayCount,
+	}
+	if err := im.st.CreateOrganization(ctx, &org); err != nil {
+		return err
+	}
+	im.result.OrganizationID = org.ID
+
+	for _, f := range []func(context.Context, *Archive) error{
+		im.importUsers,
+		im.importServiceProfiles,
+		im.importDeviceProfiles,
+		im.importApplications,
+		im.importDevices,
+		im.importGateways,
+		im.importMulticastGroups,
+	} {
+		if err := f(ctx, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (im *importer) importUsers(ctx context.Context, a *Archive) error {
+	orgID := im.result.OrganizationID
+	for _, r := range a.Roles {
+		perms, err := auth.ParsePermissions(r.Permissions)
+		if err != nil {
+			im.conflict("role", r.Name, fmt.Sprintf("%v, skipped", err))
+			continue
+		}
+		nr := role.Role{
+			OrganizationID: orgID,
+			Name:           r.Name,
+			Description:    r.Description,
+			Permissions:    perms,
+		}
+		if err := im.st.CreateOrganizationRole(ctx, &nr); err != nil {
+			return fmt.Errorf("create role %s error: %w", r.Name, err)
+		}
+		im.roles[r.ID] = nr.ID
+	}
+	for _, u := range a.Users {
+		if im.missingUsers[u.Email] {
+			continue
+		}
+		user, err := im.st.GetUserByEmail(ctx, u.Email)
+		if err != nil {
+			return err
+		}
+		err = im.st.CreateOrganizationUser(ctx, orgID, user.ID, u.IsAdmin, u.IsDeviceAdmin, u.IsGatewayAdmin)
+		if err != nil {
+			return fmt.Errorf("add user %s error: %w", u.Email, err)
+		}
+		if u.RoleID == nil {
+			continue
+		}
+		roleID, ok := im.roles[*u.RoleID]
+		if !ok {
+			im.conflict("user", u.Email, "role not imported, built-in role is used")
+			continue
+		}
+		if err := im.st.SetOrganizationUserRole(ctx, orgID, user.ID, &roleID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (im *importer) importServiceProfiles(ctx context.Context, a *Archive) error {
+	for _, esp := range a.ServiceProfiles {
+		if esp.ServiceProfile == nil {
+			im.conflict("service-profile", esp.ID.String(), "network-server part is missing, skipped")
+			continue
+		}
+		sp := spd.ServiceProfile{
+			NetworkServerID: im.n.ID,
+			OrganizationID:  im.result.OrganizationID,
+			Name:            esp.Name,
+			ServiceProfile:  *esp.ServiceProfile,
+		}
+		id, err := spmod.CreateServiceProfile(ctx, im.st, &sp, im.nsCli)
+		if err != nil {
+			return fmt.Errorf("create service-profile %s error: %w", esp.Name, err)
+		}
+		im.createdServiceProfile(*id)
+		im.serviceProfiles[esp.ID] = *id
+	}
+	return nil
+}
+
+func (im *importer) importDeviceProfiles(ctx context.Context, a *Archive) error {
+	for _, edp := range a.DeviceProfiles {
+		if edp.DeviceProfile == nil {
+			im.conflict("device-profile", edp.ID.String(), "network-server part is missing, skipped")
+			continue
+		}
+		dp := dps.DeviceProfile{
+			NetworkServerID:      im.n.ID,
+			OrganizationID:       im.result.OrganizationID,
+			Name:                 edp.Name,
+			PayloadCodec:         edp.PayloadCodec,
+			PayloadEncoderScript: edp.PayloadEncoderScript,
+			PayloadDecoderScript: edp.PayloadDecoderScript,
+			Tags:                 mapToHstore(edp.Tags),
+			UplinkInterval:       edp.UplinkInterval,
+			DeviceProfile:        *edp.DeviceProfile,
+		}
+		if err := dps.CreateDeviceProfile(ctx, im.st, im.nsCli, &dp); err != nil {
+			return fmt.Errorf("create device-profile %s error: %w", edp.Name, err)
+		}
+		id, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		if err != nil {
+			return err
+		}
+		im.createdDeviceProfile(id)
+		im.deviceProfiles[edp.ID] = id
+	}
+	return nil
+}
+
+func (im *importer) importApplications(ctx context.Context, a *Archive) error {
+	for _, ea := range a.Applications {
+		spID, ok := im.serviceProfiles[ea.ServiceProfileID]
+		if !ok {
+			im.conflict("application", ea.Name, "service-profile not imported, skipped")
+			continue
+		}
+		app := appd.Application{
+			Name:                 ea.Name,
+			Description:          ea.Description,
+			OrganizationID:       im.result.OrganizationID,
+			ServiceProfileID:     spID,
+			PayloadCodec:         ea.PayloadCodec,
+			PayloadEncoderScript: ea.PayloadEncoderScript,
+			PayloadDecoderScript: ea.PayloadDecoderScript,
+		}
+		if err := im.st.CreateApplication(ctx, &app); err != nil {
+			return fmt.Errorf("create application %s error: %w", ea.Name, err)
+		}
+		for _, i := range ea.Integrations {
+			err := im.st.CreateIntegration(ctx, &appd.Integration{
+				ApplicationID: app.ID,
+				Kind:          i.Kind,
+				Settings:      i.Settings,
+			})
+			if err != nil {
+				return fmt.Errorf("create %s integration of application %s error: %w", i.Kind, ea.Name, err)
+			}
+		}
+		im.applications[ea.ID] = app
+	}
+	return nil
+}
+
+func (im *importer) importDevices(ctx context.Context, a *Archive) error {
+	mxpCli := im.mxpCli.GetM2MDeviceServiceClient()
+	for _, ed := range a.Devices {
+		if im.skipDevices[ed.DevEUI] {
+			continue
+		}
+		app, ok := im.applications[ed.ApplicationID]
+		if !ok {
+			im.conflict("device", ed.DevEUI.String(), "application not imported, skipped")
+			continue
+		}
+		dpID, ok := im.deviceProfiles[ed.DeviceProfileID]
+		if !ok {
+			im.conflict("device", ed.DevEUI.String(), "device-profile not imported, skipped")
+			continue
+		}
+		d := devd.Device{
+			DevEUI:            ed.DevEUI,
+			ApplicationID:     app.ID,
+			DeviceProfileID:   dpID,
+			Name:              ed.Name,
+			Description:       ed.Description,
+			SkipFCntCheck:     ed.SkipFCntCheck,
+			ReferenceAltitude: ed.ReferenceAltitude,
+			Latitude:          ed.Latitude,
+			Longitude:         ed.Longitude,
+			Altitude:          ed.Altitude,
+			Variables:         mapToHstore(ed.Variables),
+			Tags:              mapToHstore(ed.Tags),
+			Model:             ed.Model,
+			SerialNumber:      ed.SerialNumber,
+			Manufacturer:      ed.Manufacturer,
+		}
+		// the device may be created at m2m and the network-server even if
+		// the creation fails later
+		im.createdDevice(d.DevEUI, mxpCli)
+		if err := device.CreateDevice(ctx, im.st, &d, &app, im.applicationServerID, mxpCli, im.nsClient); err != nil {
+			return fmt.Errorf("create device %s error: %w", ed.DevEUI, err)
+		}
+		if ed.IsDisabled {
+			_, err := im.nsClient.UpdateDevice(ctx, &ns.UpdateDeviceRequest{
+				Device: &ns.Device{
+					DevEui:            d.DevEUI[:],
+					DeviceProfileId:   dpID.Bytes(),
+					ServiceProfileId:  app.ServiceProfileID.Bytes(),
+					RoutingProfileId:  im.applicationServerID.Bytes(),
+					SkipFCntCheck:     d.SkipFCntCheck,
+					ReferenceAltitude: d.ReferenceAltitude,
+					IsDisabled:        true,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("disable device %s error: %w", ed.DevEUI, err)
+			}
+		}
+		if ed.Keys != nil {
+			err := im.st.CreateDeviceKeys(ctx, &devd.DeviceKeys{
+				DevEUI:    ed.DevEUI,
+				NwkKey:    ed.Keys.NwkKey,
+				AppKey:    ed.Keys.AppKey,
+				GenAppKey: ed.Keys.GenAppKey,
+				JoinNonce: ed.Keys.JoinNonce,
+			})
+			if err != nil {
+				return fmt.Errorf("create device %s keys error: %w", ed.DevEUI, err)
+			}
+		}
+		if act := ed.Activation; act != nil {
+			if err := im.st.UpdateDeviceActivation(ctx, ed.DevEUI, act.DevAddr, act.AppSKey); err != nil {
+				return err
+			}
+			_, err := im.nsClient.ActivateDevice(ctx, &ns.ActivateDeviceRequest{
+				DeviceActivation: &ns.DeviceActivation{
+					DevEui:      ed.DevEUI[:],
+					DevAddr:     act.DevAddr[:],
+					NwkSEncKey:  act.NwkSEncKey[:],
+					SNwkSIntKey: act.SNwkSIntKey[:],
+					FNwkSIntKey: act.FNwkSIntKey[:],
+					FCntUp:      act.FCntUp,
+					NFCntDown:   act.NFCntDown,
+					AFCntDown:   act.AFCntDown,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("activate device %s error: %w", ed.DevEUI, err)
+			}
+		}
+		im.devices[ed.DevEUI] = true
+	}
+	return nil
+}
+
+func (im *importer) importGateways(ctx context.Context, a *Archive) error {
+	mxpCli := im.mxpCli.GetM2MGatewayServiceClient()
+	for _, eg := range a.Gateways {
+		if im.skipGateways[eg.MAC] {
+			continue
+		}
+		gw := gwd.Gateway{
+			MAC:                eg.MAC,
+			Name:               eg.Name,
+			Description:        eg.Description,
+			OrganizationID:     im.result.OrganizationID,
+			Ping:               eg.Ping,
+			NetworkServerID:    im.n.ID,
+			Latitude:           eg.Latitude,
+			Longitude:          eg.Longitude,
+			Altitude:           eg.Altitude,
+			Tags:               mapToHstore(eg.Tags),
+			Metadata:           mapToHstore(eg.Metadata),
+			Model:              eg.Model,
+			Config:             eg.Config,
+			SerialNumber:       eg.SerialNumber,
+			FirmwareHash:       types.MD5SUM{},
+			AutoUpdateFirmware: eg.AutoUpdateFirmware,
+		}
+		createReq := ns.CreateGatewayRequest{
+			Gateway: &ns.Gateway{
+				Id: eg.MAC[:],
+				Location: &common.Location{
+					Latitude:  eg.Latitude,
+					Longitude: eg.Longitude,
+					Altitude:  eg.Altitude,
+				},
+				RoutingProfileId: im.applicationServerID.Bytes(),
+			},
+		}
+		if eg.Gateway != nil {
+			createReq.Gateway.Boards = eg.Gateway.Boards
+			if len(eg.Gateway.GatewayProfileId) != 0 {
+				gpID, err := uuid.FromBytes(eg.Gateway.GatewayProfileId)
+				if err != nil {
+					return err
+				}
+				if id := im.gatewayProfiles[gpID]; id != nil {
+					createReq.Gateway.GatewayProfileId = id
+					gpIDStr := uuid.FromBytesOrNil(id).String()
+					gw.GatewayProfileID = &gpIDStr
+				}
+			}
+		}
+		im.createdGateway(gw.MAC, mxpCli)
+		if err := gatewaymod.AddGateway(ctx, im.st.PgStore, &gw, createReq, mxpCli, im.nsCli); err != nil {
+			return fmt.Errorf("create gateway %s error: %w", eg.MAC, err)
+		}
+	}
+	return nil
+}
+
+func (im *importer) importMulticastGroups(ctx context.Context, a *Archive) error {
+	for _, emg := range a.MulticastGroups {
+		spID, ok := im.serviceProfiles[emg.ServiceProfileID]
+		if !ok || emg.MulticastGroup == nil {
+			im.conflict("multicast-group", emg.ID.String(), "service-profile not imported, skipped")
+			continue
+		}
+		mg := mgd.MulticastGroup{
+			Name:             emg.Name,
+			MCAppSKey:        emg.MCAppSKey,
+			MCKey:            emg.MCKey,
+			ServiceProfileID: spID,
+			MulticastGroup: ns.MulticastGroup{
+				McAddr:           emg.MulticastGroup.McAddr,
+				McNwkSKey:        emg.MulticastGroup.McNwkSKey,
+				FCnt:             emg.MulticastGroup.FCnt,
+				GroupType:        emg.MulticastGroup.GroupType,
+				Dr:               emg.MulticastGroup.Dr,
+				Frequency:        emg.MulticastGroup.Frequency,
+				PingSlotPeriod:   emg.MulticastGroup.PingSlotPeriod,
+				ServiceProfileId: spID.Bytes(),
+				RoutingProfileId: im.applicationServerID.Bytes(),
+			},
+		}
+		if err := im.st.CreateMulticastGroup(ctx, &mg); err != nil {
+			return fmt.Errorf("create multicast-group %s error: %w", emg.Name, err)
+		}
+		_, err := im.nsClient.CreateMulticastGroup(ctx, &ns.CreateMulticastGroupRequest{
+			MulticastGroup: &mg.MulticastGroup,
+		})
+		if err != nil {
+			return fmt.Errorf("create multicast-group %s error: %w", emg.Name, err)
+		}
+		mgID, err := uuid.FromBytes(mg.MulticastGroup.Id)
+		if err != nil {
+			return err
+		}
+		im.createdMulticastGroup(mgID)
+		for _, devEUI := range emg.DevEUIs {
+			if !im.devices[devEUI] {
+				continue
+			}
+			if err := im.st.AddDeviceToMulticastGroup(ctx, mgID, devEUI); err != nil {
+				return err
+			}
+			_, err := im.nsClient.AddDeviceToMulticastGroup(ctx, &ns.AddDeviceToMulticastGroupRequest{
+				DevEui:           devEUI[:],
+				MulticastGroupId: mgID.Bytes(),
+			})
+			if err != nil {
+				return fmt.Errorf("add device %s to multicast-group %s error: %w", devEUI, emg.Name, err)
+			}
+		}
+	}
+	return nil
+}
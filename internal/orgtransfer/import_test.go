@@ -0,0 +1,100 @@
+package orgtransfer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/brocaar/chirpstack-api/go/v3/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
+)
+
+// deleteNSClient records the objects deleted at the network-server
+type deleteNSClient struct {
+	ns.NetworkServerServiceClient
+	deleted []string
+}
+
+func (c *deleteNSClient) DeleteServiceProfile(ctx context.Context, in *ns.DeleteServiceProfileRequest,
+	opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.deleted = append(c.deleted, "service-profile")
+	return nil, errors.New("network-server is unavailable")
+}
+
+func (c *deleteNSClient) DeleteDeviceProfile(ctx context.Context, in *ns.DeleteDeviceProfileRequest,
+	opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.deleted = append(c.deleted, "device-profile")
+	return &empty.Empty{}, nil
+}
+
+func (c *deleteNSClient) DeleteDevice(ctx context.Context, in *ns.DeleteDeviceRequest,
+	opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.deleted = append(c.deleted, "device")
+	return nil, status.Error(codes.NotFound, "object does not exist")
+}
+
+func (c *deleteNSClient) DeleteGateway(ctx context.Context, in *ns.DeleteGatewayRequest,
+	opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.deleted = append(c.deleted, "gateway")
+	return &empty.Empty{}, nil
+}
+
+func (c *deleteNSClient) DeleteMulticastGroup(ctx context.Context, in *ns.DeleteMulticastGroupRequest,
+	opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.deleted = append(c.deleted, "multicast-group")
+	return &empty.Empty{}, nil
+}
+
+type deleteM2MClient struct {
+	pb.DSDeviceServiceClient
+	pb.GSGatewayServiceClient
+	deleted []string
+}
+
+func (c *deleteM2MClient) DeleteDeviceInM2MServer(ctx context.Context, in *pb.DeleteDeviceInM2MServerRequest,
+	opts ...grpc.CallOption) (*pb.DeleteDeviceInM2MServerResponse, error) {
+	c.deleted = append(c.deleted, in.DevEui)
+	return &pb.DeleteDeviceInM2MServerResponse{}, nil
+}
+
+func (c *deleteM2MClient) DeleteGatewayInM2MServer(ctx context.Context, in *pb.DeleteGatewayInM2MServerRequest,
+	opts ...grpc.CallOption) (*pb.DeleteGatewayInM2MServerResponse, error) {
+	c.deleted = append(c.deleted, in.MacAddress)
+	return &pb.DeleteGatewayInM2MServerResponse{}, nil
+}
+
+func TestDeleteCreated(t *testing.T) {
+	nsClient := &deleteNSClient{}
+	m2m := &deleteM2MClient{}
+	im := &importer{nsClient: nsClient}
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	mac := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+	im.createdServiceProfile(uuid.Must(uuid.NewV4()))
+	im.createdDeviceProfile(uuid.Must(uuid.NewV4()))
+	im.createdDevice(devEUI, m2m)
+	im.createdGateway(mac, m2m)
+	im.createdMulticastGroup(uuid.Must(uuid.NewV4()))
+	im.deleteCreated(context.Background())
+
+	// the objects are deleted in the reverse order, the failed deletion
+	// doesn't stop the cleanup
+	expected := []string{"multicast-group", "gateway", "device", "device-profile", "service-profile"}
+	if !reflect.DeepEqual(nsClient.deleted, expected) {
+		t.Errorf("expected the network-server deletions %v, got %v", expected, nsClient.deleted)
+	}
+	if expected := []string{mac.String(), devEUI.String()}; !reflect.DeepEqual(m2m.deleted, expected) {
+		t.Errorf("expected the m2m deletions %v, got %v", expected, m2m.deleted)
+	}
+	if len(im.created) != 0 {
+		t.Errorf("expected the created objects to be cleared, got %d", len(im.created))
+	}
+}
@@ -0,0 +1,117 @@
+// Package price provides the prices of crypto currencies in fiat currencies
+// retrieved from a chain of price providers and keeps the history of daily
+// close prices
+package price
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/coingecko"
+	"github.com/mxc-foundation/lpwan-app-server/internal/cryptocompare"
+)
+
+// Supported price providers
+const (
+	ProviderCoinGecko     = "coingecko"
+	ProviderCryptoCompare = "cryptocompare"
+)
+
+// Config contains configuration of the price service
+type Config struct {
+	// Providers is the list of price providers in the order in which they
+	// are queried, coingecko if not set
+	Providers []string `mapstructure:"providers"`
+	// CryptoCompareAPIKey is the API key used for requests to CryptoCompare
+	CryptoCompareAPIKey string `mapstructure:"cryptocompare_api_key"`
+	// HistoryPairs is the list of pairs, e.g. mxc/usd, for which the daily
+	// close prices are recorded
+	HistoryPairs []string `mapstructure:"history_pairs"`
+	// FallbackMaxAge is the max age of the recorded close price that is
+	// returned as the current price if none of the providers is available
+	FallbackMaxAge time.Duration `mapstructure:"fallback_max_age"`
+}
+
+// Provider is the source of the prices of crypto currencies
+type Provider interface {
+	// GetPrice returns current price of the crypto currency in the units of
+	// the fiat currency
+	GetPrice(crypto, fiat string) (float64, error)
+	// GetClosePrice returns the close price of the crypto currency in the
+	// units of the fiat currency on the given UTC day
+	GetClosePrice(crypto, fiat string, day time.Time) (float64, error)
+}
+
+// NewProvider creates the provider with the given name
+func NewProvider(name string, cfg Config) (Provider, error) {
+	switch name {
+	case ProviderCoinGecko:
+		return coingecko.New(), nil
+	case ProviderCryptoCompare:
+		return cryptocompare.New(cfg.CryptoCompareAPIKey), nil
+	}
+	return nil, fmt.Errorf("unknown price provider: %s", name)
+}
+
+type namedProvider struct {
+	name string
+	Provider
+}
+
+// Chain queries the providers in order and returns the first price
+// retrieved
+type Chain struct {
+	providers []namedProvider
+}
+
+// NewChain creates a new chain of the providers
+func NewChain() *Chain {
+	return &Chain{}
+}
+
+// Add adds the provider to the end of the chain
+func (c *Chain) Add(name string, p Provider) *Chain {
+	c.providers = append(c.providers, namedProvider{name: name, Provider: p})
+	return c
+}
+
+// GetPrice returns current price of the crypto currency in the units of the
+// fiat currency from the first provider that returns it
+func (c *Chain) GetPrice(crypto, fiat string) (float64, error) {
+	price, _, err := c.query(func(p Provider) (float64, error) {
+		return p.GetPrice(crypto, fiat)
+	})
+	return price, err
+}
+
+// GetClosePrice returns the close price of the crypto currency in the units
+// of the fiat currency on the given day from the first provider that returns
+// it
+func (c *Chain) GetClosePrice(crypto, fiat string, day time.Time) (float64, error) {
+	price, _, err := c.getClosePrice(crypto, fiat, day)
+	return price, err
+}
+
+func (c *Chain) getClosePrice(crypto, fiat string, day time.Time) (float64, string, error) {
+	return c.query(func(p Provider) (float64, error) {
+		return p.GetClosePrice(crypto, fiat, day)
+	})
+}
+
+// query returns the price and the name of the provider that returned it, or
+// the errors of all the providers
+func (c *Chain) query(get func(p Provider) (float64, error)) (float64, string, error) {
+	if len(c.providers) == 0 {
+		return 0, "", fmt.Errorf("no price providers configured")
+	}
+	var errs []string
+	for _, p := range c.providers {
+		price, err := get(p.Provider)
+		if err == nil {
+			return price, p.name, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", p.name, err))
+	}
+	return 0, "", fmt.Errorf("all price providers failed: %s", strings.Join(errs, "; "))
+}
@@ -0,0 +1,143 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+)
+
+type testProvider struct {
+	prices      map[string]float64
+	closePrices map[string]float64
+	requests    int
+}
+
+func (tp *testProvider) GetPrice(crypto, fiat string) (float64, error) {
+	tp.requests++
+	if price, ok := tp.prices[crypto+"/"+fiat]; ok {
+		return price, nil
+	}
+	return 0, fmt.Errorf("rate limited")
+}
+
+func (tp *testProvider) GetClosePrice(crypto, fiat string, day time.Time) (float64, error) {
+	tp.requests++
+	if price, ok := tp.closePrices[crypto+"/"+fiat+"/"+day.Format("2006-01-02")]; ok {
+		return price, nil
+	}
+	return 0, fmt.Errorf("rate limited")
+}
+
+type testStore struct {
+	prices map[string]ClosePrice
+}
+
+func (ts *testStore) GetClosePrice(ctx context.Context, crypto, fiat string, day time.Time) (ClosePrice, error) {
+	if cp, ok := ts.prices[crypto+"/"+fiat+"/"+day.Format("2006-01-02")]; ok {
+		return cp, nil
+	}
+	return ClosePrice{}, errHandler.ErrDoesNotExist
+}
+
+func (ts *testStore) GetLatestClosePrice(ctx context.Context, crypto, fiat string) (ClosePrice, error) {
+	var latest *ClosePrice
+	for _, cp := range ts.prices {
+		cp := cp
+		if cp.Crypto == crypto && cp.Fiat == fiat && (latest == nil || cp.Day.After(latest.Day)) {
+			latest = &cp
+		}
+	}
+	if latest == nil {
+		return ClosePrice{}, errHandler.ErrDoesNotExist
+	}
+	return *latest, nil
+}
+
+func (ts *testStore) SetClosePrice(ctx context.Context, cp ClosePrice) error {
+	ts.prices[cp.Crypto+"/"+cp.Fiat+"/"+cp.Day.Format("2006-01-02")] = cp
+	return nil
+}
+
+func TestChain(t *testing.T) {
+	first := &testProvider{prices: map[string]float64{"mxc/usd": 0.02}}
+	second := &testProvider{prices: map[string]float64{"mxc/usd": 0.03, "mxc/eur": 0.025}}
+	chain := NewChain().Add("first", first).Add("second", second)
+
+	price, err := chain.GetPrice("mxc", "usd")
+	if err != nil || price != 0.02 {
+		t.Errorf("expected price from the first provider, got %f, %v", price, err)
+	}
+	if second.requests != 0 {
+		t.Errorf("second provider should not be queried")
+	}
+	price, err = chain.GetPrice("mxc", "eur")
+	if err != nil || price != 0.025 {
+		t.Errorf("expected price from the second provider, got %f, %v", price, err)
+	}
+	if _, err := chain.GetPrice("btc", "usd"); err == nil {
+		t.Errorf("expected error if all providers fail")
+	}
+	if _, err := NewChain().GetPrice("mxc", "usd"); err == nil {
+		t.Errorf("expected error for empty chain")
+	}
+}
+
+func TestService(t *testing.T) {
+	yesterday := time.Now().UTC().AddDate(0, 0, -1)
+	day := time.Date(yesterday.Year(), yesterday.Month(), yesterday.Day(), 0, 0, 0, 0, time.UTC)
+	provider := &testProvider{
+		prices:      map[string]float64{},
+		closePrices: map[string]float64{"mxc/usd/" + day.Format("2006-01-02"): 0.021},
+	}
+	store := &testStore{prices: make(map[string]ClosePrice)}
+	srv, err := NewWithChain(Config{HistoryPairs: []string{"mxc/usd", "dhx/usd"}},
+		NewChain().Add("test", provider), store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// current price is not available and nothing is recorded
+	if _, err := srv.GetPrice("mxc", "usd"); err == nil {
+		t.Errorf("expected error when there's no price")
+	}
+
+	srv.recordClosePrices(context.Background(), time.Now())
+	cp, err := store.GetClosePrice(context.Background(), "mxc", "usd", day)
+	if err != nil {
+		t.Fatalf("close price has not been recorded: %v", err)
+	}
+	if cp.Price != 0.021 || cp.Source != "test" {
+		t.Errorf("unexpected close price: %+v", cp)
+	}
+	if _, err := store.GetClosePrice(context.Background(), "dhx", "usd", day); err == nil {
+		t.Errorf("expected missing dhx/usd price not to be recorded")
+	}
+
+	// recorded price is used without querying the providers
+	requests := provider.requests
+	price, err := srv.GetClosePrice(context.Background(), "mxc", "usd", day.Add(5*time.Hour))
+	if err != nil || price != 0.021 {
+		t.Errorf("expected recorded price, got %f, %v", price, err)
+	}
+	if provider.requests != requests {
+		t.Errorf("expected no requests to providers")
+	}
+
+	// recent close price is used when the providers are not available
+	price, err = srv.GetPrice("mxc", "usd")
+	if err != nil || price != 0.021 {
+		t.Errorf("expected fallback to recorded price, got %f, %v", price, err)
+	}
+
+	// close price of today is not known
+	if _, err := srv.GetClosePrice(context.Background(), "mxc", "usd", time.Now()); err == nil {
+		t.Errorf("expected error for today's close price")
+	}
+
+	if _, err := NewWithChain(Config{HistoryPairs: []string{"mxc"}}, NewChain(), store); err == nil {
+		t.Errorf("expected error for invalid pair")
+	}
+}
@@ -0,0 +1,189 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
+)
+
+const defaultFallbackMaxAge = 48 * time.Hour
+
+// ClosePrice is the recorded close price of the crypto currency on the day
+type ClosePrice struct {
+	Crypto string    `db:"crypto"`
+	Fiat   string    `db:"fiat"`
+	Day    time.Time `db:"day"`
+	Price  float64   `db:"price"`
+	// Source is the name of the provider the price was retrieved from
+	Source    string    `db:"source"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Store is the DB interface
+type Store interface {
+	// GetClosePrice returns the recorded close price of the crypto currency
+	// on the day
+	GetClosePrice(ctx context.Context, crypto, fiat string, day time.Time) (ClosePrice, error)
+	// GetLatestClosePrice returns the latest recorded close price of the
+	// crypto currency
+	GetLatestClosePrice(ctx context.Context, crypto, fiat string) (ClosePrice, error)
+	// SetClosePrice records the close price of the crypto currency on the
+	// day, the recorded price is never modified
+	SetClosePrice(ctx context.Context, cp ClosePrice) error
+}
+
+type pair struct {
+	crypto string
+	fiat   string
+}
+
+// Service provides the prices of crypto currencies retrieved from the chain
+// of providers and records the daily close prices
+type Service struct {
+	chain  *Chain
+	store  Store
+	maxAge time.Duration
+	pairs  []pair
+	done   chan struct{}
+}
+
+// New creates the price service using the providers listed in the config
+func New(cfg Config, store Store) (*Service, error) {
+	names := cfg.Providers
+	if len(names) == 0 {
+		names = []string{ProviderCoinGecko}
+	}
+	chain := NewChain()
+	for _, name := range names {
+		p, err := NewProvider(name, cfg)
+		if err != nil {
+			return nil, err
+		}
+		chain.Add(name, p)
+	}
+	return NewWithChain(cfg, chain, store)
+}
+
+// NewWithChain creates the price service using the given chain of providers
+func NewWithChain(cfg Config, chain *Chain, store Store) (*Service, error) {
+	srv := &Service{
+		chain:  chain,
+		store:  store,
+		maxAge: cfg.FallbackMaxAge,
+	}
+	if srv.maxAge == 0 {
+		srv.maxAge = defaultFallbackMaxAge
+	}
+	for _, p := range cfg.HistoryPairs {
+		parts := strings.Split(p, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid currency pair: %s", p)
+		}
+		srv.pairs = append(srv.pairs, pair{crypto: parts[0], fiat: parts[1]})
+	}
+	return srv, nil
+}
+
+// Start starts recording of the daily close prices of the configured pairs
+func (srv *Service) Start() {
+	if len(srv.pairs) == 0 {
+		return
+	}
+	srv.done = make(chan struct{})
+	go srv.run()
+}
+
+// Stop stops recording of the close prices
+func (srv *Service) Stop() {
+	if srv != nil && srv.done != nil {
+		srv.done <- struct{}{}
+		close(srv.done)
+	}
+}
+
+// leaderJob is the name of the job in the leader election
+const leaderJob = "price-history"
+
+func (srv *Service) run() {
+	for {
+		wait := time.Until(time.Now().Truncate(time.Hour).Add(time.Hour))
+		select {
+		case <-time.After(wait):
+			if !leader.IsLeader(leaderJob) {
+				continue
+			}
+			srv.recordClosePrices(context.Background(), time.Now())
+		case <-srv.done:
+			return
+		}
+	}
+}
+
+// recordClosePrices makes sure that the close prices of the previous day are
+// recorded for all the configured pairs
+func (srv *Service) recordClosePrices(ctx context.Context, now time.Time) {
+	yesterday := now.UTC().AddDate(0, 0, -1)
+	for _, p := range srv.pairs {
+		if _, err := srv.GetClosePrice(ctx, p.crypto, p.fiat, yesterday); err != nil {
+			logrus.Warnf("couldn't record close price of %s/%s: %v", p.crypto, p.fiat, err)
+		}
+	}
+}
+
+// GetPrice returns current price of the crypto currency in the units of the
+// fiat currency. If none of the providers is available the latest recorded
+// close price is returned if it's not too old
+func (srv *Service) GetPrice(crypto, fiat string) (float64, error) {
+	price, err := srv.chain.GetPrice(crypto, fiat)
+	if err == nil {
+		return price, nil
+	}
+	cp, serr := srv.store.GetLatestClosePrice(context.Background(), crypto, fiat)
+	if serr != nil || time.Since(cp.Day.AddDate(0, 0, 1)) > srv.maxAge {
+		return 0, err
+	}
+	logrus.Warnf("using close price of %s for %s/%s: %v", cp.Day.Format("2006-01-02"), crypto, fiat, err)
+	return cp.Price, nil
+}
+
+// GetClosePrice returns the close price of the crypto currency in the units
+// of the fiat currency on the given UTC day. The price is taken from the
+// history, if it hasn't been recorded yet it is retrieved from the providers
+// and recorded
+func (srv *Service) GetClosePrice(ctx context.Context, crypto, fiat string, day time.Time) (float64, error) {
+	day = day.UTC()
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	if !day.AddDate(0, 0, 1).Before(time.Now()) {
+		return 0, fmt.Errorf("close price for %s is not known yet", day.Format("2006-01-02"))
+	}
+
+	cp, err := srv.store.GetClosePrice(ctx, crypto, fiat, day)
+	if err == nil {
+		return cp.Price, nil
+	}
+	if !errors.Is(err, errHandler.ErrDoesNotExist) {
+		return 0, fmt.Errorf("couldn't get recorded close price: %v", err)
+	}
+
+	price, source, err := srv.chain.getClosePrice(crypto, fiat, day)
+	if err != nil {
+		return 0, err
+	}
+	if err := srv.store.SetClosePrice(ctx, ClosePrice{
+		Crypto: crypto,
+		Fiat:   fiat,
+		Day:    day,
+		Price:  price,
+		Source: source,
+	}); err != nil {
+		return 0, fmt.Errorf("couldn't record close price: %v", err)
+	}
+	return price, nil
+}
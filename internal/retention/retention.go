@@ -0,0 +1,222 @@
+// Package retention removes the data of the organizations older than the
+// TTLs set by their data retention policies and erases the personal data of
+// the users
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/sirupsen/logrus"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/devicedata"
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
+)
+
+// Kinds of the data besides the device event types of devicedata
+const (
+	// KindDeviceActivation is the history of the device activations, the
+	// latest activation of every device is kept
+	KindDeviceActivation = "device_activation"
+	// KindFUOTADeployment are the FUOTA deployments not updated within TTL
+	KindFUOTADeployment = "fuota_deployment"
+	// KindGatewayMetrics are the metrics of the gateways stored in Redis. It
+	// is not a kind of the data with TTL as the metrics of every aggregation
+	// interval already expire after the TTL set in the metrics configuration
+	// for all the organizations, it is only removed by the erasure.
+	KindGatewayMetrics = "gateway_metrics"
+)
+
+// Kinds is the list of all the kinds of the data with TTL
+var Kinds = append(append([]string{}, devicedata.Types...), KindDeviceActivation, KindFUOTADeployment)
+
+const (
+	// MinTTL is the shortest TTL allowed, the data is removed once per hour
+	MinTTL = time.Hour
+	// MaxTTL is the longest TTL allowed
+	MaxTTL = 10 * 365 * 24 * time.Hour
+)
+
+// Policy is the TTL of each kind of the data of the organization. The kinds
+// without TTL are kept until the global TTL expires, if any.
+type Policy map[string]time.Duration
+
+// Validate checks that the kinds are known and the TTLs are within limits
+func (p Policy) Validate() error {
+	for kind, ttl := range p {
+		if !isKind(kind) {
+			return fmt.Errorf("unknown kind of data: %s", kind)
+		}
+		if ttl < MinTTL || ttl > MaxTTL {
+			return fmt.Errorf("ttl of %s must be between %s and %s", kind, MinTTL, MaxTTL)
+		}
+	}
+	return nil
+}
+
+func isKind(kind string) bool {
+	for _, k := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Erasure is the result of the erasure of the user data in the database
+type Erasure struct {
+	// Erased is the number of the removed or anonymized records of each kind
+	Erased map[string]int64
+	// ApplicationIDs are the applications of the organizations whose device
+	// data is erased
+	ApplicationIDs []int64
+	// GatewayMACs are the gateways of the organizations whose data is erased
+	GatewayMACs []lorawan.EUI64
+}
+
+// DataEraser removes the data of the erasure kept outside of the application
+// server database, i.e. the device events and the metrics of the gateways,
+// and returns the number of the removed records of each kind
+type DataEraser func(ctx context.Context, erasure Erasure) (map[string]int64, error)
+
+// MetricsStore stores the metrics of the gateways
+type MetricsStore interface {
+	// DeleteGatewayMetrics removes the metrics of the gateway, or only
+	// counts them if dryRun is true, and returns the number of the records
+	DeleteGatewayMetrics(ctx context.Context, mac lorawan.EUI64, dryRun bool) (int64, error)
+}
+
+// Store stores the data retention policies and removes the data
+type Store interface {
+	// GetDataRetentionPolicy returns the policy of the organization
+	GetDataRetentionPolicy(ctx context.Context, orgID int64) (Policy, error)
+	// SetDataRetentionPolicy replaces the policy of the organization
+	SetDataRetentionPolicy(ctx context.Context, orgID int64, policy Policy) error
+	// GetDataRetentionPolicies returns the policies of all the organizations
+	// having any
+	GetDataRetentionPolicies(ctx context.Context) (map[int64]Policy, error)
+	// GetOrganizationApplicationIDs returns the IDs of the applications of
+	// the organization
+	GetOrganizationApplicationIDs(ctx context.Context, orgID int64) ([]int64, error)
+	// DeleteDeviceActivationsBefore deletes the activations of the devices
+	// of the organization created before the given time, except the latest
+	// activation of every device
+	DeleteDeviceActivationsBefore(ctx context.Context, orgID int64, before time.Time) (int64, error)
+	// DeleteFUOTADeploymentsBefore deletes the FUOTA deployments of the
+	// organization not updated since the given time, all the deployments
+	// if it's zero
+	DeleteFUOTADeploymentsBefore(ctx context.Context, orgID int64, before time.Time) (int64, error)
+	// EraseUserData anonymizes the user, removes the personal data of the
+	// user and the device data of the organizations the user is the only
+	// member of, and anonymizes the devices and the gateways of these
+	// organizations. The data outside of the database is removed by
+	// eraseData, if not nil, before the erasure is committed, so if it fails
+	// nothing is erased and the erasure can be repeated. If dryRun is true
+	// nothing is changed, only the number of the records that would be
+	// erased is returned.
+	EraseUserData(ctx context.Context, userID int64, dryRun bool, eraseData DataEraser) (Erasure, error)
+}
+
+// Service removes the data older than the TTLs of the policies
+type Service struct {
+	store      Store
+	deviceData devicedata.Store
+	done       chan struct{}
+}
+
+// Start starts the removal of the expired data. If the device data store is
+// nil, i.e. the PostgreSQL integration is not enabled, only the data in the
+// application server database is removed.
+func Start(store Store, deviceData devicedata.Store) *Service {
+	srv := &Service{
+		store:      store,
+		deviceData: deviceData,
+		done:       make(chan struct{}),
+	}
+	go srv.run()
+	return srv
+}
+
+// Stop stops the service
+func (srv *Service) Stop() {
+	if srv == nil {
+		return
+	}
+	srv.done <- struct{}{}
+	close(srv.done)
+}
+
+// leaderJob is the name of the job in the leader election
+const leaderJob = "data-retention"
+
+func (srv *Service) run() {
+	for {
+		wait := time.Until(time.Now().Truncate(time.Hour).Add(time.Hour))
+		select {
+		case <-time.After(wait):
+			if !leader.IsLeader(leaderJob) {
+				continue
+			}
+			srv.removeExpired(context.Background(), time.Now())
+		case <-srv.done:
+			return
+		}
+	}
+}
+
+// removeExpired removes the data of all the organizations older than the
+// TTLs of their policies
+func (srv *Service) removeExpired(ctx context.Context, now time.Time) {
+	policies, err := srv.store.GetDataRetentionPolicies(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("retention: couldn't get the policies")
+		return
+	}
+	for orgID, policy := range policies {
+		srv.removeExpiredForOrganization(ctx, orgID, policy, now)
+	}
+}
+
+func (srv *Service) removeExpiredForOrganization(ctx context.Context, orgID int64, policy Policy, now time.Time) {
+	log := logrus.WithField("organization_id", orgID)
+	var appIDs []int64
+	if srv.deviceData != nil {
+		var err error
+		if appIDs, err = srv.store.GetOrganizationApplicationIDs(ctx, orgID); err != nil {
+			log.WithError(err).Error("retention: couldn't get the applications")
+			return
+		}
+	}
+	for _, kind := range Kinds {
+		ttl, ok := policy[kind]
+		if !ok {
+			continue
+		}
+		before := now.Add(-ttl)
+		var n int64
+		var err error
+		switch kind {
+		case KindDeviceActivation:
+			n, err = srv.store.DeleteDeviceActivationsBefore(ctx, orgID, before)
+		case KindFUOTADeployment:
+			n, err = srv.store.DeleteFUOTADeploymentsBefore(ctx, orgID, before)
+		default:
+			if len(appIDs) == 0 {
+				continue
+			}
+			n, err = srv.deviceData.DeleteDeviceData(ctx, kind, devicedata.DeleteFilter{
+				ApplicationIDs: appIDs,
+				Before:         before,
+			})
+		}
+		if err != nil {
+			log.WithError(err).Errorf("retention: couldn't remove expired %s", kind)
+			continue
+		}
+		if n > 0 {
+			log.Infof("retention: removed %d expired %s records", n, kind)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	"github.com/mxc-foundation/lpwan-app-server/internal/devicedata"
+)
+
+type testStore struct {
+	policies map[int64]Policy
+	appIDs   map[int64][]int64
+	// before are the times before which the data of each kind was deleted
+	before map[string]time.Time
+	erased map[int64]bool
+}
+
+func (ts *testStore) GetDataRetentionPolicy(ctx context.Context, orgID int64) (Policy, error) {
+	return ts.policies[orgID], nil
+}
+
+func (ts *testStore) SetDataRetentionPolicy(ctx context.Context, orgID int64, policy Policy) error {
+	ts.policies[orgID] = policy
+	return nil
+}
+
+func (ts *testStore) GetDataRetentionPolicies(ctx context.Context) (map[int64]Policy, error) {
+	return ts.policies, nil
+}
+
+func (ts *testStore) GetOrganizationApplicationIDs(ctx context.Context, orgID int64) ([]int64, error) {
+	return ts.appIDs[orgID], nil
+}
+
+func (ts *testStore) DeleteDeviceActivationsBefore(ctx context.Context, orgID int64, before time.Time) (int64, error) {
+	ts.before[KindDeviceActivation] = before
+	return 3, nil
+}
+
+func (ts *testStore) DeleteFUOTADeploymentsBefore(ctx context.Context, orgID int64, before time.Time) (int64, error) {
+	ts.before[KindFUOTADeployment] = before
+	return 1, nil
+}
+
+func (ts *testStore) EraseUserData(ctx context.Context, userID int64, dryRun bool, eraseData DataEraser) (Erasure, error) {
+	erasure := Erasure{
+		Erased:         map[string]int64{"user": 1, KindDeviceActivation: 4},
+		ApplicationIDs: ts.appIDs[1],
+		GatewayMACs:    []lorawan.EUI64{{1}},
+	}
+	if eraseData != nil {
+		erased, err := eraseData(ctx, erasure)
+		if err != nil {
+			return Erasure{}, err
+		}
+		for kind, n := range erased {
+			erasure.Erased[kind] = n
+		}
+	}
+	if !dryRun {
+		ts.erased[userID] = true
+	}
+	return erasure, nil
+}
+
+type testDeviceData struct {
+	devicedata.Store
+	deleted map[string]devicedata.DeleteFilter
+	fail    bool
+}
+
+func (td *testDeviceData) GetDeviceDataCount(ctx context.Context, filter devicedata.Filter) (int64, error) {
+	return 5, nil
+}
+
+func (td *testDeviceData) DeleteDeviceData(ctx context.Context, eventType string, filter devicedata.DeleteFilter) (int64, error) {
+	if td.fail {
+		return 0, errors.New("device data is not available")
+	}
+	td.deleted[eventType] = filter
+	return 2, nil
+}
+
+type testMetrics struct {
+	deleted []lorawan.EUI64
+}
+
+func (tm *testMetrics) DeleteGatewayMetrics(ctx context.Context, mac lorawan.EUI64, dryRun bool) (int64, error) {
+	if !dryRun {
+		tm.deleted = append(tm.deleted, mac)
+	}
+	return 6, nil
+}
+
+type testAuth struct {
+	cred auth.Credentials
+}
+
+func (ta *testAuth) GetCredentials(ctx context.Context, opts *auth.Options) (*auth.Credentials, error) {
+	cred := ta.cred
+	return &cred, nil
+}
+
+func TestPolicyValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		policy Policy
+		valid  bool
+	}{
+		{"empty", Policy{}, true},
+		{"valid", Policy{devicedata.TypeUp: 30 * 24 * time.Hour, KindFUOTADeployment: MinTTL}, true},
+		{"unknown kind", Policy{"metrics": 24 * time.Hour}, false},
+		{"too short", Policy{devicedata.TypeError: time.Minute}, false},
+		{"too long", Policy{KindDeviceActivation: MaxTTL + time.Hour}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.policy.Validate(); (err == nil) != tc.valid {
+				t.Errorf("expected valid %v, got %v", tc.valid, err)
+			}
+		})
+	}
+}
+
+func TestRemoveExpired(t *testing.T) {
+	now := time.Now()
+	st := &testStore{
+		policies: map[int64]Policy{
+			1: {devicedata.TypeUp: 24 * time.Hour, KindDeviceActivation: 48 * time.Hour},
+			// no applications, the events are not deleted
+			2: {devicedata.TypeJoin: time.Hour},
+		},
+		appIDs: map[int64][]int64{1: {10, 11}},
+		before: make(map[string]time.Time),
+	}
+	dd := &testDeviceData{deleted: make(map[string]devicedata.DeleteFilter)}
+	srv := &Service{store: st, deviceData: dd}
+	srv.removeExpired(context.Background(), now)
+
+	if len(dd.deleted) != 1 {
+		t.Fatalf("expected only uplinks to be deleted, got %v", dd.deleted)
+	}
+	f := dd.deleted[devicedata.TypeUp]
+	if len(f.ApplicationIDs) != 2 || !f.Before.Equal(now.Add(-24*time.Hour)) {
+		t.Errorf("unexpected filter: %+v", f)
+	}
+	if !st.before[KindDeviceActivation].Equal(now.Add(-48 * time.Hour)) {
+		t.Errorf("unexpected activations deleted before %v", st.before[KindDeviceActivation])
+	}
+	if _, ok := st.before[KindFUOTADeployment]; ok {
+		t.Errorf("deployments must be kept")
+	}
+}
+
+func TestUpdatePolicy(t *testing.T) {
+	ctx := context.Background()
+	st := &testStore{policies: make(map[int64]Policy)}
+	srv := NewServer(st, nil, nil, &testAuth{cred: auth.Credentials{IsOrgUser: true}})
+
+	_, err := srv.UpdatePolicy(ctx, &api.UpdateDataRetentionPolicyRequest{OrganizationId: 1})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected permission denied, got %v", err)
+	}
+
+	srv = NewServer(st, nil, nil, &testAuth{cred: auth.Credentials{IsGlobalAdmin: true}})
+	_, err = srv.UpdatePolicy(ctx, &api.UpdateDataRetentionPolicyRequest{
+		OrganizationId: 1,
+		Rules:          []*api.DataRetentionRule{{Kind: "metrics"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument, got %v", err)
+	}
+
+	srv = NewServer(st, nil, nil, &testAuth{cred: auth.Credentials{IsGlobalAdmin: true}})
+	_, err = srv.UpdatePolicy(ctx, &api.UpdateDataRetentionPolicyRequest{
+		OrganizationId: 1,
+		Rules: []*api.DataRetentionRule{
+			{Kind: KindDeviceActivation, Ttl: durationpb.New(72 * time.Hour)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := srv.GetPolicy(ctx, &api.GetDataRetentionPolicyRequest{OrganizationId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Rules) != 1 || resp.Rules[0].Kind != KindDeviceActivation ||
+		resp.Rules[0].Ttl.AsDuration() != 72*time.Hour {
+		t.Errorf("unexpected rules: %v", resp.Rules)
+	}
+}
+
+func TestEraseUserData(t *testing.T) {
+	ctx := context.Background()
+	st := &testStore{appIDs: map[int64][]int64{1: {10}}, erased: make(map[int64]bool)}
+	dd := &testDeviceData{deleted: make(map[string]devicedata.DeleteFilter)}
+	tm := &testMetrics{}
+	srv := NewServer(st, dd, tm, &testAuth{cred: auth.Credentials{UserID: 2}})
+
+	_, err := srv.EraseUserData(ctx, &api.EraseUserDataRequest{UserId: 3})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected permission denied, got %v", err)
+	}
+
+	resp, err := srv.EraseUserData(ctx, &api.EraseUserDataRequest{UserId: 2, DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.erased[2] || len(dd.deleted) != 0 || len(tm.deleted) != 0 {
+		t.Errorf("dry run must not erase the data")
+	}
+	// the events are counted per type, the metrics of the gateways together
+	if len(resp.Erased) != len(devicedata.Types)+3 || resp.Erased[0].Kind != devicedata.TypeAck ||
+		resp.Erased[0].Count != 5 {
+		t.Errorf("unexpected erased: %v", resp.Erased)
+	}
+	var metrics int64
+	for _, e := range resp.Erased {
+		if e.Kind == KindGatewayMetrics {
+			metrics = e.Count
+		}
+	}
+	if metrics != 6 {
+		t.Errorf("expected 6 metrics records of the gateway, got %d", metrics)
+	}
+
+	// nothing is erased if the events can't be erased, so the request can
+	// be repeated
+	dd.fail = true
+	if _, err := srv.EraseUserData(ctx, &api.EraseUserDataRequest{UserId: 2}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if st.erased[2] {
+		t.Fatal("the user must not be erased if the events are not erased")
+	}
+
+	dd.fail = false
+	if _, err := srv.EraseUserData(ctx, &api.EraseUserDataRequest{UserId: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if !st.erased[2] || len(dd.deleted) != len(devicedata.Types) ||
+		dd.deleted[devicedata.TypeUp].ApplicationIDs[0] != 10 {
+		t.Errorf("the data is not erased: %v", dd.deleted)
+	}
+	if len(tm.deleted) != 1 || tm.deleted[0] != (lorawan.EUI64{1}) {
+		t.Errorf("the metrics of the gateway are not erased: %v", tm.deleted)
+	}
+}
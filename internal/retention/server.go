@@ -0,0 +1,199 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
+	"github.com/mxc-foundation/lpwan-app-server/internal/audit"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	"github.com/mxc-foundation/lpwan-app-server/internal/devicedata"
+)
+
+// Server implements the data retention service API
+type Server struct {
+	store      Store
+	deviceData devicedata.Store
+	metrics    MetricsStore
+	auth       auth.Authenticator
+}
+
+// NewServer creates a new data retention service server. The device data
+// store may be nil if the PostgreSQL integration is not enabled.
+func NewServer(store Store, deviceData devicedata.Store, metrics MetricsStore, auth auth.Authenticator) *Server {
+	return &Server{
+		store:      store,
+		deviceData: deviceData,
+		metrics:    metrics,
+		auth:       auth,
+	}
+}
+
+// policyState is the policy recorded in the audit log, the TTL of each kind
+type policyState map[string]string
+
+func newPolicyState(p Policy) policyState {
+	state := make(policyState)
+	for kind, ttl := range p {
+		state[kind] = ttl.String()
+	}
+	return state
+}
+
+func (s *Server) getCredentials(ctx context.Context, orgID int64) (*auth.Credentials, error) {
+	if orgID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "organization_id must be set")
+	}
+	cred, err := s.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(orgID))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	return cred, nil
+}
+
+// GetPolicy returns the data retention policy of the organization, any user
+// who can read the devices of the organization may read it
+func (s *Server) GetPolicy(ctx context.Context, req *api.GetDataRetentionPolicyRequest) (*api.GetDataRetentionPolicyResponse, error) {
+	cred, err := s.getCredentials(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	if !cred.Has(auth.PermDeviceRead) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	policy, err := s.store.GetDataRetentionPolicy(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	resp := &api.GetDataRetentionPolicyResponse{}
+	for _, kind := range Kinds {
+		if ttl, ok := policy[kind]; ok {
+			resp.Rules = append(resp.Rules, &api.DataRetentionRule{
+				Kind: kind,
+				Ttl:  durationpb.New(ttl),
+			})
+		}
+	}
+	return resp, nil
+}
+
+// UpdatePolicy replaces the data retention policy of the organization
+func (s *Server) UpdatePolicy(ctx context.Context, req *api.UpdateDataRetentionPolicyRequest) (*api.UpdateDataRetentionPolicyResponse, error) {
+	cred, err := s.getCredentials(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	if !cred.Has(auth.PermOrganizationAdmin) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	policy := make(Policy)
+	for _, r := range req.Rules {
+		if r.Ttl == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "ttl of %s must be set", r.Kind)
+		}
+		if _, ok := policy[r.Kind]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate rule for %s", r.Kind)
+		}
+		policy[r.Kind] = r.Ttl.AsDuration()
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid policy: %v", err)
+	}
+	before, err := s.store.GetDataRetentionPolicy(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	if err := s.store.SetDataRetentionPolicy(ctx, req.OrganizationId, policy); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	audit.Describe(ctx, req.OrganizationId, "data-retention", newPolicyState(before), newPolicyState(policy))
+	return &api.UpdateDataRetentionPolicyResponse{}, nil
+}
+
+// EraseUserData anonymizes the personal data of the user, removes the device
+// data of the organizations the user is the only member of and anonymizes
+// their devices and gateways. The entries of the audit log are kept as they
+// are append-only, only the username is removed from them.
+func (s *Server) EraseUserData(ctx context.Context, req *api.EraseUserDataRequest) (*api.EraseUserDataResponse, error) {
+	cred, err := s.auth.GetCredentials(ctx, auth.NewOptions())
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id must be set")
+	}
+	if req.UserId != cred.UserID && !cred.IsGlobalAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	eraseData := func(ctx context.Context, erasure Erasure) (map[string]int64, error) {
+		erased := make(map[string]int64)
+		if s.deviceData != nil && len(erasure.ApplicationIDs) != 0 {
+			events, err := s.eraseDeviceEvents(ctx, erasure.ApplicationIDs, req.DryRun)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't erase the device events: %w", err)
+			}
+			for kind, n := range events {
+				erased[kind] = n
+			}
+		}
+		if s.metrics != nil {
+			for _, mac := range erasure.GatewayMACs {
+				n, err := s.metrics.DeleteGatewayMetrics(ctx, mac, req.DryRun)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't erase the metrics of the gateway %s: %w", mac, err)
+				}
+				if n > 0 {
+					erased[KindGatewayMetrics] += n
+				}
+			}
+		}
+		return erased, nil
+	}
+	erasure, err := s.store.EraseUserData(ctx, req.UserId, req.DryRun, eraseData)
+	if err != nil {
+		// nothing is erased if the device events or the metrics can't be
+		// erased, so the request can be repeated
+		return nil, helpers.ErrToRPCError(err)
+	}
+	erased := erasure.Erased
+
+	resp := &api.EraseUserDataResponse{}
+	var kinds []string
+	for kind := range erased {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		resp.Erased = append(resp.Erased, &api.ErasedData{Kind: kind, Count: erased[kind]})
+	}
+	if !req.DryRun {
+		audit.Describe(ctx, 0, fmt.Sprintf("user:%d", req.UserId), nil, erased)
+	}
+	return resp, nil
+}
+
+// eraseDeviceEvents removes the device events of the applications, or only
+// counts them if dryRun is true
+func (s *Server) eraseDeviceEvents(ctx context.Context, appIDs []int64, dryRun bool) (map[string]int64, error) {
+	if !dryRun {
+		return devicedata.DeleteAllTypes(ctx, s.deviceData, devicedata.DeleteFilter{ApplicationIDs: appIDs})
+	}
+	counts := make(map[string]int64)
+	for _, t := range devicedata.Types {
+		for _, appID := range appIDs {
+			n, err := s.deviceData.GetDeviceDataCount(ctx, devicedata.Filter{Type: t, ApplicationID: appID})
+			if err != nil {
+				return counts, err
+			}
+			counts[t] += n
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,71 @@
+// Package role implements the custom roles of the organization users
+package role
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+)
+
+// Role is the custom role defined by the organization. The user with the
+// role has exactly the permissions of the role.
+type Role struct {
+	ID             int64
+	OrganizationID int64
+	Name           string
+	Description    string
+	Permissions    auth.Permissions
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Validate checks that the role has a name and grants some permissions
+func (r Role) Validate() error {
+	name := strings.TrimSpace(r.Name)
+	if name == "" || len(name) > 100 {
+		return fmt.Errorf("name must be 1 to 100 characters long")
+	}
+	if r.Permissions == 0 {
+		return fmt.Errorf("role must grant at least one permission")
+	}
+	if r.Permissions&^auth.AllPermissions != 0 {
+		return fmt.Errorf("unknown permissions")
+	}
+	return nil
+}
+
+// Store stores the roles and assigns them to the organization users
+type Store interface {
+	// GetOrganizationRoles returns the roles of the organization sorted by
+	// name
+	GetOrganizationRoles(ctx context.Context, orgID int64) ([]Role, error)
+	// GetOrganizationRole returns the role of the organization
+	GetOrganizationRole(ctx context.Context, orgID, id int64) (Role, error)
+	// CreateOrganizationRole creates the role and sets its ID
+	CreateOrganizationRole(ctx context.Context, r *Role) error
+	// UpdateOrganizationRole updates the name, description and permissions
+	// of the role
+	UpdateOrganizationRole(ctx context.Context, r *Role) error
+	// DeleteOrganizationRole deletes the role, it fails if the role is
+	// assigned to any user
+	DeleteOrganizationRole(ctx context.Context, orgID, id int64) error
+	// SetOrganizationUserRole assigns the role to the organization user, if
+	// roleID is nil the user gets the built-in role
+	SetOrganizationUserRole(ctx context.Context, orgID, userID int64, roleID *int64) error
+}
+
+// permissionDescriptions describe the permissions for the API clients
+var permissionDescriptions = map[auth.Permission]string{
+	auth.PermOrganizationAdmin: "Manage the organization, its users, roles and service profiles",
+	auth.PermDeviceRead:        "Read applications, devices and device data",
+	auth.PermDeviceWrite:       "Create, update and delete applications, device profiles, devices and multicast groups",
+	auth.PermDeviceKeysRead:    "Read the device keys",
+	auth.PermDeviceQueue:       "Enqueue downlinks for the devices",
+	auth.PermGatewayRead:       "Read gateways and their statistics",
+	auth.PermGatewayWrite:      "Create, update and delete gateways",
+	auth.PermFinanceRead:       "Read the wallet, staking and mining reports",
+	auth.PermFinanceWrite:      "Top up, withdraw and stake",
+}
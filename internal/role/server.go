@@ -0,0 +1,216 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
+	"github.com/mxc-foundation/lpwan-app-server/internal/audit"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+)
+
+// Server implements the organization role service API
+type Server struct {
+	store Store
+	auth  auth.Authenticator
+}
+
+// NewServer creates a new organization role service server
+func NewServer(store Store, auth auth.Authenticator) *Server {
+	return &Server{
+		store: store,
+		auth:  auth,
+	}
+}
+
+// roleState is the role recorded in the audit log
+type roleState struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+func newRoleState(r Role) roleState {
+	return roleState{
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: r.Permissions.Names(),
+	}
+}
+
+// userRoleState is the role of the organization user recorded in the audit
+// log
+type userRoleState struct {
+	RoleID int64 `json:"role_id"`
+}
+
+func roleToPB(r Role) *api.OrganizationRole {
+	return &api.OrganizationRole{
+		Id:             r.ID,
+		OrganizationId: r.OrganizationID,
+		Name:           r.Name,
+		Description:    r.Description,
+		Permissions:    r.Permissions.Names(),
+		CreatedAt:      timestamppb.New(r.CreatedAt),
+		UpdatedAt:      timestamppb.New(r.UpdatedAt),
+	}
+}
+
+func roleFromPB(r *api.OrganizationRole) (Role, error) {
+	if r == nil {
+		return Role{}, status.Errorf(codes.InvalidArgument, "role must not be nil")
+	}
+	perms, err := auth.ParsePermissions(r.Permissions)
+	if err != nil {
+		return Role{}, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	role := Role{
+		ID:             r.Id,
+		OrganizationID: r.OrganizationId,
+		Name:           strings.TrimSpace(r.Name),
+		Description:    r.Description,
+		Permissions:    perms,
+	}
+	if err := role.Validate(); err != nil {
+		return Role{}, status.Errorf(codes.InvalidArgument, "invalid role: %v", err)
+	}
+	return role, nil
+}
+
+func (s *Server) getCredentials(ctx context.Context, orgID int64) (*auth.Credentials, error) {
+	if orgID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "organization_id must be set")
+	}
+	cred, err := s.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(orgID))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	return cred, nil
+}
+
+func (s *Server) checkOrgAdmin(ctx context.Context, orgID int64) error {
+	cred, err := s.getCredentials(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !cred.Has(auth.PermOrganizationAdmin) {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// ListPermissions returns the permissions that can be granted by a role
+func (s *Server) ListPermissions(ctx context.Context, req *api.ListOrganizationPermissionsRequest) (*api.ListOrganizationPermissionsResponse, error) {
+	if _, err := s.auth.GetCredentials(ctx, auth.NewOptions()); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	resp := &api.ListOrganizationPermissionsResponse{}
+	for p := auth.Permission(1); auth.AllPermissions.Has(p); p <<= 1 {
+		resp.Permissions = append(resp.Permissions, &api.OrganizationPermission{
+			Name:        p.String(),
+			Description: permissionDescriptions[p],
+		})
+	}
+	return resp, nil
+}
+
+// List returns the roles of the organization, any user with a permission in
+// the organization may list them
+func (s *Server) List(ctx context.Context, req *api.ListOrganizationRolesRequest) (*api.ListOrganizationRolesResponse, error) {
+	cred, err := s.getCredentials(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	if cred.Permissions() == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	roles, err := s.store.GetOrganizationRoles(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	resp := &api.ListOrganizationRolesResponse{}
+	for _, r := range roles {
+		resp.Result = append(resp.Result, roleToPB(r))
+	}
+	return resp, nil
+}
+
+// Create creates a new role in the organization
+func (s *Server) Create(ctx context.Context, req *api.CreateOrganizationRoleRequest) (*api.CreateOrganizationRoleResponse, error) {
+	role, err := roleFromPB(req.Role)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkOrgAdmin(ctx, role.OrganizationID); err != nil {
+		return nil, err
+	}
+	if err := s.store.CreateOrganizationRole(ctx, &role); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	audit.Describe(ctx, role.OrganizationID, fmt.Sprintf("role:%d", role.ID), nil, newRoleState(role))
+	return &api.CreateOrganizationRoleResponse{Id: role.ID}, nil
+}
+
+// Update updates the name, description and permissions of the role. The
+// change applies to all the users with the role immediately.
+func (s *Server) Update(ctx context.Context, req *api.UpdateOrganizationRoleRequest) (*api.UpdateOrganizationRoleResponse, error) {
+	role, err := roleFromPB(req.Role)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkOrgAdmin(ctx, role.OrganizationID); err != nil {
+		return nil, err
+	}
+	before, err := s.store.GetOrganizationRole(ctx, role.OrganizationID, role.ID)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	if err := s.store.UpdateOrganizationRole(ctx, &role); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	audit.Describe(ctx, role.OrganizationID, fmt.Sprintf("role:%d", role.ID), newRoleState(before), newRoleState(role))
+	return &api.UpdateOrganizationRoleResponse{}, nil
+}
+
+// Delete deletes the role, the role must not be assigned to any user or API
+// key
+func (s *Server) Delete(ctx context.Context, req *api.DeleteOrganizationRoleRequest) (*api.DeleteOrganizationRoleResponse, error) {
+	if err := s.checkOrgAdmin(ctx, req.OrganizationId); err != nil {
+		return nil, err
+	}
+	before, err := s.store.GetOrganizationRole(ctx, req.OrganizationId, req.Id)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	if err := s.store.DeleteOrganizationRole(ctx, req.OrganizationId, req.Id); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	audit.Describe(ctx, req.OrganizationId, fmt.Sprintf("role:%d", req.Id), newRoleState(before), nil)
+	return &api.DeleteOrganizationRoleResponse{}, nil
+}
+
+// SetUserRole assigns the role to the organization user
+func (s *Server) SetUserRole(ctx context.Context, req *api.SetOrganizationUserRoleRequest) (*api.SetOrganizationUserRoleResponse, error) {
+	if err := s.checkOrgAdmin(ctx, req.OrganizationId); err != nil {
+		return nil, err
+	}
+	var roleID *int64
+	if req.RoleId != 0 {
+		// the role must belong to the same organization
+		if _, err := s.store.GetOrganizationRole(ctx, req.OrganizationId, req.RoleId); err != nil {
+			return nil, helpers.ErrToRPCError(err)
+		}
+		roleID = &req.RoleId
+	}
+	if err := s.store.SetOrganizationUserRole(ctx, req.OrganizationId, req.UserId, roleID); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	audit.Describe(ctx, req.OrganizationId, fmt.Sprintf("user:%d", req.UserId), nil, userRoleState{RoleID: req.RoleId})
+	return &api.SetOrganizationUserRoleResponse{}, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/user"
 	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
 	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
 )
 
 // Service represents an running instance of the serivce
@@ -152,7 +153,14 @@ func (c *Service) distributeBonus(ctx context.Context) error {
 	return nil
 }
 
+// leaderJob is the name of the job in the leader election
+const leaderJob = "shopify-bonus"
+
 func (c *Service) nextRun(ctx context.Context) (time.Time, error) {
+	if !leader.IsLeader(leaderJob) {
+		// check again later whether this replica took over
+		return time.Now().Add(time.Minute), nil
+	}
 	if err := c.distributeBonus(ctx); err != nil {
 		return time.Now().Add(10 * time.Minute), nil
 	}
@@ -0,0 +1,116 @@
+package statement
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/mxc-foundation/lpwan-app-server/api/extapi"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/helpers"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+)
+
+// Server implements the statement service API
+type Server struct {
+	store Store
+	auth  auth.Authenticator
+}
+
+// NewServer creates a new statement service server
+func NewServer(store Store, auth auth.Authenticator) *Server {
+	return &Server{
+		store: store,
+		auth:  auth,
+	}
+}
+
+func (a *Server) getOrgAdminCredentials(ctx context.Context, organizationID int64) (*auth.Credentials, error) {
+	cred, err := a.auth.GetCredentials(ctx, auth.NewOptions().WithOrgID(organizationID))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+	if !cred.Has(auth.PermOrganizationAdmin) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return cred, nil
+}
+
+// Subscribe subscribes the user to the statements of the organization, if
+// the user is already subscribed the subscription is updated
+func (a *Server) Subscribe(ctx context.Context, req *api.SubscribeStatementRequest) (*api.SubscribeStatementResponse, error) {
+	cred, err := a.getOrgAdminCredentials(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Frequency != FrequencyWeekly && req.Frequency != FrequencyMonthly {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid frequency: %s", req.Frequency)
+	}
+	language := req.Language
+	if language == "" {
+		language = "en"
+	}
+
+	// the first statement is sent for the first complete period after
+	// subscribing
+	_, periodEnd := LastPeriod(req.Frequency, time.Now())
+	sub := &Subscription{
+		OrganizationID: req.OrganizationId,
+		UserID:         cred.UserID,
+		Frequency:      req.Frequency,
+		Language:       language,
+		LastPeriodEnd:  periodEnd,
+	}
+	if err := a.store.UpsertStatementSubscription(ctx, sub); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	return &api.SubscribeStatementResponse{Subscription: subscriptionToPB(*sub)}, nil
+}
+
+// Unsubscribe unsubscribes the user from the statements of the organization
+func (a *Server) Unsubscribe(ctx context.Context, req *api.UnsubscribeStatementRequest) (*api.UnsubscribeStatementResponse, error) {
+	cred, err := a.getOrgAdminCredentials(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	userID := req.UserId
+	if userID == 0 {
+		userID = cred.UserID
+	}
+	if err := a.store.DeleteStatementSubscription(ctx, req.OrganizationId, userID); err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	return &api.UnsubscribeStatementResponse{}, nil
+}
+
+// ListSubscriptions lists the subscriptions to the statements of the
+// organization
+func (a *Server) ListSubscriptions(ctx context.Context, req *api.ListStatementSubscriptionsRequest) (*api.ListStatementSubscriptionsResponse, error) {
+	if _, err := a.getOrgAdminCredentials(ctx, req.OrganizationId); err != nil {
+		return nil, err
+	}
+	subs, err := a.store.GetStatementSubscriptions(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, helpers.ErrToRPCError(err)
+	}
+	resp := &api.ListStatementSubscriptionsResponse{}
+	for _, sub := range subs {
+		resp.Subscriptions = append(resp.Subscriptions, subscriptionToPB(sub))
+	}
+	return resp, nil
+}
+
+func subscriptionToPB(sub Subscription) *api.StatementSubscription {
+	return &api.StatementSubscription{
+		Id:             sub.ID,
+		OrganizationId: sub.OrganizationID,
+		UserId:         sub.UserID,
+		Email:          sub.Email,
+		Frequency:      sub.Frequency,
+		Language:       sub.Language,
+		LastPeriodEnd:  timestamppb.New(sub.LastPeriodEnd),
+		CreatedAt:      timestamppb.New(sub.CreatedAt),
+	}
+}
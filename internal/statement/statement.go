@@ -0,0 +1,226 @@
+// Package statement sends the periodic statements of mining and staking
+// income to the subscribed organization users
+package statement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/report"
+	"github.com/mxc-foundation/lpwan-app-server/internal/email"
+	"github.com/mxc-foundation/lpwan-app-server/internal/leader"
+)
+
+// Config contains configuration of the service
+type Config struct {
+	// Enabled turns on sending of the statements
+	Enabled bool `mapstructure:"enabled"`
+	// FiatCurrency is the currency in which the value of the mined MXC is
+	// shown in the reports, usd if not set
+	FiatCurrency string `mapstructure:"fiat_currency"`
+}
+
+// Frequencies of the statements
+const (
+	FrequencyWeekly  = "weekly"
+	FrequencyMonthly = "monthly"
+)
+
+// Subscription is the subscription of the user to the statements of the
+// organization
+type Subscription struct {
+	ID             int64  `db:"id"`
+	OrganizationID int64  `db:"organization_id"`
+	UserID         int64  `db:"user_id"`
+	Frequency      string `db:"frequency"`
+	Language       string `db:"language"`
+	// LastPeriodEnd is the end of the last period for which the statement
+	// has been sent
+	LastPeriodEnd time.Time `db:"last_period_end"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+	// email of the user and the name of the organization
+	Email            string `db:"email"`
+	OrganizationName string `db:"organization_name"`
+}
+
+// Store is the DB interface
+type Store interface {
+	// UpsertStatementSubscription creates the subscription or updates the
+	// frequency and language of the existing one
+	UpsertStatementSubscription(ctx context.Context, s *Subscription) error
+	// DeleteStatementSubscription deletes the subscription of the user to
+	// the statements of the organization
+	DeleteStatementSubscription(ctx context.Context, organizationID, userID int64) error
+	// GetStatementSubscriptions returns the subscriptions to the statements
+	// of the organization
+	GetStatementSubscriptions(ctx context.Context, organizationID int64) ([]Subscription, error)
+	// GetDueStatementSubscriptions returns the subscriptions of the
+	// organization admins with the given frequency for which the statement
+	// for the period ending at periodEnd hasn't been sent yet
+	GetDueStatementSubscriptions(ctx context.Context, frequency string, periodEnd time.Time) ([]Subscription, error)
+	// SetStatementSubscriptionPeriodEnd updates the end of the last period
+	// for which the statement has been sent
+	SetStatementSubscriptionPeriodEnd(ctx context.Context, id int64, periodEnd time.Time) error
+}
+
+// Reporter generates the mining reports
+type Reporter interface {
+	GenerateStatement(ctx context.Context, organizationID int64, fiatCurrency string,
+		start, end time.Time, username string) (*report.Statement, error)
+}
+
+// Mailer sends the statements by email
+type Mailer interface {
+	SendMiningStatement(email, lang string, param email.Param) error
+}
+
+// Service represents an instance of the running service
+type Service struct {
+	cfg        Config
+	store      Store
+	reporter   Reporter
+	stakingCli pb.StakingServiceClient
+	mailer     Mailer
+	done       chan struct{}
+}
+
+// Start starts the service
+func Start(cfg Config, store Store, reporter Reporter, stakingCli pb.StakingServiceClient, mailer Mailer) *Service {
+	if !cfg.Enabled {
+		logrus.Infof("statements are not enabled, not starting")
+		return nil
+	}
+	if cfg.FiatCurrency == "" {
+		cfg.FiatCurrency = "usd"
+	}
+	srv := &Service{
+		cfg:        cfg,
+		store:      store,
+		reporter:   reporter,
+		stakingCli: stakingCli,
+		mailer:     mailer,
+		done:       make(chan struct{}),
+	}
+	go srv.run()
+	return srv
+}
+
+// Stop stops the service. The service object is not usable after this call
+func (srv *Service) Stop() {
+	if srv != nil {
+		srv.done <- struct{}{}
+		close(srv.done)
+	}
+}
+
+// leaderJob is the name of the job in the leader election
+const leaderJob = "statement"
+
+func (srv *Service) run() {
+	for {
+		wait := time.Until(time.Now().Truncate(time.Hour).Add(time.Hour))
+		select {
+		case <-time.After(wait):
+			if !leader.IsLeader(leaderJob) {
+				continue
+			}
+			if err := srv.processSubscriptions(context.Background(), time.Now()); err != nil {
+				logrus.Errorf("failed to send statements: %v", err)
+			}
+		case <-srv.done:
+			return
+		}
+	}
+}
+
+// LastPeriod returns the start and the end of the last complete period with
+// the given frequency before now. Weeks start on Monday, all the periods
+// start at midnight UTC
+func LastPeriod(frequency string, now time.Time) (start, end time.Time) {
+	now = now.UTC()
+	if frequency == FrequencyWeekly {
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		end = today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+		return end.AddDate(0, 0, -7), end
+	}
+	end = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return end.AddDate(0, -1, 0), end
+}
+
+// processSubscriptions sends the statements for the last complete period to
+// all the subscribers that haven't received them yet. If sending fails the
+// statement is sent on the next run
+func (srv *Service) processSubscriptions(ctx context.Context, now time.Time) error {
+	for _, frequency := range []string{FrequencyWeekly, FrequencyMonthly} {
+		start, end := LastPeriod(frequency, now)
+		subs, err := srv.store.GetDueStatementSubscriptions(ctx, frequency, end)
+		if err != nil {
+			return fmt.Errorf("couldn't get %s subscriptions: %v", frequency, err)
+		}
+		for _, sub := range subs {
+			if err := srv.sendStatement(ctx, sub, start, end); err != nil {
+				logrus.Errorf("failed to send statement of organization %d to %s: %v",
+					sub.OrganizationID, sub.Email, err)
+				continue
+			}
+			if err := srv.store.SetStatementSubscriptionPeriodEnd(ctx, sub.ID, end); err != nil {
+				return fmt.Errorf("couldn't update subscription %d: %v", sub.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (srv *Service) sendStatement(ctx context.Context, sub Subscription, start, end time.Time) error {
+	// the reports include both the first and the last day of the period
+	till := end.Add(-time.Second)
+	st, err := srv.reporter.GenerateStatement(ctx, sub.OrganizationID, srv.cfg.FiatCurrency, start, till, sub.Email)
+	if err != nil {
+		return fmt.Errorf("couldn't generate report: %v", err)
+	}
+	revenue, err := srv.stakingCli.GetStakingRevenue(ctx, &pb.StakingRevenueRequest{
+		OrgId:    sub.OrganizationID,
+		Currency: "ETH_MXC",
+		From:     timestamppb.New(start),
+		Till:     timestamppb.New(till),
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't get staking revenue: %v", err)
+	}
+	stakingRevenue := decimal.Zero
+	if revenue.Amount != "" {
+		if stakingRevenue, err = decimal.NewFromString(revenue.Amount); err != nil {
+			return fmt.Errorf("invalid staking revenue %s: %v", revenue.Amount, err)
+		}
+	}
+
+	firstDay := start.Format("2006-01-02")
+	lastDay := till.Format("2006-01-02")
+	return srv.mailer.SendMiningStatement(sub.Email, sub.Language, email.Param{
+		Amount: map[string]string{
+			email.StatementMXCMined:       st.Totals["ETH_MXC"],
+			email.StatementDHXMined:       st.Totals["DHX"],
+			email.StatementBTCReceived:    st.Totals["BTC"],
+			email.StatementStakingRevenue: stakingRevenue.StringFixed(4),
+		},
+		ItemID: map[string]string{
+			email.StatementOrgName: sub.OrganizationName,
+		},
+		Date: map[string]string{
+			email.StatementPeriodStart: firstDay,
+			email.StatementPeriodEnd:   lastDay,
+		},
+		Attachments: []email.Attachment{{
+			Filename:    fmt.Sprintf("mining-statement-%s-%s.pdf", firstDay, lastDay),
+			ContentType: "application/pdf",
+			Data:        st.PDF,
+		}},
+	})
+}
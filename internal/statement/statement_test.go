@@ -0,0 +1,197 @@
+package statement
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/mxc-foundation/lpwan-app-server/api/m2m-serves-appserver"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/report"
+	"github.com/mxc-foundation/lpwan-app-server/internal/email"
+)
+
+func TestLastPeriod(t *testing.T) {
+	// Wednesday
+	now := time.Date(2021, 3, 3, 15, 4, 5, 0, time.UTC)
+	for _, tc := range []struct {
+		frequency  string
+		now        time.Time
+		start, end time.Time
+	}{
+		{
+			frequency: FrequencyWeekly,
+			now:       now,
+			start:     time.Date(2021, 2, 22, 0, 0, 0, 0, time.UTC),
+			end:       time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			frequency: FrequencyWeekly,
+			now:       time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+			start:     time.Date(2021, 2, 22, 0, 0, 0, 0, time.UTC),
+			end:       time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			frequency: FrequencyWeekly,
+			now:       time.Date(2021, 3, 7, 23, 59, 0, 0, time.UTC),
+			start:     time.Date(2021, 2, 22, 0, 0, 0, 0, time.UTC),
+			end:       time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			frequency: FrequencyMonthly,
+			now:       now,
+			start:     time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
+			end:       time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			frequency: FrequencyMonthly,
+			now:       time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC),
+			start:     time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC),
+			end:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		start, end := LastPeriod(tc.frequency, tc.now)
+		if !start.Equal(tc.start) || !end.Equal(tc.end) {
+			t.Errorf("%s at %s: expected %s - %s, got %s - %s", tc.frequency, tc.now, tc.start, tc.end, start, end)
+		}
+	}
+}
+
+type testStore struct {
+	Store
+	subs []Subscription
+}
+
+func (ts *testStore) GetDueStatementSubscriptions(ctx context.Context, frequency string,
+	periodEnd time.Time) ([]Subscription, error) {
+	var subs []Subscription
+	for _, s := range ts.subs {
+		if s.Frequency == frequency && s.LastPeriodEnd.Before(periodEnd) {
+			subs = append(subs, s)
+		}
+	}
+	return subs, nil
+}
+
+func (ts *testStore) SetStatementSubscriptionPeriodEnd(ctx context.Context, id int64, periodEnd time.Time) error {
+	for i := range ts.subs {
+		if ts.subs[i].ID == id {
+			ts.subs[i].LastPeriodEnd = periodEnd
+			return nil
+		}
+	}
+	return fmt.Errorf("subscription %d not found", id)
+}
+
+type testReporter struct{}
+
+func (tr *testReporter) GenerateStatement(ctx context.Context, organizationID int64, fiatCurrency string,
+	start, end time.Time, username string) (*report.Statement, error) {
+	if organizationID == 13 {
+		return nil, fmt.Errorf("m2m is not available")
+	}
+	return &report.Statement{
+		PDF:    []byte("%PDF"),
+		Totals: map[string]string{"ETH_MXC": "20.0000", "DHX": "1.7500", "BTC": "0.0030"},
+	}, nil
+}
+
+type testStakingCli struct {
+	pb.StakingServiceClient
+}
+
+func (tc *testStakingCli) GetStakingRevenue(ctx context.Context, in *pb.StakingRevenueRequest,
+	opts ...grpc.CallOption) (*pb.StakingRevenueResponse, error) {
+	return &pb.StakingRevenueResponse{Amount: "12.5"}, nil
+}
+
+type sentStatement struct {
+	email string
+	lang  string
+	param email.Param
+}
+
+type testMailer struct {
+	sent []sentStatement
+}
+
+func (tm *testMailer) SendMiningStatement(email, lang string, param email.Param) error {
+	tm.sent = append(tm.sent, sentStatement{email: email, lang: lang, param: param})
+	return nil
+}
+
+func TestProcessSubscriptions(t *testing.T) {
+	now := time.Date(2021, 3, 3, 15, 4, 5, 0, time.UTC)
+	st := &testStore{
+		subs: []Subscription{
+			{
+				ID: 1, OrganizationID: 1, Frequency: FrequencyMonthly, Language: "en",
+				LastPeriodEnd: time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
+				Email:         "monthly@example.com", OrganizationName: "org 1",
+			},
+			{
+				ID: 2, OrganizationID: 1, Frequency: FrequencyWeekly, Language: "ko",
+				LastPeriodEnd: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+				Email:         "sent@example.com", OrganizationName: "org 1",
+			},
+			{
+				ID: 3, OrganizationID: 13, Frequency: FrequencyWeekly, Language: "en",
+				LastPeriodEnd: time.Date(2021, 2, 22, 0, 0, 0, 0, time.UTC),
+				Email:         "failed@example.com", OrganizationName: "org 13",
+			},
+		},
+	}
+	mailer := &testMailer{}
+	srv := &Service{
+		cfg:        Config{Enabled: true, FiatCurrency: "usd"},
+		store:      st,
+		reporter:   &testReporter{},
+		stakingCli: &testStakingCli{},
+		mailer:     mailer,
+	}
+
+	if err := srv.processSubscriptions(context.Background(), now); err != nil {
+		t.Fatal(err)
+	}
+	if len(mailer.sent) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(mailer.sent))
+	}
+	sent := mailer.sent[0]
+	if sent.email != "monthly@example.com" {
+		t.Errorf("statement sent to %s", sent.email)
+	}
+	if sent.param.Date[email.StatementPeriodStart] != "2021-02-01" ||
+		sent.param.Date[email.StatementPeriodEnd] != "2021-02-28" {
+		t.Errorf("unexpected period: %v", sent.param.Date)
+	}
+	if sent.param.Amount[email.StatementStakingRevenue] != "12.5000" ||
+		sent.param.Amount[email.StatementDHXMined] != "1.7500" {
+		t.Errorf("unexpected amounts: %v", sent.param.Amount)
+	}
+	if len(sent.param.Attachments) != 1 ||
+		sent.param.Attachments[0].Filename != "mining-statement-2021-02-01-2021-02-28.pdf" {
+		t.Errorf("unexpected attachments: %+v", sent.param.Attachments)
+	}
+
+	expectedEnd := map[int64]time.Time{
+		1: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		2: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		// failed statement is sent again on the next run
+		3: time.Date(2021, 2, 22, 0, 0, 0, 0, time.UTC),
+	}
+	for _, s := range st.subs {
+		if !s.LastPeriodEnd.Equal(expectedEnd[s.ID]) {
+			t.Errorf("subscription %d: expected last period end %s, got %s", s.ID, expectedEnd[s.ID], s.LastPeriodEnd)
+		}
+	}
+
+	// nothing is sent twice
+	if err := srv.processSubscriptions(context.Background(), now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if len(mailer.sent) != 1 {
+		t.Errorf("expected no more statements, got %d", len(mailer.sent))
+	}
+}
@@ -0,0 +1,100 @@
+package pgstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/audit"
+)
+
+// InsertAuditEntry appends the entry to the audit log
+func (ps *PgStore) InsertAuditEntry(ctx context.Context, e *audit.Entry) error {
+	err := sqlx.GetContext(ctx, ps.db, &e.ID, `
+		insert into audit_log (
+			created_at, user_id, username, organization_id, action, target, before, after, source_ip
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		returning id`,
+		e.CreatedAt,
+		e.UserID,
+		e.Username,
+		e.OrganizationID,
+		e.Action,
+		e.Target,
+		nullJSON(e.Before),
+		nullJSON(e.After),
+		e.SourceIP,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
+
+// nullJSON returns nil for the empty JSON so that it's stored as NULL
+func nullJSON(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
+
+func auditLogWhere(filter audit.Filter) (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.OrganizationID != 0 {
+		add("organization_id = $%d", filter.OrganizationID)
+	}
+	if filter.UserID != 0 {
+		add("user_id = $%d", filter.UserID)
+	}
+	if filter.Action != "" {
+		add("action = $%d", filter.Action)
+	}
+	if !filter.Start.IsZero() {
+		add("created_at >= $%d", filter.Start)
+	}
+	if !filter.End.IsZero() {
+		add("created_at < $%d", filter.End)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return "where " + strings.Join(conds, " and "), args
+}
+
+// GetAuditEntriesCount returns the number of the audit log entries matching
+// the filter
+func (ps *PgStore) GetAuditEntriesCount(ctx context.Context, filter audit.Filter) (int64, error) {
+	where, args := auditLogWhere(filter)
+	var count int64
+	err := sqlx.GetContext(ctx, ps.db, &count, "select count(*) from audit_log "+where, args...)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetAuditEntries returns the audit log entries matching the filter, the
+// newest entries first
+func (ps *PgStore) GetAuditEntries(ctx context.Context, filter audit.Filter) ([]audit.Entry, error) {
+	where, args := auditLogWhere(filter)
+	args = append(args, filter.Limit, filter.Offset)
+	var entries []audit.Entry
+	err := sqlx.SelectContext(ctx, ps.db, &entries, fmt.Sprintf(`
+		select * from audit_log %s
+		order by created_at desc, id desc
+		limit $%d offset $%d`, where, len(args)-1, len(args)),
+		args...,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return entries, nil
+}
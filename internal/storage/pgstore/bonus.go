@@ -3,6 +3,12 @@ package pgstore
 import (
 	"context"
 	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	"github.com/mxc-foundation/lpwan-app-server/internal/bonus"
 )
 
 // GetUserBonusOrgID given the user's email address returns the ID of the
@@ -12,7 +18,7 @@ func (ps *PgStore) GetUserBonusOrgID(ctx context.Context, email string) (int64,
 	query := `SELECT ou.organization_id
 			  FROM organization_user ou
 			  	JOIN "user" u ON (ou.user_id = u.id)
-			  WHERE u.email = $1 AND ou.is_admin
+			  WHERE u.email = $1 AND ` + roleFlag("ou", "is_admin", auth.PermOrganizationAdmin) + `
 			  ORDER BY ou.created_at ASC
 			  LIMIT 1`
 	var orgID int64
@@ -22,3 +28,96 @@ func (ps *PgStore) GetUserBonusOrgID(ctx context.Context, email string) (int64,
 	}
 	return orgID, err
 }
+
+// GetAirdrop returns the record of the processed airdrop
+func (ps *PgStore) GetAirdrop(ctx context.Context, airdropID int64) (bonus.Airdrop, error) {
+	var ad bonus.Airdrop
+	err := sqlx.GetContext(ctx, ps.db, &ad, `
+		select * from airdrop where airdrop_id = $1`,
+		airdropID,
+	)
+	if err != nil {
+		return ad, handlePSQLError(Select, err, "select error")
+	}
+	return ad, nil
+}
+
+// UpsertAirdrop creates or updates the record of the processed airdrop, the
+// record of the paid airdrop is never updated
+func (ps *PgStore) UpsertAirdrop(ctx context.Context, ad *bonus.Airdrop) error {
+	now := time.Now()
+	if ad.CreatedAt.IsZero() {
+		ad.CreatedAt = now
+	}
+	ad.UpdatedAt = now
+	_, err := ps.db.ExecContext(ctx, `
+		insert into airdrop (
+			airdrop_id, email, token, amount_usd, purpose, organization_id,
+			currency, status, error, attempts, created_at, updated_at, paid_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		on conflict (airdrop_id) do update set
+			email = excluded.email,
+			token = excluded.token,
+			amount_usd = excluded.amount_usd,
+			purpose = excluded.purpose,
+			organization_id = excluded.organization_id,
+			currency = excluded.currency,
+			status = excluded.status,
+			error = excluded.error,
+			attempts = excluded.attempts,
+			updated_at = excluded.updated_at,
+			paid_at = excluded.paid_at
+		where airdrop.status <> $14`,
+		ad.AirdropID,
+		ad.Email,
+		ad.Token,
+		ad.AmountUSD,
+		ad.Purpose,
+		ad.OrganizationID,
+		ad.Currency,
+		ad.Status,
+		ad.Error,
+		ad.Attempts,
+		ad.CreatedAt,
+		ad.UpdatedAt,
+		ad.PaidAt,
+		bonus.AirdropPaid,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
+
+// GetAirdropCount returns the number of processed airdrops with the given
+// status, or of all the airdrops if status is empty
+func (ps *PgStore) GetAirdropCount(ctx context.Context, status string) (int64, error) {
+	var count int64
+	err := sqlx.GetContext(ctx, ps.db, &count, `
+		select count(*) from airdrop where $1 = '' or status = $1`,
+		status,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetAirdrops returns the processed airdrops with the given status, or all
+// the airdrops if status is empty, the latest first
+func (ps *PgStore) GetAirdrops(ctx context.Context, status string, limit, offset int64) ([]bonus.Airdrop, error) {
+	var ads []bonus.Airdrop
+	err := sqlx.SelectContext(ctx, ps.db, &ads, `
+		select * from airdrop
+		where $1 = '' or status = $1
+		order by updated_at desc
+		limit $2 offset $3`,
+		status,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return ads, nil
+}
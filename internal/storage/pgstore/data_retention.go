@@ -0,0 +1,352 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/retention"
+)
+
+type dataRetentionRule struct {
+	OrganizationID int64  `db:"organization_id"`
+	Kind           string `db:"kind"`
+	TTLSeconds     int64  `db:"ttl_seconds"`
+}
+
+// GetDataRetentionPolicy returns the data retention policy of the
+// organization, the policy is empty if the organization has no rules
+func (ps *PgStore) GetDataRetentionPolicy(ctx context.Context, orgID int64) (retention.Policy, error) {
+	var rows []dataRetentionRule
+	err := sqlx.SelectContext(ctx, ps.db, &rows, `
+		select organization_id, kind, ttl_seconds
+		from organization_data_retention
+		where organization_id = $1`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	policy := make(retention.Policy)
+	for _, r := range rows {
+		policy[r.Kind] = time.Duration(r.TTLSeconds) * time.Second
+	}
+	return policy, nil
+}
+
+// SetDataRetentionPolicy replaces the data retention policy of the
+// organization
+func (ps *PgStore) SetDataRetentionPolicy(ctx context.Context, orgID int64, policy retention.Policy) error {
+	return ps.Tx(ctx, func(ctx context.Context, ps *PgStore) error {
+		_, err := ps.db.ExecContext(ctx, `
+			delete from organization_data_retention where organization_id = $1`,
+			orgID,
+		)
+		if err != nil {
+			return handlePSQLError(Delete, err, "delete error")
+		}
+		for kind, ttl := range policy {
+			_, err := ps.db.ExecContext(ctx, `
+				insert into organization_data_retention (organization_id, kind, ttl_seconds, updated_at)
+				values ($1, $2, $3, now())`,
+				orgID,
+				kind,
+				int64(ttl/time.Second),
+			)
+			if err != nil {
+				return handlePSQLError(Insert, err, "insert error")
+			}
+		}
+		return nil
+	})
+}
+
+// GetDataRetentionPolicies returns the data retention policies of all the
+// organizations having any rules
+func (ps *PgStore) GetDataRetentionPolicies(ctx context.Context) (map[int64]retention.Policy, error) {
+	var rows []dataRetentionRule
+	err := sqlx.SelectContext(ctx, ps.db, &rows, `
+		select organization_id, kind, ttl_seconds
+		from organization_data_retention`,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	policies := make(map[int64]retention.Policy)
+	for _, r := range rows {
+		if policies[r.OrganizationID] == nil {
+			policies[r.OrganizationID] = make(retention.Policy)
+		}
+		policies[r.OrganizationID][r.Kind] = time.Duration(r.TTLSeconds) * time.Second
+	}
+	return policies, nil
+}
+
+// GetOrganizationApplicationIDs returns the IDs of the applications of the
+// organization
+func (ps *PgStore) GetOrganizationApplicationIDs(ctx context.Context, orgID int64) ([]int64, error) {
+	var ids []int64
+	err := sqlx.SelectContext(ctx, ps.db, &ids, `
+		select id from application where organization_id = $1 order by id`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return ids, nil
+}
+
+// DeleteDeviceActivationsBefore deletes the activations of the devices of the
+// organization created before the given time. The latest activation of every
+// device is kept as it holds the current session keys.
+func (ps *PgStore) DeleteDeviceActivationsBefore(ctx context.Context, orgID int64, before time.Time) (int64, error) {
+	res, err := ps.db.ExecContext(ctx, `
+		delete from device_activation da
+		using device d, application a
+		where da.dev_eui = d.dev_eui
+			and d.application_id = a.id
+			and a.organization_id = $1
+			and da.created_at < $2
+			and exists (
+				select 1 from device_activation l
+				where l.dev_eui = da.dev_eui
+					and (l.created_at, l.id) > (da.created_at, da.id))`,
+		orgID,
+		before,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+	return res.RowsAffected()
+}
+
+// DeleteFUOTADeploymentsBefore deletes the FUOTA deployments of the
+// organization not updated since the given time, or all the deployments of
+// the organization if the time is zero. The deployment belongs to the
+// organization if its multicast group or any of its devices does.
+func (ps *PgStore) DeleteFUOTADeploymentsBefore(ctx context.Context, orgID int64, before time.Time) (int64, error) {
+	var beforeArg interface{}
+	if !before.IsZero() {
+		beforeArg = before
+	}
+	res, err := ps.db.ExecContext(ctx, `
+		delete from fuota_deployment fd
+		where ($2::timestamptz is null or fd.updated_at < $2)
+			and (
+				exists (
+					select 1 from multicast_group mg
+					inner join service_profile sp
+						on sp.service_profile_id = mg.service_profile_id
+					where mg.id = fd.multicast_group_id
+						and sp.organization_id = $1)
+				or exists (
+					select 1 from fuota_deployment_device fdd
+					inner join device d
+						on d.dev_eui = fdd.dev_eui
+					inner join application a
+						on a.id = d.application_id
+					where fdd.fuota_deployment_id = fd.id
+						and a.organization_id = $1))`,
+		orgID,
+		beforeArg,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+	return res.RowsAffected()
+}
+
+// errDryRun rolls back the transaction of the dry run of the erasure
+var errDryRun = errors.New("dry run")
+
+// EraseUserData anonymizes the user and removes the personal data of the
+// user. In the organizations the user is the only member of the device
+// activations, the device keys, the multicast group memberships and the FUOTA
+// deployments are removed, the devices and the gateways are anonymized. The
+// device events and the metrics of these organizations are removed by
+// eraseData before the transaction is committed, if it fails the transaction
+// is rolled back. The audit log entries are kept as the log is append-only,
+// only the username is removed from them. If dryRun is true the transaction
+// is rolled back, so only the numbers of the records are returned.
+func (ps *PgStore) EraseUserData(ctx context.Context, userID int64, dryRun bool,
+	eraseData retention.DataEraser) (retention.Erasure, error) {
+	var erasure retention.Erasure
+	err := ps.Tx(ctx, func(ctx context.Context, ps *PgStore) error {
+		erasure = retention.Erasure{Erased: make(map[string]int64)}
+		var email string
+		err := sqlx.GetContext(ctx, ps.db, &email, `
+			select email from "user" where id = $1 for update`,
+			userID,
+		)
+		if err != nil {
+			return handlePSQLError(Select, err, "select error")
+		}
+
+		var orgIDs []int64
+		err = sqlx.SelectContext(ctx, ps.db, &orgIDs, `
+			select organization_id
+			from organization_user
+			where organization_id in (
+				select organization_id from organization_user where user_id = $1)
+			group by organization_id
+			having count(*) = 1
+			order by organization_id`,
+			userID,
+		)
+		if err != nil {
+			return handlePSQLError(Select, err, "select error")
+		}
+		if len(orgIDs) != 0 {
+			err = sqlx.SelectContext(ctx, ps.db, &erasure.ApplicationIDs, `
+				select id from application where organization_id = any($1) order by id`,
+				pq.Int64Array(orgIDs),
+			)
+			if err != nil {
+				return handlePSQLError(Select, err, "select error")
+			}
+			err = sqlx.SelectContext(ctx, ps.db, &erasure.GatewayMACs, `
+				select mac from gateway where organization_id = any($1) order by mac`,
+				pq.Int64Array(orgIDs),
+			)
+			if err != nil {
+				return handlePSQLError(Select, err, "select error")
+			}
+		}
+		for _, orgID := range orgIDs {
+			n, err := ps.DeleteFUOTADeploymentsBefore(ctx, orgID, time.Time{})
+			if err != nil {
+				return err
+			}
+			erasure.Erased[retention.KindFUOTADeployment] += n
+		}
+
+		// allows to remove the username from the audit log in this
+		// transaction only
+		if _, err := ps.db.ExecContext(ctx, `set local audit_log.erase_username = 'on'`); err != nil {
+			return handlePSQLError(Update, err, "set error")
+		}
+		for _, q := range []struct {
+			kind  string
+			query string
+			arg   interface{}
+		}{
+			{
+				kind: retention.KindDeviceActivation,
+				query: `delete from device_activation da
+					using device d
+					where da.dev_eui = d.dev_eui and d.application_id = any($1)`,
+				arg: pq.Int64Array(erasure.ApplicationIDs),
+			},
+			{
+				kind: "device_multicast_group",
+				query: `delete from device_multicast_group dmg
+					using device d
+					where dmg.dev_eui = d.dev_eui and d.application_id = any($1)`,
+				arg: pq.Int64Array(erasure.ApplicationIDs),
+			},
+			{
+				kind: "device_keys",
+				query: `delete from device_keys dk
+					using device d
+					where dk.dev_eui = d.dev_eui and d.application_id = any($1)`,
+				arg: pq.Int64Array(erasure.ApplicationIDs),
+			},
+			{
+				kind: "device",
+				query: `update device set
+						name = 'erased-' || encode(dev_eui, 'hex'),
+						description = '',
+						latitude = null,
+						longitude = null,
+						altitude = null,
+						variables = '',
+						tags = '',
+						updated_at = now()
+					where application_id = any($1)`,
+				arg: pq.Int64Array(erasure.ApplicationIDs),
+			},
+			{
+				kind: "gateway",
+				query: `update gateway set
+						name = 'erased-' || encode(mac, 'hex'),
+						description = '',
+						latitude = 0,
+						longitude = 0,
+						altitude = 0,
+						tags = '',
+						metadata = '',
+						updated_at = now()
+					where organization_id = any($1)`,
+				arg: pq.Int64Array(orgIDs),
+			},
+			{kind: "external_login", query: `delete from external_login where user_id = $1`, arg: userID},
+			{kind: "totp_configuration", query: `delete from totp_configuration where user_id = $1`, arg: userID},
+			{kind: "totp_recovery_code", query: `delete from totp_recovery_codes where user_id = $1`, arg: userID},
+			{kind: "password_reset", query: `delete from password_reset where user_id = $1`, arg: userID},
+			{kind: "statement_subscription", query: `delete from statement_subscription where user_id = $1`, arg: userID},
+			{kind: "organization_invitation", query: `delete from organization_invitation where email = $1`, arg: email},
+			{kind: "email_outbox", query: `delete from email_outbox where recipient = $1`, arg: email},
+			{kind: "organization_user", query: `delete from organization_user where user_id = $1`, arg: userID},
+			{
+				kind:  "audit_log_username",
+				query: `update audit_log set username = '' where user_id = $1 and username != ''`,
+				arg:   userID,
+			},
+			{
+				kind: "user",
+				query: `update "user" set
+						email = 'erased-' || id,
+						display_name = '',
+						password_hash = null,
+						security_token = null,
+						email_verified = false,
+						is_active = false,
+						is_admin = false,
+						last_login_service = '',
+						updated_at = now()
+					where id = $1`,
+				arg: userID,
+			},
+		} {
+			res, err := ps.db.ExecContext(ctx, q.query, q.arg)
+			if err != nil {
+				return handlePSQLError(Delete, err, "delete error")
+			}
+			n, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				erasure.Erased[q.kind] += n
+			}
+		}
+
+		// the events and the metrics are in other databases, they are
+		// removed at last so the erasure is committed only if they are
+		// removed
+		if eraseData != nil {
+			erased, err := eraseData(ctx, erasure)
+			if err != nil {
+				return err
+			}
+			for kind, n := range erased {
+				erasure.Erased[kind] = n
+			}
+		}
+
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return erasure, err
+	}
+	if erasure.Erased[retention.KindFUOTADeployment] == 0 {
+		delete(erasure.Erased, retention.KindFUOTADeployment)
+	}
+	return erasure, nil
+}
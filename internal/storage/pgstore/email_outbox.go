@@ -0,0 +1,193 @@
+package pgstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/email"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+)
+
+// InsertOutboxEmail queues the email in the outbox
+func (ps *PgStore) InsertOutboxEmail(ctx context.Context, e *email.OutboxEmail) error {
+	err := sqlx.GetContext(ctx, ps.db, &e.ID, `
+		insert into email_outbox (
+			recipient, kind, message, status, attempts, last_error, created_at, next_attempt_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)
+		returning id`,
+		e.Recipient,
+		e.Kind,
+		e.Message,
+		e.Status,
+		e.Attempts,
+		e.LastError,
+		e.CreatedAt,
+		e.NextAttemptAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
+
+// GetDueOutboxEmails returns up to limit pending emails which next attempt is
+// due at the given time, the oldest first
+func (ps *PgStore) GetDueOutboxEmails(ctx context.Context, now time.Time, limit int) ([]email.OutboxEmail, error) {
+	var emails []email.OutboxEmail
+	err := sqlx.SelectContext(ctx, ps.db, &emails, `
+		select * from email_outbox
+		where status = $1 and next_attempt_at <= $2
+		order by next_attempt_at, id
+		limit $3`,
+		email.OutboxStatusPending,
+		now,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return emails, nil
+}
+
+// UpdateOutboxEmail updates the status, message and the attempts of the email
+func (ps *PgStore) UpdateOutboxEmail(ctx context.Context, e *email.OutboxEmail) error {
+	res, err := ps.db.ExecContext(ctx, `
+		update email_outbox set
+			message = $2,
+			status = $3,
+			attempts = $4,
+			last_error = $5,
+			next_attempt_at = $6,
+			sent_at = $7
+		where id = $1`,
+		e.ID,
+		e.Message,
+		e.Status,
+		e.Attempts,
+		e.LastError,
+		e.NextAttemptAt,
+		e.SentAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	if ra == 0 {
+		return errHandler.ErrDoesNotExist
+	}
+	return nil
+}
+
+// GetOutboxEmailSentCount returns the number of the emails sent to the
+// recipient since the given time
+func (ps *PgStore) GetOutboxEmailSentCount(ctx context.Context, recipient string, since time.Time) (int64, error) {
+	var count int64
+	err := sqlx.GetContext(ctx, ps.db, &count, `
+		select count(*) from email_outbox where recipient = $1 and sent_at >= $2`,
+		recipient,
+		since,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// DeleteOutboxEmails deletes the sent and failed emails created before the
+// given time
+func (ps *PgStore) DeleteOutboxEmails(ctx context.Context, before time.Time) (int64, error) {
+	res, err := ps.db.ExecContext(ctx, `
+		delete from email_outbox where status <> $1 and created_at < $2`,
+		email.OutboxStatusPending,
+		before,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+	return ra, nil
+}
+
+func emailOutboxWhere(filter email.OutboxFilter) (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.Status != "" {
+		add("status = $%d", filter.Status)
+	}
+	if filter.Recipient != "" {
+		add("recipient = $%d", filter.Recipient)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return "where " + strings.Join(conds, " and "), args
+}
+
+// GetOutboxEmailCount returns the number of the emails in the outbox matching
+// the filter
+func (ps *PgStore) GetOutboxEmailCount(ctx context.Context, filter email.OutboxFilter) (int64, error) {
+	where, args := emailOutboxWhere(filter)
+	var count int64
+	err := sqlx.GetContext(ctx, ps.db, &count, "select count(*) from email_outbox "+where, args...)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetOutboxEmails returns the emails in the outbox matching the filter
+// without the messages, the newest first
+func (ps *PgStore) GetOutboxEmails(ctx context.Context, filter email.OutboxFilter) ([]email.OutboxEmail, error) {
+	where, args := emailOutboxWhere(filter)
+	args = append(args, filter.Limit, filter.Offset)
+	var emails []email.OutboxEmail
+	err := sqlx.SelectContext(ctx, ps.db, &emails, fmt.Sprintf(`
+		select id, recipient, kind, status, attempts, last_error, created_at, next_attempt_at, sent_at
+		from email_outbox %s
+		order by created_at desc, id desc
+		limit $%d offset $%d`, where, len(args)-1, len(args)),
+		args...,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return emails, nil
+}
+
+// RetryOutboxEmail resets the attempts of the failed email and makes it
+// pending again
+func (ps *PgStore) RetryOutboxEmail(ctx context.Context, id int64, now time.Time) error {
+	res, err := ps.db.ExecContext(ctx, `
+		update email_outbox set status = $2, attempts = 0, next_attempt_at = $3
+		where id = $1 and status = $4`,
+		id,
+		email.OutboxStatusPending,
+		now,
+		email.OutboxStatusFailed,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	if ra == 0 {
+		return errHandler.ErrDoesNotExist
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package pgstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/invitation"
+)
+
+// CreateOrganizationInvitation creates the invitation, the pending invitation
+// of the same email address to the organization is revoked
+func (ps *PgStore) CreateOrganizationInvitation(ctx context.Context, inv *invitation.Invitation) error {
+	return ps.Tx(ctx, func(ctx context.Context, ps *PgStore) error {
+		_, err := ps.db.ExecContext(ctx, `
+			update organization_invitation set revoked_at = $3
+			where organization_id = $1 and email = $2 and accepted_at is null and revoked_at is null`,
+			inv.OrganizationID,
+			inv.Email,
+			inv.CreatedAt,
+		)
+		if err != nil {
+			return handlePSQLError(Update, err, "update error")
+		}
+		err = sqlx.GetContext(ctx, ps.db, &inv.ID, `
+			insert into organization_invitation (
+				organization_id, email, is_admin, is_device_admin, is_gateway_admin,
+				language, created_by, created_at, expires_at
+			) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			returning id`,
+			inv.OrganizationID,
+			inv.Email,
+			inv.IsAdmin,
+			inv.IsDeviceAdmin,
+			inv.IsGatewayAdmin,
+			inv.Language,
+			inv.CreatedBy,
+			inv.CreatedAt,
+			inv.ExpiresAt,
+		)
+		if err != nil {
+			return handlePSQLError(Insert, err, "insert error")
+		}
+		return nil
+	})
+}
+
+// GetOrganizationInvitation returns the invitation with the given id
+func (ps *PgStore) GetOrganizationInvitation(ctx context.Context, id int64) (invitation.Invitation, error) {
+	var inv invitation.Invitation
+	err := sqlx.GetContext(ctx, ps.db, &inv, `select * from organization_invitation where id = $1`, id)
+	if err != nil {
+		return inv, handlePSQLError(Select, err, "select error")
+	}
+	return inv, nil
+}
+
+// GetPendingOrganizationInvitationCount returns the number of the pending
+// invitations to the organization
+func (ps *PgStore) GetPendingOrganizationInvitationCount(ctx context.Context, organizationID int64) (int64, error) {
+	var count int64
+	err := sqlx.GetContext(ctx, ps.db, &count, `
+		select count(*) from organization_invitation
+		where organization_id = $1 and accepted_at is null and revoked_at is null and expires_at > $2`,
+		organizationID,
+		time.Now(),
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetPendingOrganizationInvitations returns the pending invitations to the
+// organization, the newest first
+func (ps *PgStore) GetPendingOrganizationInvitations(ctx context.Context, organizationID, limit,
+	offset int64) ([]invitation.Invitation, error) {
+	var invs []invitation.Invitation
+	err := sqlx.SelectContext(ctx, ps.db, &invs, `
+		select * from organization_invitation
+		where organization_id = $1 and accepted_at is null and revoked_at is null and expires_at > $2
+		order by created_at desc, id desc
+		limit $3 offset $4`,
+		organizationID,
+		time.Now(),
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return invs, nil
+}
+
+// RevokeOrganizationInvitation revokes the pending invitation to the
+// organization
+func (ps *PgStore) RevokeOrganizationInvitation(ctx context.Context, organizationID, id int64) error {
+	res, err := ps.db.ExecContext(ctx, `
+		update organization_invitation set revoked_at = $3
+		where id = $1 and organization_id = $2 and accepted_at is null and revoked_at is null`,
+		id,
+		organizationID,
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	if ra == 0 {
+		return errHandler.ErrDoesNotExist
+	}
+	return nil
+}
+
+// AcceptOrganizationInvitation marks the pending invitation as accepted, if
+// userID is not 0 the user is added to the organization
+func (ps *PgStore) AcceptOrganizationInvitation(ctx context.Context, id, userID int64) error {
+	return ps.Tx(ctx, func(ctx context.Context, ps *PgStore) error {
+		res, err := ps.db.ExecContext(ctx, `
+			update organization_invitation set accepted_at = $2
+			where id = $1 and accepted_at is null and revoked_at is null and expires_at > $2`,
+			id,
+			time.Now(),
+		)
+		if err != nil {
+			return handlePSQLError(Update, err, "update error")
+		}
+		ra, err := res.RowsAffected()
+		if err != nil {
+			return handlePSQLError(Update, err, "update error")
+		}
+		if ra == 0 {
+			return errHandler.ErrDoesNotExist
+		}
+		if userID == 0 {
+			return nil
+		}
+		_, err = ps.db.ExecContext(ctx, `
+			insert into organization_user (
+				organization_id, user_id, is_admin, is_device_admin, is_gateway_admin, created_at, updated_at
+			)
+			select organization_id, $2, is_admin, is_device_admin, is_gateway_admin, now(), now()
+			from organization_invitation where id = $1
+			on conflict (user_id, organization_id) do nothing`,
+			id,
+			userID,
+		)
+		if err != nil {
+			return handlePSQLError(Insert, err, "insert error")
+		}
+		return nil
+	})
+}
+
+// JoinInvitedOrganizations adds the newly registered user to the
+// organizations whose invitations the user has accepted before registering.
+// The invitations accepted before the user has been created were accepted by
+// the previous owner of the email address and are ignored.
+func (ps *PgStore) JoinInvitedOrganizations(ctx context.Context, userID int64) error {
+	_, err := ps.db.ExecContext(ctx, `
+		insert into organization_user (
+			organization_id, user_id, is_admin, is_device_admin, is_gateway_admin, created_at, updated_at
+		)
+		select distinct on (oi.organization_id)
+			oi.organization_id, u.id, oi.is_admin, oi.is_device_admin, oi.is_gateway_admin, now(), now()
+		from organization_invitation oi
+			inner join "user" u on u.email = oi.email
+		where u.id = $1 and oi.accepted_at is not null and oi.revoked_at is null
+			and oi.accepted_at > u.created_at
+		order by oi.organization_id, oi.accepted_at desc
+		on conflict (user_id, organization_id) do nothing`,
+		userID,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
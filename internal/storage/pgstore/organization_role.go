@@ -0,0 +1,184 @@
+package pgstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/role"
+)
+
+type organizationRole struct {
+	ID             int64          `db:"id"`
+	OrganizationID int64          `db:"organization_id"`
+	Name           string         `db:"name"`
+	Description    string         `db:"description"`
+	Permissions    pq.StringArray `db:"permissions"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+func (r organizationRole) toRole() (role.Role, error) {
+	perms, err := auth.ParsePermissions(r.Permissions)
+	if err != nil {
+		return role.Role{}, err
+	}
+	return role.Role{
+		ID:             r.ID,
+		OrganizationID: r.OrganizationID,
+		Name:           r.Name,
+		Description:    r.Description,
+		Permissions:    perms,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}, nil
+}
+
+// GetOrganizationRoles returns the roles of the organization sorted by name
+func (ps *PgStore) GetOrganizationRoles(ctx context.Context, orgID int64) ([]role.Role, error) {
+	var rows []organizationRole
+	err := sqlx.SelectContext(ctx, ps.db, &rows, `
+		select * from organization_role where organization_id = $1 order by name`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	var roles []role.Role
+	for _, row := range rows {
+		r, err := row.toRole()
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, r)
+	}
+	return roles, nil
+}
+
+// GetOrganizationRole returns the role of the organization
+func (ps *PgStore) GetOrganizationRole(ctx context.Context, orgID, id int64) (role.Role, error) {
+	var row organizationRole
+	err := sqlx.GetContext(ctx, ps.db, &row, `
+		select * from organization_role where organization_id = $1 and id = $2`,
+		orgID,
+		id,
+	)
+	if err != nil {
+		return role.Role{}, handlePSQLError(Select, err, "select error")
+	}
+	return row.toRole()
+}
+
+// CreateOrganizationRole creates the role and sets its ID
+func (ps *PgStore) CreateOrganizationRole(ctx context.Context, r *role.Role) error {
+	now := time.Now()
+	err := sqlx.GetContext(ctx, ps.db, &r.ID, `
+		insert into organization_role (
+			organization_id, name, description, permissions, created_at, updated_at
+		) values ($1, $2, $3, $4, $5, $5)
+		returning id`,
+		r.OrganizationID,
+		r.Name,
+		r.Description,
+		pq.StringArray(r.Permissions.Names()),
+		now,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	r.CreatedAt = now
+	r.UpdatedAt = now
+	return nil
+}
+
+// UpdateOrganizationRole updates the name, description and permissions of the
+// role
+func (ps *PgStore) UpdateOrganizationRole(ctx context.Context, r *role.Role) error {
+	r.UpdatedAt = time.Now()
+	res, err := ps.db.ExecContext(ctx, `
+		update organization_role set
+			name = $3,
+			description = $4,
+			permissions = $5,
+			updated_at = $6
+		where organization_id = $1 and id = $2`,
+		r.OrganizationID,
+		r.ID,
+		r.Name,
+		r.Description,
+		pq.StringArray(r.Permissions.Names()),
+		r.UpdatedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	if ra == 0 {
+		return errHandler.ErrDoesNotExist
+	}
+	return nil
+}
+
+// DeleteOrganizationRole deletes the role, it fails if the role is assigned
+// to any user
+func (ps *PgStore) DeleteOrganizationRole(ctx context.Context, orgID, id int64) error {
+	res, err := ps.db.ExecContext(ctx, `
+		delete from organization_role where organization_id = $1 and id = $2`,
+		orgID,
+		id,
+	)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	if ra == 0 {
+		return errHandler.ErrDoesNotExist
+	}
+	return nil
+}
+
+// roleFlag returns the SQL expression of the built-in role flag of the
+// organization user ou. The flag of the user with the custom role is granted
+// by the permission p of the role, the built-in flags stored for the user are
+// used only when the user has no custom role.
+func roleFlag(ou, flag string, p auth.Permission) string {
+	return fmt.Sprintf(`coalesce((select '%s' = any(r.permissions) from organization_role r where r.id = %s.role_id), %s.%s)`,
+		p, ou, ou, flag)
+}
+
+// SetOrganizationUserRole assigns the role to the organization user, if
+// roleID is nil the user gets the built-in role. The built-in flags of the
+// user are kept, so they are used again once the role is unassigned.
+func (ps *PgStore) SetOrganizationUserRole(ctx context.Context, orgID, userID int64, roleID *int64) error {
+	res, err := ps.db.ExecContext(ctx, `
+		update organization_user set role_id = $3, updated_at = now()
+		where organization_id = $1 and user_id = $2
+			and ($3::bigint is null or exists (
+				select 1 from organization_role where organization_id = $1 and id = $3))`,
+		orgID,
+		userID,
+		roleID,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	if ra == 0 {
+		return errHandler.ErrDoesNotExist
+	}
+	return nil
+}
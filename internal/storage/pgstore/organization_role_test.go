@@ -0,0 +1,96 @@
+package pgstore
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/user"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	"github.com/mxc-foundation/lpwan-app-server/internal/role"
+)
+
+func TestSetOrganizationUserRole(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	ctx := context.Background()
+	// only the role is set, the built-in flags are kept
+	query := regexp.QuoteMeta(`update organization_user set role_id = $3, updated_at = now()`)
+
+	roleID := int64(5)
+	mock.ExpectExec(query).WithArgs(int64(1), int64(2), &roleID).WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := st.SetOrganizationUserRole(ctx, 1, 2, &roleID); err != nil {
+		t.Fatal(err)
+	}
+	// the role of another organization is not found
+	mock.ExpectExec(query).WithArgs(int64(1), int64(2), &roleID).WillReturnResult(sqlmock.NewResult(0, 0))
+	if err := st.SetOrganizationUserRole(ctx, 1, 2, &roleID); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestOrganizationUserRoleRoundTrip checks on the real database that
+// assigning and unassigning the role gives the user back the built-in role
+func TestOrganizationUserRoleRoundTrip(t *testing.T) {
+	st := &PgStore{db: testDB(t)}
+	ctx := context.Background()
+
+	org := organization.Organization{Name: "role-org", DisplayName: "Role org"}
+	if err := st.CreateOrganization(ctx, &org); err != nil {
+		t.Fatal(err)
+	}
+	u, err := st.CreateUser(ctx, user.User{Email: "gwadmin@example.com", IsActive: true}, []user.OrganizationUser{
+		{OrganizationID: org.ID, IsGatewayAdmin: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := role.Role{
+		OrganizationID: org.ID,
+		Name:           "admin",
+		Description:    "organization admin",
+		Permissions:    auth.Permissions(0).With(auth.PermOrganizationAdmin),
+	}
+	if err := st.CreateOrganizationRole(ctx, &r); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := st.SetOrganizationUserRole(ctx, org.ID, u.ID, &r.ID); err != nil {
+		t.Fatal(err)
+	}
+	ou, err := st.GetOrganizationUser(ctx, org.ID, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ou.RoleID == nil || *ou.RoleID != r.ID || ou.IsAdmin || !ou.IsGatewayAdmin {
+		t.Fatalf("expected the role with the built-in flags kept, got %+v", ou)
+	}
+	orgs, err := st.GetUserOrganizations(ctx, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orgs) != 1 || !orgs[0].IsOrgAdmin || orgs[0].IsGatewayAdmin {
+		t.Fatalf("expected the flags granted by the role, got %+v", orgs)
+	}
+
+	if err := st.SetOrganizationUserRole(ctx, org.ID, u.ID, nil); err != nil {
+		t.Fatal(err)
+	}
+	au, err := st.AuthGetOrgUser(ctx, u.ID, org.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if au.RoleID != 0 || au.IsOrgAdmin || !au.IsGatewayAdmin {
+		t.Fatalf("expected the built-in role back, got %+v", au)
+	}
+}
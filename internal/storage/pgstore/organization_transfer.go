@@ -0,0 +1,187 @@
+package pgstore
+
+import (
+	"context"
+
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+
+	appd "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
+	devd "github.com/mxc-foundation/lpwan-app-server/internal/modules/device/data"
+	gwd "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+)
+
+// GetOrganizationServiceProfileIDs returns the IDs of the service-profiles of
+// the organization
+func (ps *PgStore) GetOrganizationServiceProfileIDs(ctx context.Context, orgID int64) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := sqlx.SelectContext(ctx, ps.db, &ids, `
+		select service_profile_id from service_profile
+		where organization_id = $1
+		order by name`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return ids, nil
+}
+
+// GetOrganizationDeviceProfileIDs returns the IDs of the device-profiles of
+// the organization
+func (ps *PgStore) GetOrganizationDeviceProfileIDs(ctx context.Context, orgID int64) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := sqlx.SelectContext(ctx, ps.db, &ids, `
+		select device_profile_id from device_profile
+		where organization_id = $1
+		order by name`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return ids, nil
+}
+
+// GetOrganizationMulticastGroupIDs returns the IDs of the multicast-groups of
+// the organization
+func (ps *PgStore) GetOrganizationMulticastGroupIDs(ctx context.Context, orgID int64) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := sqlx.SelectContext(ctx, ps.db, &ids, `
+		select mg.id from multicast_group mg
+		inner join service_profile sp
+			on sp.service_profile_id = mg.service_profile_id
+		where sp.organization_id = $1
+		order by mg.name`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return ids, nil
+}
+
+// GetOrganizationMulticastGroupDevices returns the DevEUIs of the devices of
+// every multicast-group of the organization
+func (ps *PgStore) GetOrganizationMulticastGroupDevices(ctx context.Context, orgID int64) (map[uuid.UUID][]lorawan.EUI64, error) {
+	var rows []struct {
+		MulticastGroupID uuid.UUID     `db:"multicast_group_id"`
+		DevEUI           lorawan.EUI64 `db:"dev_eui"`
+	}
+	err := sqlx.SelectContext(ctx, ps.db, &rows, `
+		select dmg.multicast_group_id, dmg.dev_eui
+		from device_multicast_group dmg
+		inner join multicast_group mg
+			on mg.id = dmg.multicast_group_id
+		inner join service_profile sp
+			on sp.service_profile_id = mg.service_profile_id
+		where sp.organization_id = $1
+		order by dmg.multicast_group_id, dmg.dev_eui`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	devices := make(map[uuid.UUID][]lorawan.EUI64)
+	for _, r := range rows {
+		devices[r.MulticastGroupID] = append(devices[r.MulticastGroupID], r.DevEUI)
+	}
+	return devices, nil
+}
+
+// GetOrganizationApplications returns all the applications of the
+// organization
+func (ps *PgStore) GetOrganizationApplications(ctx context.Context, orgID int64) ([]appd.Application, error) {
+	var apps []appd.Application
+	err := sqlx.SelectContext(ctx, ps.db, &apps, `
+		select * from application where organization_id = $1 order by id`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return apps, nil
+}
+
+// GetOrganizationIntegrations returns the integrations of all the
+// applications of the organization
+func (ps *PgStore) GetOrganizationIntegrations(ctx context.Context, orgID int64) ([]appd.Integration, error) {
+	var is []appd.Integration
+	err := sqlx.SelectContext(ctx, ps.db, &is, `
+		select i.* from integration i
+		inner join application a
+			on a.id = i.application_id
+		where a.organization_id = $1
+		order by i.application_id, i.kind`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return is, nil
+}
+
+// GetOrganizationDevices returns all the devices of the organization
+func (ps *PgStore) GetOrganizationDevices(ctx context.Context, orgID int64) ([]devd.Device, error) {
+	var devs []devd.Device
+	err := sqlx.SelectContext(ctx, ps.db, &devs, `
+		select d.* from device d
+		inner join application a
+			on a.id = d.application_id
+		where a.organization_id = $1
+		order by d.dev_eui`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return devs, nil
+}
+
+// GetOrganizationDeviceKeys returns the keys of all the devices of the
+// organization
+func (ps *PgStore) GetOrganizationDeviceKeys(ctx context.Context, orgID int64) ([]devd.DeviceKeys, error) {
+	var keys []devd.DeviceKeys
+	err := sqlx.SelectContext(ctx, ps.db, &keys, `
+		select dk.* from device_keys dk
+		inner join device d
+			on d.dev_eui = dk.dev_eui
+		inner join application a
+			on a.id = d.application_id
+		where a.organization_id = $1
+		order by dk.dev_eui`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return keys, nil
+}
+
+// GetOrganizationGateways returns all the gateways of the organization
+func (ps *PgStore) GetOrganizationGateways(ctx context.Context, orgID int64) ([]gwd.Gateway, error) {
+	var gws []gwd.Gateway
+	err := sqlx.SelectContext(ctx, ps.db, &gws, `
+		select * from gateway where organization_id = $1 order by mac`,
+		orgID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return gws, nil
+}
+
+// OrganizationNameExists returns true if there's the organization with the
+// given name
+func (ps *PgStore) OrganizationNameExists(ctx context.Context, name string) (bool, error) {
+	var exists bool
+	err := sqlx.GetContext(ctx, ps.db, &exists, `
+		select exists (select 1 from organization where name = $1)`,
+		name,
+	)
+	if err != nil {
+		return false, handlePSQLError(Select, err, "select error")
+	}
+	return exists, nil
+}
@@ -0,0 +1,88 @@
+package pgstore
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AdvisoryLocker holds session level advisory locks on a dedicated
+// connection, the locks are released by the server when the connection is
+// closed, e.g. because the process has died.
+type AdvisoryLocker struct {
+	mu   sync.Mutex
+	conn *sql.Conn
+	held map[string]bool
+}
+
+// NewAdvisoryLocker returns a new advisory locker
+func (ps *PgStore) NewAdvisoryLocker() *AdvisoryLocker {
+	return &AdvisoryLocker{
+		held: make(map[string]bool),
+	}
+}
+
+// Lock acquires the advisory lock with the given name or checks that it's
+// still held if it has been acquired before. It returns false if the lock is
+// held by another session. The locks don't expire, so ttl is ignored.
+func (l *AdvisoryLocker) Lock(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		conn, err := ctrl.db.Conn(ctx)
+		if err != nil {
+			return false, errors.Wrap(err, "get connection error")
+		}
+		l.conn = conn
+	}
+
+	if l.held[name] {
+		// locks are reentrant, so instead of locking again make sure that
+		// the session holding the lock is still alive
+		if err := l.conn.PingContext(ctx); err != nil {
+			l.reset()
+			return false, errors.Wrap(err, "ping error")
+		}
+		return true, nil
+	}
+
+	var locked bool
+	err := l.conn.QueryRowContext(ctx, `select pg_try_advisory_lock(hashtext($1))`, name).Scan(&locked)
+	if err != nil {
+		l.reset()
+		return false, handlePSQLError(Select, err, "select error")
+	}
+	l.held[name] = locked
+	return locked, nil
+}
+
+// Unlock releases the advisory lock with the given name
+func (l *AdvisoryLocker) Unlock(ctx context.Context, name string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.held[name] || l.conn == nil {
+		return nil
+	}
+	delete(l.held, name)
+	_, err := l.conn.ExecContext(ctx, `select pg_advisory_unlock(hashtext($1))`, name)
+	if err != nil {
+		l.reset()
+		return handlePSQLError(Select, err, "select error")
+	}
+	return nil
+}
+
+// reset closes the connection, all the locks held by the session are
+// released by the server
+func (l *AdvisoryLocker) reset() {
+	if l.conn != nil {
+		_ = l.conn.Close()
+		l.conn = nil
+	}
+	l.held = make(map[string]bool)
+}
@@ -3,7 +3,6 @@ package pgstore
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -14,191 +13,6 @@ import (
 	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
 )
 
-// CheckCreateApplicationAccess validate validates if the client has access to the applications resource.
-func (ps *PgStore) CheckCreateApplicationAccess(ctx context.Context, username string, userID, organizationID int64) (bool, error) {
-	// global admin
-	// organization admin
-	// organization device admin
-	var userWhere = [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "o.id = $2", "ou.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "o.id = $2", "ou.is_device_admin = true"},
-	}
-
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-	`
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, organizationID, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-// CheckListApplicationAccess :
-func (ps *PgStore) CheckListApplicationAccess(ctx context.Context, username string, userID, organizationID int64) (bool, error) {
-	// global admin
-	// organization user (when organization id is given)
-	// any active user (api will filter on user)
-	var userWhere = [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "$2 > 0", "o.id = $2 or a.organization_id = $2"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "$2 = 0"},
-	}
-
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-	`
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, organizationID, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-// CheckReadApplicationAccess :
-func (ps *PgStore) CheckReadApplicationAccess(ctx context.Context, username string, userID, applicationID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-	`
-	// global admin
-	// organization user
-	var userWhere = [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "a.id = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, applicationID, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-// CheckUpdateApplicationAccess :
-func (ps *PgStore) CheckUpdateApplicationAccess(ctx context.Context, username string, userID, applicationID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-	`
-	// global admin
-	// organization admin
-	// organization device admin
-	var userWhere = [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_device_admin = true", "a.id = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, applicationID, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-// CheckDeleteApplicationAccess :
-func (ps *PgStore) CheckDeleteApplicationAccess(ctx context.Context, username string, userID, applicationID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-	`
-	// global admin
-	// organization admin
-	// organization device admin
-	var userWhere = [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_device_admin = true", "a.id = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, applicationID, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
 // CreateApplication creates the given Application.
 func (ps *PgStore) CreateApplication(ctx context.Context, item *Application) error {
 	if err := item.Validate(); err != nil {
@@ -247,6 +61,19 @@ func (ps *PgStore) GetApplication(ctx context.Context, id int64) (Application, e
 	return app, nil
 }
 
+// GetApplicationByName returns the Application of the organization with the
+// given name.
+func (ps *PgStore) GetApplicationByName(ctx context.Context, orgID int64, name string) (Application, error) {
+	var app Application
+	err := sqlx.GetContext(ctx, ps.db, &app, "select * from application where organization_id = $1 and name = $2",
+		orgID, name)
+	if err != nil {
+		return app, handlePSQLError(Select, err, "select error")
+	}
+
+	return app, nil
+}
+
 // GetApplicationWithIDAndOrganizationID returns Application with given application id and organization id
 func (ps *PgStore) GetApplicationWithIDAndOrganizationID(ctx context.Context, id, orgID int64) (Application, error) {
 	var app Application
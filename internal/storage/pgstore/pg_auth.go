@@ -4,6 +4,11 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
 	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
 )
 
@@ -18,13 +23,20 @@ func (ps *PgStore) AuthGetUser(ctx context.Context, username string) (auth.User,
 }
 
 func (ps *PgStore) AuthGetOrgUser(ctx context.Context, userID int64, orgID int64) (auth.OrgUser, error) {
-	q := `SELECT is_admin, is_device_admin, is_gateway_admin
-		FROM organization_user WHERE user_id=$1 AND organization_id=$2`
+	q := `SELECT ou.is_admin, ou.is_device_admin, ou.is_gateway_admin, r.id, r.permissions
+		FROM organization_user ou LEFT JOIN organization_role r ON r.id = ou.role_id
+		WHERE ou.user_id=$1 AND ou.organization_id=$2`
 	row := ps.db.QueryRowContext(ctx, q, userID, orgID)
 	var ou auth.OrgUser
-	err := row.Scan(&ou.IsOrgAdmin, &ou.IsDeviceAdmin, &ou.IsGatewayAdmin)
+	var roleID sql.NullInt64
+	var perms pq.StringArray
+	err := row.Scan(&ou.IsOrgAdmin, &ou.IsDeviceAdmin, &ou.IsGatewayAdmin, &roleID, &perms)
 	if err == nil {
 		ou.IsOrgUser = true
+		if roleID.Valid {
+			ou.RoleID = roleID.Int64
+			ou.RolePermissions, err = auth.ParsePermissions(perms)
+		}
 	} else if err == sql.ErrNoRows {
 		// if user is not an org member, then we just return an empty OrgUser,
 		// it's not an error
@@ -32,3 +44,45 @@ func (ps *PgStore) AuthGetOrgUser(ctx context.Context, userID int64, orgID int64
 	}
 	return ou, err
 }
+
+// getOrganizationID returns the organization ID selected by the query
+func (ps *PgStore) getOrganizationID(ctx context.Context, q string, id interface{}) (int64, error) {
+	var orgID int64
+	if err := sqlx.GetContext(ctx, ps.db, &orgID, q, id); err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return orgID, nil
+}
+
+// AuthGetApplicationOrgID returns the organization of the application
+func (ps *PgStore) AuthGetApplicationOrgID(ctx context.Context, applicationID int64) (int64, error) {
+	return ps.getOrganizationID(ctx, `SELECT organization_id FROM application WHERE id=$1`, applicationID)
+}
+
+// AuthGetDeviceOrgID returns the organization of the device
+func (ps *PgStore) AuthGetDeviceOrgID(ctx context.Context, devEUI lorawan.EUI64) (int64, error) {
+	return ps.getOrganizationID(ctx, `SELECT a.organization_id FROM device d
+		JOIN application a ON a.id = d.application_id
+		WHERE d.dev_eui=$1`, devEUI[:])
+}
+
+// AuthGetServiceProfileOrgID returns the organization of the service profile
+func (ps *PgStore) AuthGetServiceProfileOrgID(ctx context.Context, id uuid.UUID) (int64, error) {
+	return ps.getOrganizationID(ctx, `SELECT organization_id FROM service_profile WHERE service_profile_id=$1`, id)
+}
+
+// AuthGetMulticastGroupOrgID returns the organization of the multicast group
+func (ps *PgStore) AuthGetMulticastGroupOrgID(ctx context.Context, id uuid.UUID) (int64, error) {
+	return ps.getOrganizationID(ctx, `SELECT sp.organization_id FROM multicast_group mg
+		JOIN service_profile sp ON sp.service_profile_id = mg.service_profile_id
+		WHERE mg.id=$1`, id)
+}
+
+// AuthGetFUOTADeploymentOrgID returns the organization of the devices of
+// the FUOTA deployment
+func (ps *PgStore) AuthGetFUOTADeploymentOrgID(ctx context.Context, id uuid.UUID) (int64, error) {
+	return ps.getOrganizationID(ctx, `SELECT a.organization_id FROM fuota_deployment_device fdd
+		JOIN device d ON d.dev_eui = fdd.dev_eui
+		JOIN application a ON a.id = d.application_id
+		WHERE fdd.fuota_deployment_id=$1 LIMIT 1`, id)
+}
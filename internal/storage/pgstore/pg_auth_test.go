@@ -0,0 +1,44 @@
+package pgstore
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/brocaar/lorawan"
+
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+)
+
+func TestAuthGetDeviceOrgID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	ctx := context.Background()
+	eui := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	query := regexp.QuoteMeta(`JOIN application a ON a.id = d.application_id`)
+
+	mock.ExpectQuery(query).WithArgs(eui[:]).
+		WillReturnRows(sqlmock.NewRows([]string{"organization_id"}).AddRow(3))
+	orgID, err := st.AuthGetDeviceOrgID(ctx, eui)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orgID != 3 {
+		t.Errorf("expected the organization 3, got %d", orgID)
+	}
+
+	// the unknown device is reported as not existing
+	mock.ExpectQuery(query).WithArgs(eui[:]).
+		WillReturnRows(sqlmock.NewRows([]string{"organization_id"}))
+	if _, err := st.AuthGetDeviceOrgID(ctx, eui); err != errHandler.ErrDoesNotExist {
+		t.Errorf("expected the does not exist error, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
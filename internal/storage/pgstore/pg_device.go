@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/brocaar/lorawan"
@@ -17,223 +16,6 @@ import (
 	device "github.com/mxc-foundation/lpwan-app-server/internal/modules/device/data"
 )
 
-func (ps *PgStore) CheckCreateNodeAccess(ctx context.Context, username string, applicationID int64, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-	`
-
-	// global admin
-	// organization admin
-	// organization device admin
-	var userWhere = [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_device_admin = true", "a.id = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, applicationID, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-func (ps *PgStore) CheckListNodeAccess(ctx context.Context, username string, applicationID int64, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-	`
-	// global admin
-	// organization user
-	var userWhere = [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "a.id = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, applicationID, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-func (ps *PgStore) CheckReadNodeAccess(ctx context.Context, username string, devEUI lorawan.EUI64, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-		left join device d
-			on a.id = d.application_id
-	`
-	// global admin
-	// organization user
-	var userWhere = [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "d.dev_eui = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, devEUI[:], userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-func (ps *PgStore) CheckUpdateNodeAccess(ctx context.Context, username string, devEUI lorawan.EUI64, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-		left join device d
-			on a.id = d.application_id
-	`
-	// global admin
-	// organization admin
-	// organization device admin
-	var userWhere = [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_admin = true", "d.dev_eui = $2"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_device_admin = true", "d.dev_eui = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, devEUI[:], userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-func (ps *PgStore) CheckDeleteNodeAccess(ctx context.Context, username string, devEUI lorawan.EUI64, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-		left join device d
-			on a.id = d.application_id
-	`
-	// global admin
-	// organization admin
-	// organization device admin
-	var userWhere = [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_admin = true", "d.dev_eui = $2"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_device_admin = true", "d.dev_eui = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, devEUI[:], userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-func (ps *PgStore) CheckCreateListDeleteDeviceQueueAccess(ctx context.Context, username string, devEUI lorawan.EUI64, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join application a
-			on a.organization_id = ou.organization_id
-		left join device d
-			on a.id = d.application_id
-	`
-	// global admin
-	// organization user
-	userWhere := [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "d.dev_eui = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, devEUI[:], userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
 // UpdateDeviceActivation updates the device address and the AppSKey.
 func (ps *PgStore) UpdateDeviceActivation(ctx context.Context, devEUI lorawan.EUI64, devAddr lorawan.DevAddr, appSKey lorawan.AES128Key) error {
 	res, err := ps.db.ExecContext(ctx, `
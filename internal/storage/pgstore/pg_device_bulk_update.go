@@ -0,0 +1,101 @@
+package pgstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/lib/pq/hstore"
+
+	device "github.com/mxc-foundation/lpwan-app-server/internal/modules/device/data"
+)
+
+// GetDevicesForBulkUpdate returns the devices matching the filters. If
+// forUpdate is true the devices are locked until the end of the transaction.
+func (ps *PgStore) GetDevicesForBulkUpdate(ctx context.Context, filters device.BulkDeviceFilters, forUpdate bool) ([]device.BulkDevice, error) {
+	conds := []string{"a.organization_id = $1"}
+	args := []interface{}{filters.OrganizationID}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filters.ApplicationID != 0 {
+		add("d.application_id = $%d", filters.ApplicationID)
+	}
+	if filters.DeviceProfileID != uuid.Nil {
+		add("d.device_profile_id = $%d", filters.DeviceProfileID)
+	}
+	if len(filters.Tags.Map) != 0 {
+		add("d.tags @> $%d", filters.Tags)
+	}
+	if len(filters.DevEUIs) != 0 {
+		euis := make(pq.ByteaArray, len(filters.DevEUIs))
+		for i := range filters.DevEUIs {
+			euis[i] = filters.DevEUIs[i][:]
+		}
+		add("d.dev_eui = any($%d)", euis)
+	}
+	query := `
+		select d.dev_eui, d.device_profile_id
+		from device d
+		inner join application a on a.id = d.application_id
+		where ` + strings.Join(conds, " and ") + `
+		order by d.dev_eui`
+	if filters.Limit > 0 {
+		args = append(args, filters.Limit)
+		query += fmt.Sprintf(" limit $%d", len(args))
+	}
+	if forUpdate {
+		query += " for update of d"
+	}
+	var devices []device.BulkDevice
+	if err := sqlx.SelectContext(ctx, ps.db, &devices, query, args...); err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return devices, nil
+}
+
+// BulkUpdateDevices applies the update to the given devices and returns the
+// number of the updated devices
+func (ps *PgStore) BulkUpdateDevices(ctx context.Context, devices []device.BulkDevice, upd device.BulkDeviceUpdate) (int64, error) {
+	euis := make(pq.ByteaArray, len(devices))
+	for i := range devices {
+		euis[i] = devices[i].DevEUI[:]
+	}
+	emptyIfNil := func(h hstore.Hstore) hstore.Hstore {
+		if h.Map == nil {
+			return hstore.Hstore{Map: map[string]sql.NullString{}}
+		}
+		return h
+	}
+	var dpID interface{}
+	if upd.DeviceProfileID != uuid.Nil {
+		dpID = upd.DeviceProfileID
+	}
+	res, err := ps.db.ExecContext(ctx, `
+		update device set
+			tags = (coalesce(tags, ''::hstore) - $2::text[]) || $3::hstore,
+			variables = (coalesce(variables, ''::hstore) - $4::text[]) || $5::hstore,
+			device_profile_id = coalesce($6, device_profile_id),
+			updated_at = now()
+		where dev_eui = any($1)`,
+		euis,
+		pq.StringArray(upd.UnsetTags),
+		emptyIfNil(upd.SetTags),
+		pq.StringArray(upd.UnsetVariables),
+		emptyIfNil(upd.SetVariables),
+		dpID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, handlePSQLError(Update, err, "update error")
+	}
+	return ra, nil
+}
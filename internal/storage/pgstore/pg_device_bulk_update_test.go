@@ -0,0 +1,233 @@
+package pgstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/brocaar/chirpstack-api/go/v3/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/lib/pq/hstore"
+
+	dpapi "github.com/mxc-foundation/lpwan-app-server/internal/api/external/dp"
+	nsapi "github.com/mxc-foundation/lpwan-app-server/internal/api/external/ns"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
+	appd "github.com/mxc-foundation/lpwan-app-server/internal/modules/application/data"
+	device "github.com/mxc-foundation/lpwan-app-server/internal/modules/device/data"
+	spd "github.com/mxc-foundation/lpwan-app-server/internal/modules/service-profile/data"
+)
+
+func testHstore(kv ...string) hstore.Hstore {
+	h := hstore.Hstore{Map: make(map[string]sql.NullString)}
+	for i := 0; i < len(kv); i += 2 {
+		h.Map[kv[i]] = sql.NullString{String: kv[i+1], Valid: true}
+	}
+	return h
+}
+
+func TestGetDevicesForBulkUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	ctx := context.Background()
+
+	dpID := uuid.Must(uuid.NewV4())
+	eui := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	filters := device.BulkDeviceFilters{
+		OrganizationID:  1,
+		ApplicationID:   2,
+		DeviceProfileID: dpID,
+		Tags:            testHstore("floor", "2"),
+		DevEUIs:         []lorawan.EUI64{eui},
+		Limit:           11,
+	}
+	// all the set filters are applied and the devices are locked
+	mock.ExpectQuery(regexp.QuoteMeta(`where a.organization_id = $1 and d.application_id = $2 and `+
+		`d.device_profile_id = $3 and d.tags @> $4 and d.dev_eui = any($5)`)+`\s+`+
+		regexp.QuoteMeta(`order by d.dev_eui limit $6 for update of d`)).
+		WithArgs(int64(1), int64(2), dpID, filters.Tags, pq.ByteaArray{eui[:]}, 11).
+		WillReturnRows(sqlmock.NewRows([]string{"dev_eui", "device_profile_id"}).AddRow(eui[:], dpID))
+	devices, err := st.GetDevicesForBulkUpdate(ctx, filters, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devices) != 1 || devices[0].DevEUI != eui || devices[0].DeviceProfileID != dpID {
+		t.Errorf("unexpected devices: %+v", devices)
+	}
+
+	// the dry run doesn't lock the devices
+	mock.ExpectQuery(regexp.QuoteMeta(`where a.organization_id = $1 and d.application_id = $2`)+`\s+`+
+		regexp.QuoteMeta(`order by d.dev_eui`)+`$`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"dev_eui", "device_profile_id"}))
+	if _, err := st.GetDevicesForBulkUpdate(ctx, device.BulkDeviceFilters{OrganizationID: 1, ApplicationID: 2}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBulkUpdateDevices(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	ctx := context.Background()
+
+	eui := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	devices := []device.BulkDevice{{DevEUI: eui}}
+	// the unset keys are removed before the set ones are added and the
+	// device profile is kept if not set
+	mock.ExpectExec(regexp.QuoteMeta(`tags = (coalesce(tags, ''::hstore) - $2::text[]) || $3::hstore`)+`.*`+
+		regexp.QuoteMeta(`device_profile_id = coalesce($6, device_profile_id)`)).
+		WithArgs(pq.ByteaArray{eui[:]}, pq.StringArray{"room"}, testHstore("floor", "3"),
+			pq.StringArray(nil), testHstore(), nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	count, err := st.BulkUpdateDevices(ctx, devices, device.BulkDeviceUpdate{
+		SetTags:   testHstore("floor", "3"),
+		UnsetTags: []string{"room"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 updated device, got %d", count)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestBulkUpdateDevicesOnDatabase checks on the real database that the
+// filters select the right devices, that the tags and variables are set and
+// unset, and that the update is rolled back with the transaction
+func TestBulkUpdateDevicesOnDatabase(t *testing.T) {
+	st := &PgStore{db: testDB(t)}
+	ctx := context.Background()
+
+	org := organization.Organization{Name: "bulk-org", DisplayName: "Bulk org"}
+	if err := st.CreateOrganization(ctx, &org); err != nil {
+		t.Fatal(err)
+	}
+	n := nsapi.NetworkServer{Name: "bulk-ns", Server: "ns:8000"}
+	if err := st.CreateNetworkServer(ctx, &n); err != nil {
+		t.Fatal(err)
+	}
+	sp := spd.ServiceProfile{
+		NetworkServerID: n.ID,
+		OrganizationID:  org.ID,
+		Name:            "bulk-sp",
+		ServiceProfile:  ns.ServiceProfile{Id: uuid.Must(uuid.NewV4()).Bytes()},
+	}
+	spID, err := st.CreateServiceProfile(ctx, &sp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dpID := uuid.Must(uuid.NewV4())
+	dp := dpapi.DeviceProfile{
+		NetworkServerID: n.ID,
+		OrganizationID:  org.ID,
+		Name:            "bulk-dp",
+		DeviceProfile:   ns.DeviceProfile{Id: dpID.Bytes()},
+	}
+	if err := st.CreateDeviceProfile(ctx, &dp); err != nil {
+		t.Fatal(err)
+	}
+	app := appd.Application{Name: "bulk-app", OrganizationID: org.ID, ServiceProfileID: *spID}
+	if err := st.CreateApplication(ctx, &app); err != nil {
+		t.Fatal(err)
+	}
+	euis := []lorawan.EUI64{{1}, {2}, {3}}
+	for i, eui := range euis {
+		tags := testHstore("floor", "2", "room", "a")
+		if i == 2 {
+			tags = testHstore("floor", "3")
+		}
+		if err := st.CreateDevice(ctx, &device.Device{
+			DevEUI:          eui,
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            eui.String(),
+			Tags:            tags,
+			Variables:       testHstore("key", "old"),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	filters := device.BulkDeviceFilters{OrganizationID: org.ID, Tags: testHstore("floor", "2")}
+	devices, err := st.GetDevicesForBulkUpdate(ctx, filters, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devices) != 2 || devices[0].DevEUI != euis[0] || devices[1].DevEUI != euis[1] {
+		t.Fatalf("expected the devices on the floor 2, got %+v", devices)
+	}
+	filters.DevEUIs = []lorawan.EUI64{euis[1], euis[2]}
+	if devices, err = st.GetDevicesForBulkUpdate(ctx, filters, false); err != nil {
+		t.Fatal(err)
+	}
+	if len(devices) != 1 || devices[0].DevEUI != euis[1] {
+		t.Fatalf("expected only the second device, got %+v", devices)
+	}
+	if devices, err = st.GetDevicesForBulkUpdate(ctx, device.BulkDeviceFilters{OrganizationID: org.ID + 1,
+		ApplicationID: app.ID}, false); err != nil {
+		t.Fatal(err)
+	}
+	if len(devices) != 0 {
+		t.Fatalf("expected no devices of another organization, got %+v", devices)
+	}
+
+	upd := device.BulkDeviceUpdate{
+		SetTags:        testHstore("room", "b", "building", "x"),
+		UnsetTags:      []string{"room"},
+		UnsetVariables: []string{"key"},
+	}
+	count, err := st.BulkUpdateDevices(ctx, []device.BulkDevice{{DevEUI: euis[0]}}, upd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("expected 1 updated device, got %d", count)
+	}
+	d, err := st.GetDevice(ctx, euis[0], false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(d.Tags, testHstore("floor", "2", "room", "b", "building", "x")) ||
+		len(d.Variables.Map) != 0 {
+		t.Errorf("unexpected tags %v and variables %v", d.Tags.Map, d.Variables.Map)
+	}
+
+	// the update is rolled back when the transaction fails, e.g. when the
+	// network server can't be updated
+	errNS := errors.New("network server error")
+	err = st.Tx(ctx, func(ctx context.Context, tx *PgStore) error {
+		if _, err := tx.BulkUpdateDevices(ctx, []device.BulkDevice{{DevEUI: euis[1]}},
+			device.BulkDeviceUpdate{SetTags: testHstore("floor", "9")}); err != nil {
+			return err
+		}
+		return errNS
+	})
+	if err != errNS {
+		t.Fatalf("expected the network server error, got %v", err)
+	}
+	if d, err = st.GetDevice(ctx, euis[1], false); err != nil {
+		t.Fatal(err)
+	}
+	if d.Tags.Map["floor"].String != "2" {
+		t.Errorf("expected the update to be rolled back, got tags %v", d.Tags.Map)
+	}
+}
@@ -2,7 +2,6 @@ package pgstore
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -15,155 +14,6 @@ import (
 	"github.com/mxc-foundation/lpwan-app-server/internal/logging"
 )
 
-func (ps *PgStore) CheckReadDeviceProfileAccess(ctx context.Context, username string, id uuid.UUID, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-		left join device_profile dp
-			on dp.organization_id = o.id
-	`
-	// gloabal admin
-	// organization users
-	userWhere := [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "dp.device_profile_id = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, id, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-func (ps *PgStore) CheckUpdateDeleteDeviceProfileAccess(ctx context.Context, username string, id uuid.UUID, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-		left join device_profile dp
-			on dp.organization_id = o.id
-	`
-	// global admin
-	// organization admin users
-	// organization device admin users
-	userWhere := [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_admin=true", "dp.device_profile_id = $2"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "ou.is_device_admin=true", "dp.device_profile_id = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, id, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-func (ps *PgStore) CheckCreateDeviceProfilesAccess(ctx context.Context, username string, organizationID, applicationID, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-	`
-
-	// global admin
-	// organization admin
-	// organization device admin
-	userWhere := [][]string{
-		{"(u.email = $1 or u.id = $4)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $4)", "u.is_active = true", "o.id = $2", "ou.is_admin = true", "$3 = 0"},
-		{"(u.email = $1 or u.id = $4)", "u.is_active = true", "o.id = $2", "ou.is_device_admin = true", "$3 = 0"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, organizationID, applicationID, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-func (ps *PgStore) CheckListDeviceProfilesAccess(ctx context.Context, username string, organizationID, applicationID, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-		left join application a
-			on a.organization_id = o.id
-	`
-	// global admin
-	// organization user (when organization id is given)
-	// user linked to a given application (when application id is given)
-	// any active user (filtered by user)
-	userWhere := [][]string{
-		{"(u.email = $1 or u.id = $4)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $4)", "u.is_active = true", "$3 = 0", "$2 > 0", "o.id = $2"},
-		{"(u.email = $1 or u.id = $4)", "u.is_active = true", "$2 = 0", "$3 > 0", "a.id = $3"},
-		{"(u.email = $1 or u.id = $4)", "u.is_active = true", "$2 = 0", "$3 = 0"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, organizationID, applicationID, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
 // DeleteAllDeviceProfilesForOrganizationID deletes all device-profiles
 // given an organization id.
 func (ps *PgStore) DeleteAllDeviceProfilesForOrganizationID(ctx context.Context, organizationID int64) error {
@@ -371,6 +221,26 @@ func (ps *PgStore) GetDeviceProfile(ctx context.Context, id uuid.UUID, forUpdate
 	return dp, nil
 }
 
+// GetDeviceProfileIDByName returns the id of the device-profile of the
+// organization with the given name. If there are several, the oldest one is
+// returned.
+func (ps *PgStore) GetDeviceProfileIDByName(ctx context.Context, orgID int64, name string) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := sqlx.GetContext(ctx, ps.db, &id, `
+		select device_profile_id
+		from device_profile
+		where organization_id = $1 and name = $2
+		order by created_at
+		limit 1`,
+		orgID,
+		name,
+	)
+	if err != nil {
+		return id, handlePSQLError(Select, err, "select error")
+	}
+	return id, nil
+}
+
 // GetDefaultDeviceProfileID returns the default device profile id with given organization id and network server id
 func (ps *PgStore) GetDefaultDeviceProfileID(ctx context.Context, orgID, nsID int64, forUpdate bool) (*uuid.UUID, error) {
 	var fu string
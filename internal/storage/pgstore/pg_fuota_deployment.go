@@ -3,7 +3,6 @@ package pgstore
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -22,80 +21,6 @@ import (
 	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/fuota-deployment/data"
 )
 
-func (ps *PgStore) CheckReadFUOTADeploymentAccess(ctx context.Context, username string, id uuid.UUID, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join application a
-			on a.organization_id = ou.organization_id
-		left join device d
-			on a.id = d.application_id
-		left join fuota_deployment_device fdd
-			on d.dev_eui = fdd.dev_eui
-		left join fuota_deployment fd
-			on fdd.fuota_deployment_id = fd.id
-	`
-	// global admin
-	// organization user
-	userWhere := [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "fd.id = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, id, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-
-}
-
-func (ps *PgStore) CheckCreateFUOTADeploymentsAccess(ctx context.Context, username string, applicationID int64, devEUI lorawan.EUI64, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join application a
-			on a.organization_id = ou.organization_id
-		left join device d
-			on a.id = d.application_id
-	`
-	// global admin
-	// organization admin
-	userWhere := [][]string{
-		{"(u.email = $1 or u.id = $4)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $4)", "u.is_active = true", "ou.is_admin = true", "$2 > 0", "a.id = $2"},
-		{"(u.email = $1 or u.id = $4)", "u.is_active = true", "ou.is_admin = true", "$2 = 0", "d.dev_eui = $3"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, applicationID, devEUI, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
 func (ps *PgStore) GetDeviceKeysFromFuotaDevelopmentDevice(ctx context.Context, id uuid.UUID) ([]ds.DeviceKeys, error) {
 	// query all device-keys that relate to this FUOTA deployment
 	var deviceKeys []ds.DeviceKeys
@@ -973,6 +973,93 @@ func (ps *PgStore) GetLastGatewayPingAndRX(ctx context.Context, mac lorawan.EUI6
 	return ping, rx, nil
 }
 
+// GetGatewayPingLinkStats returns reception statistics of the pings sent by
+// the gateways of the organization between start and end, aggregated by
+// sender, receiver and period. Period is the precision accepted by
+// date_trunc, e.g. hour or day.
+func (ps *PgStore) GetGatewayPingLinkStats(ctx context.Context, organizationID int64, start, end time.Time,
+	period string) ([]GatewayPingLinkStats, error) {
+	var stats []GatewayPingLinkStats
+	err := sqlx.SelectContext(ctx, ps.db, &stats, `
+		select
+			p.gateway_mac as sender_mac,
+			rx.gateway_mac as receiver_mac,
+			date_trunc($4, p.created_at) as period,
+			count(*) as received,
+			avg(rx.rssi)::double precision as avg_rssi,
+			avg(rx.lora_snr)::double precision as avg_snr,
+			max(rx.rssi) as best_rssi
+		from
+			gateway_ping p
+		inner join gateway g
+			on g.mac = p.gateway_mac
+		inner join gateway_ping_rx rx
+			on rx.ping_id = p.id
+		where
+			g.organization_id = $1
+			and p.created_at >= $2
+			and p.created_at < $3
+		group by 1, 2, 3
+		order by 1, 2, 3`,
+		organizationID,
+		start,
+		end,
+		period)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return stats, nil
+}
+
+// GetGatewayPingSentStats returns the number of pings sent by the gateways of
+// the organization between start and end, aggregated by gateway and period
+func (ps *PgStore) GetGatewayPingSentStats(ctx context.Context, organizationID int64, start, end time.Time,
+	period string) ([]GatewayPingSentStats, error) {
+	var stats []GatewayPingSentStats
+	err := sqlx.SelectContext(ctx, ps.db, &stats, `
+		select
+			p.gateway_mac,
+			date_trunc($4, p.created_at) as period,
+			count(*) as sent
+		from
+			gateway_ping p
+		inner join gateway g
+			on g.mac = p.gateway_mac
+		where
+			g.organization_id = $1
+			and p.created_at >= $2
+			and p.created_at < $3
+		group by 1, 2
+		order by 1, 2`,
+		organizationID,
+		start,
+		end,
+		period)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return stats, nil
+}
+
+// DeleteGatewayPingsBefore deletes the pings created before the given time
+// together with their receptions. The last ping of each gateway is kept.
+func (ps *PgStore) DeleteGatewayPingsBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := ps.db.ExecContext(ctx, `
+		delete from gateway_ping p
+		where
+			p.created_at < $1
+			and not exists (select 1 from gateway g where g.last_ping_id = p.id)`,
+		before)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "get rows affected error")
+	}
+	return ra, nil
+}
+
 // GetGatewaysActiveInactive returns the active / inactive gateways.
 func (ps *PgStore) GetGatewaysActiveInactive(ctx context.Context, organizationID int64) (GatewaysActiveInactive, error) {
 	var out GatewaysActiveInactive
@@ -1013,13 +1100,23 @@ func (ps *PgStore) GetGatewayForPing(ctx context.Context) (*Gateway, error) {
 		from gateway g
 		inner join network_server ns
 			on ns.id = g.network_server_id
+		left join gateway_profile gp
+			on gp.gateway_profile_id = g.gateway_profile_id
 		where
 			ns.gateway_discovery_enabled = true
 			and g.ping = true
-			and (g.last_ping_sent_at is null or g.last_ping_sent_at <= (now() - (interval '24 hours' / ns.gateway_discovery_interval)))
+			and (
+				g.last_ping_sent_at is null
+				or g.last_ping_sent_at <= (now() - (
+					case when coalesce(gp.ping_interval, 0) > 0
+						then make_interval(secs => gp.ping_interval / 1000000000.0)
+						else interval '24 hours' / ns.gateway_discovery_interval
+					end
+				))
+			)
 		order by last_ping_sent_at
 		limit 1
-		for update`,
+		for update of g`,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
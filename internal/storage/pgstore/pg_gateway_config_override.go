@@ -0,0 +1,63 @@
+package pgstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/jmoiron/sqlx"
+
+	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+)
+
+// GetGatewayConfigOverrides returns the settings of the gateway applied on top
+// of the default gateway config, the empty overrides are returned if none has
+// been set
+func (ps *PgStore) GetGatewayConfigOverrides(ctx context.Context, mac lorawan.EUI64) (GatewayConfigOverrides, error) {
+	var ov GatewayConfigOverrides
+	var data []byte
+	err := sqlx.GetContext(ctx, ps.db, &data, `
+		select overrides from gateway_config_override where gateway_mac = $1`,
+		mac[:],
+	)
+	if err == sql.ErrNoRows {
+		return ov, nil
+	}
+	if err != nil {
+		return ov, handlePSQLError(Select, err, "select error")
+	}
+	if err := json.Unmarshal(data, &ov); err != nil {
+		return ov, err
+	}
+	return ov, nil
+}
+
+// SetGatewayConfigOverrides replaces the settings of the gateway applied on
+// top of the default gateway config, the empty overrides are deleted
+func (ps *PgStore) SetGatewayConfigOverrides(ctx context.Context, mac lorawan.EUI64, ov GatewayConfigOverrides) error {
+	if ov.IsEmpty() {
+		if _, err := ps.db.ExecContext(ctx, `
+			delete from gateway_config_override where gateway_mac = $1`, mac[:]); err != nil {
+			return handlePSQLError(Delete, err, "delete error")
+		}
+		return nil
+	}
+	data, err := json.Marshal(ov)
+	if err != nil {
+		return err
+	}
+	_, err = ps.db.ExecContext(ctx, `
+		insert into gateway_config_override (gateway_mac, overrides, updated_at)
+		values ($1, $2, $3)
+		on conflict (gateway_mac) do update set overrides = excluded.overrides, updated_at = excluded.updated_at`,
+		mac[:],
+		string(data),
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package pgstore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/types"
+)
+
+// AddGatewayConfigVersion stores the config as the next version of the
+// gateway config. Version and CreatedAt are set by this method. The caller
+// should hold the lock on the gateway row.
+func (ps *PgStore) AddGatewayConfigVersion(ctx context.Context, cv *GatewayConfigVersion) error {
+	cv.CreatedAt = time.Now()
+	err := sqlx.GetContext(ctx, ps.db, &cv.Version, `
+		insert into gateway_config_version (
+			gateway_mac,
+			version,
+			config,
+			config_hash,
+			author,
+			reason,
+			created_at
+		) values (
+			$1,
+			(select coalesce(max(version), 0) + 1 from gateway_config_version where gateway_mac = $1),
+			$2, $3, $4, $5, $6)
+		returning version`,
+		cv.GatewayMAC[:],
+		cv.Config,
+		cv.ConfigHash[:],
+		cv.Author,
+		cv.Reason,
+		cv.CreatedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
+
+// GetGatewayConfigVersion returns the given version of the gateway config
+func (ps *PgStore) GetGatewayConfigVersion(ctx context.Context, mac lorawan.EUI64, version int) (GatewayConfigVersion, error) {
+	var cv GatewayConfigVersion
+	err := sqlx.GetContext(ctx, ps.db, &cv, `
+		select * from gateway_config_version where gateway_mac = $1 and version = $2`,
+		mac[:],
+		version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return cv, errHandler.ErrDoesNotExist
+		}
+		return cv, errors.Wrap(err, "select error")
+	}
+	return cv, nil
+}
+
+// GetGatewayConfigVersionCount returns the number of stored versions of the
+// gateway config
+func (ps *PgStore) GetGatewayConfigVersionCount(ctx context.Context, mac lorawan.EUI64) (int, error) {
+	var count int
+	err := sqlx.GetContext(ctx, ps.db, &count, `
+		select count(*) from gateway_config_version where gateway_mac = $1`,
+		mac[:])
+	if err != nil {
+		return 0, errors.Wrap(err, "select error")
+	}
+	return count, nil
+}
+
+// GetGatewayConfigVersions returns the versions of the gateway config starting
+// from the latest one
+func (ps *PgStore) GetGatewayConfigVersions(ctx context.Context, mac lorawan.EUI64, limit, offset int) ([]GatewayConfigVersion, error) {
+	var list []GatewayConfigVersion
+	err := sqlx.SelectContext(ctx, ps.db, &list, `
+		select
+			*
+		from
+			gateway_config_version
+		where
+			gateway_mac = $1
+		order by version desc
+		limit $2 offset $3`,
+		mac[:],
+		limit,
+		offset)
+	if err != nil {
+		return nil, errors.Wrap(err, "select error")
+	}
+	return list, nil
+}
+
+// AcknowledgeGatewayConfigVersion records that the gateway reported running
+// the config with the given hash. Only the latest version with this hash is
+// acknowledged, and only once.
+func (ps *PgStore) AcknowledgeGatewayConfigVersion(ctx context.Context, mac lorawan.EUI64, hash types.MD5SUM) error {
+	_, err := ps.db.ExecContext(ctx, `
+		update gateway_config_version
+			set acknowledged_at = now()
+		where
+			gateway_mac = $1
+			and version = (
+				select max(version) from gateway_config_version where gateway_mac = $1 and config_hash = $2
+			)
+			and acknowledged_at is null`,
+		mac[:],
+		hash[:])
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	return nil
+}
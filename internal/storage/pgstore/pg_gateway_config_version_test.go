@@ -0,0 +1,70 @@
+package pgstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/brocaar/lorawan"
+
+	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/types"
+)
+
+func TestAddGatewayConfigVersion(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	ctx := context.Background()
+
+	mac := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	cv := GatewayConfigVersion{
+		GatewayMAC: mac,
+		Config:     "{}",
+		ConfigHash: types.MD5SUM{1},
+		Author:     "alice@example.com",
+		Reason:     "initial",
+	}
+	// the version is the next one for this gateway
+	mock.ExpectQuery(`insert into gateway_config_version.*`+
+		`\(select coalesce\(max\(version\), 0\) \+ 1 from gateway_config_version where gateway_mac = \$1\).*returning version`).
+		WithArgs(mac[:], "{}", cv.ConfigHash[:], "alice@example.com", "initial", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(4))
+	if err := st.AddGatewayConfigVersion(ctx, &cv); err != nil {
+		t.Fatal(err)
+	}
+	if cv.Version != 4 || cv.CreatedAt.IsZero() {
+		t.Errorf("unexpected version: %+v", cv)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAcknowledgeGatewayConfigVersion(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	ctx := context.Background()
+
+	mac := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	hash := types.MD5SUM{2}
+	// only the latest version with the hash is acknowledged, and only once
+	mock.ExpectExec(`update gateway_config_version\s+set acknowledged_at = now\(\).*`+
+		`select max\(version\) from gateway_config_version where gateway_mac = \$1 and config_hash = \$2.*`+
+		`and acknowledged_at is null`).
+		WithArgs(mac[:], hash[:]).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := st.AcknowledgeGatewayConfigVersion(ctx, mac, hash); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
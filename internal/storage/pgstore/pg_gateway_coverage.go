@@ -0,0 +1,62 @@
+package pgstore
+
+import (
+	"context"
+
+	"github.com/brocaar/lorawan"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/coverage/data"
+)
+
+// UpsertCoverageCell adds the uplink to the coverage cell of the gateway,
+// keeping the best RSSI and SNR. Uplinks received by the gateways that are
+// not registered on this server are ignored.
+func (ps *PgStore) UpsertCoverageCell(ctx context.Context, cell CoverageCell) error {
+	_, err := ps.db.ExecContext(ctx, `
+		insert into gateway_coverage (
+			gateway_mac,
+			geohash,
+			best_rssi,
+			best_snr,
+			uplink_count,
+			first_seen_at,
+			last_seen_at
+		)
+		select $1, $2, $3, $4, 1, $5, $5
+		where exists (select 1 from gateway where mac = $1)
+		on conflict (gateway_mac, geohash) do update set
+			best_rssi = greatest(gateway_coverage.best_rssi, excluded.best_rssi),
+			best_snr = greatest(gateway_coverage.best_snr, excluded.best_snr),
+			uplink_count = gateway_coverage.uplink_count + 1,
+			last_seen_at = greatest(gateway_coverage.last_seen_at, excluded.last_seen_at)`,
+		cell.GatewayMAC[:],
+		cell.Geohash,
+		cell.BestRSSI,
+		cell.BestSNR,
+		cell.LastSeenAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
+
+// GetGatewayCoverage returns the coverage cells of the gateway
+func (ps *PgStore) GetGatewayCoverage(ctx context.Context, mac lorawan.EUI64) ([]CoverageCell, error) {
+	var cells []CoverageCell
+	err := sqlx.SelectContext(ctx, ps.db, &cells, `
+		select
+			*
+		from
+			gateway_coverage
+		where
+			gateway_mac = $1
+		order by geohash`,
+		mac[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "select error")
+	}
+	return cells, nil
+}
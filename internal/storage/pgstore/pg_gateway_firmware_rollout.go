@@ -0,0 +1,371 @@
+package pgstore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+)
+
+// CreateFirmwareRollout creates a new firmware rollout campaign
+func (ps *PgStore) CreateFirmwareRollout(ctx context.Context, rollout *FirmwareRollout) error {
+	now := time.Now()
+	err := sqlx.GetContext(ctx, ps.db, &rollout.ID, `
+		insert into gateway_firmware_rollout (
+			model,
+			resource_link,
+			md5_hash,
+			previous_resource_link,
+			previous_md5_hash,
+			percentage,
+			max_silent_percentage,
+			silent_timeout,
+			status,
+			created_at,
+			updated_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+		returning id`,
+		rollout.Model,
+		rollout.ResourceLink,
+		rollout.FirmwareHash[:],
+		rollout.PreviousResourceLink,
+		rollout.PreviousFirmwareHash[:],
+		rollout.Percentage,
+		rollout.MaxSilentPercentage,
+		rollout.SilentTimeout,
+		rollout.Status,
+		now,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	rollout.CreatedAt = now
+	rollout.UpdatedAt = now
+	return nil
+}
+
+// GetFirmwareRollout returns the firmware rollout with the given id
+func (ps *PgStore) GetFirmwareRollout(ctx context.Context, id int64, forUpdate bool) (FirmwareRollout, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var rollout FirmwareRollout
+	err := sqlx.GetContext(ctx, ps.db, &rollout, "select * from gateway_firmware_rollout where id = $1"+fu, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return rollout, errHandler.ErrDoesNotExist
+		}
+		return rollout, errors.Wrap(err, "select error")
+	}
+	return rollout, nil
+}
+
+// GetLatestFirmwareRollout returns the most recently created firmware rollout
+// for the given model regardless of its status
+func (ps *PgStore) GetLatestFirmwareRollout(ctx context.Context, model string) (FirmwareRollout, error) {
+	var rollout FirmwareRollout
+	err := sqlx.GetContext(ctx, ps.db, &rollout, `
+		select *
+		from
+			gateway_firmware_rollout
+		where
+			model = $1
+		order by id desc
+		limit 1`,
+		model)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return rollout, errHandler.ErrDoesNotExist
+		}
+		return rollout, errors.Wrap(err, "select error")
+	}
+	return rollout, nil
+}
+
+// GetFirmwareRolloutsByStatus returns all firmware rollouts with the given status
+func (ps *PgStore) GetFirmwareRolloutsByStatus(ctx context.Context, status string) ([]FirmwareRollout, error) {
+	var list []FirmwareRollout
+	err := sqlx.SelectContext(ctx, ps.db, &list, `
+		select * from gateway_firmware_rollout where status = $1 order by id`,
+		status)
+	if err != nil {
+		return nil, errors.Wrap(err, "select error")
+	}
+	return list, nil
+}
+
+// GetFirmwareRolloutCount returns the number of firmware rollouts, if model is
+// not empty only rollouts for this model are counted
+func (ps *PgStore) GetFirmwareRolloutCount(ctx context.Context, model string) (int, error) {
+	var count int
+	err := sqlx.GetContext(ctx, ps.db, &count, `
+		select count(*) from gateway_firmware_rollout where $1 = '' or model = $1`,
+		model)
+	if err != nil {
+		return 0, errors.Wrap(err, "select error")
+	}
+	return count, nil
+}
+
+// GetFirmwareRollouts returns firmware rollouts starting from the latest one,
+// if model is not empty only rollouts for this model are returned
+func (ps *PgStore) GetFirmwareRollouts(ctx context.Context, model string, limit, offset int) ([]FirmwareRollout, error) {
+	var list []FirmwareRollout
+	err := sqlx.SelectContext(ctx, ps.db, &list, `
+		select *
+		from
+			gateway_firmware_rollout
+		where
+			$1 = '' or model = $1
+		order by id desc
+		limit $2 offset $3`,
+		model,
+		limit,
+		offset)
+	if err != nil {
+		return nil, errors.Wrap(err, "select error")
+	}
+	return list, nil
+}
+
+// UpdateFirmwareRolloutStatus sets status of the firmware rollout
+func (ps *PgStore) UpdateFirmwareRolloutStatus(ctx context.Context, id int64, status string) error {
+	res, err := ps.db.ExecContext(ctx, `
+		update gateway_firmware_rollout
+			set status = $1, updated_at = now()
+		where
+			id = $2`,
+		status,
+		id)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return errHandler.ErrDoesNotExist
+	}
+	return nil
+}
+
+// GetFirmwareRolloutCandidates returns the MACs of the gateways of the given
+// model that automatically update their firmware. The order is stable so that
+// repeated selection of a percentage of the gateways returns the same ones.
+func (ps *PgStore) GetFirmwareRolloutCandidates(ctx context.Context, model string) ([]lorawan.EUI64, error) {
+	var macs []lorawan.EUI64
+	err := sqlx.SelectContext(ctx, ps.db, &macs, `
+		select
+			mac
+		from
+			gateway
+		where
+			model = $1 and auto_update_firmware = true
+		order by md5(mac)`,
+		model)
+	if err != nil {
+		return nil, errors.Wrap(err, "select error")
+	}
+	return macs, nil
+}
+
+// AddFirmwareRolloutGateways adds the given gateways to the firmware rollout
+func (ps *PgStore) AddFirmwareRolloutGateways(ctx context.Context, rolloutID int64, macs []lorawan.EUI64, canary bool) error {
+	for _, mac := range macs {
+		_, err := ps.db.ExecContext(ctx, `
+			insert into gateway_firmware_rollout_gateway (
+				rollout_id, gateway_mac, canary, status
+			) values ($1, $2, $3, $4)
+			on conflict (rollout_id, gateway_mac) do update set canary = gateway_firmware_rollout_gateway.canary or $3`,
+			rolloutID,
+			mac[:],
+			canary,
+			FirmwareRolloutGatewayPending)
+		if err != nil {
+			return handlePSQLError(Insert, err, "insert error")
+		}
+	}
+	return nil
+}
+
+// GetFirmwareRolloutGateway returns the state of the gateway in the firmware
+// rollout, ErrDoesNotExist is returned if the gateway was not selected for
+// the rollout
+func (ps *PgStore) GetFirmwareRolloutGateway(ctx context.Context, rolloutID int64, mac lorawan.EUI64) (FirmwareRolloutGateway, error) {
+	var rg FirmwareRolloutGateway
+	err := sqlx.GetContext(ctx, ps.db, &rg, `
+		select
+			rg.*, g.last_heartbeat
+		from
+			gateway_firmware_rollout_gateway rg
+		inner join gateway g
+			on g.mac = rg.gateway_mac
+		where
+			rg.rollout_id = $1 and rg.gateway_mac = $2`,
+		rolloutID,
+		mac[:])
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return rg, errHandler.ErrDoesNotExist
+		}
+		return rg, errors.Wrap(err, "select error")
+	}
+	return rg, nil
+}
+
+// GetFirmwareRolloutGateways returns all the gateways selected for the
+// firmware rollout
+func (ps *PgStore) GetFirmwareRolloutGateways(ctx context.Context, rolloutID int64) ([]FirmwareRolloutGateway, error) {
+	var list []FirmwareRolloutGateway
+	err := sqlx.SelectContext(ctx, ps.db, &list, `
+		select
+			rg.*, g.last_heartbeat
+		from
+			gateway_firmware_rollout_gateway rg
+		inner join gateway g
+			on g.mac = rg.gateway_mac
+		where
+			rg.rollout_id = $1
+		order by rg.canary desc, rg.gateway_mac`,
+		rolloutID)
+	if err != nil {
+		return nil, errors.Wrap(err, "select error")
+	}
+	return list, nil
+}
+
+// SetFirmwareRolloutGatewayOffered marks that the new firmware has been sent
+// to the gateway
+func (ps *PgStore) SetFirmwareRolloutGatewayOffered(ctx context.Context, rolloutID int64, mac lorawan.EUI64) error {
+	_, err := ps.db.ExecContext(ctx, `
+		update gateway_firmware_rollout_gateway
+			set status = $1, offered_at = now()
+		where
+			rollout_id = $2 and gateway_mac = $3 and status = $4`,
+		FirmwareRolloutGatewayOffered,
+		rolloutID,
+		mac[:],
+		FirmwareRolloutGatewayPending)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	return nil
+}
+
+// SetFirmwareRolloutGatewaySucceeded marks that the gateway reported the hash
+// of the new firmware
+func (ps *PgStore) SetFirmwareRolloutGatewaySucceeded(ctx context.Context, rolloutID int64, mac lorawan.EUI64) error {
+	_, err := ps.db.ExecContext(ctx, `
+		update gateway_firmware_rollout_gateway
+			set status = $1, confirmed_at = now(), offered_at = coalesce(offered_at, now())
+		where
+			rollout_id = $2 and gateway_mac = $3 and status != $1`,
+		FirmwareRolloutGatewaySucceeded,
+		rolloutID,
+		mac[:])
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	return nil
+}
+
+// GetFirmwareRolloutSummary returns counters of the gateways selected for the
+// rollout. Pending gateways that haven't sent any heartbeat since the rollout
+// was created are not counted as pending as they would block the rollout
+// forever.
+func (ps *PgStore) GetFirmwareRolloutSummary(ctx context.Context, rolloutID int64) (FirmwareRolloutSummary, error) {
+	var summary FirmwareRolloutSummary
+	err := sqlx.GetContext(ctx, ps.db, &summary, `
+		select
+			count(*) as total,
+			coalesce(sum(case when rg.status = $2 and g.last_heartbeat >= extract(epoch from r.created_at) then 1 end), 0) as pending,
+			coalesce(sum(case when rg.status = $3 then 1 end), 0) as offered,
+			coalesce(sum(case when rg.status = $4 then 1 end), 0) as succeeded,
+			coalesce(sum(case when rg.status = $5 then 1 end), 0) as unconfirmed
+		from
+			gateway_firmware_rollout_gateway rg
+		inner join gateway_firmware_rollout r
+			on r.id = rg.rollout_id
+		inner join gateway g
+			on g.mac = rg.gateway_mac
+		where
+			rg.rollout_id = $1`,
+		rolloutID,
+		FirmwareRolloutGatewayPending,
+		FirmwareRolloutGatewayOffered,
+		FirmwareRolloutGatewaySucceeded,
+		FirmwareRolloutGatewayUnconfirmed)
+	if err != nil {
+		return summary, errors.Wrap(err, "select error")
+	}
+	return summary, nil
+}
+
+// GetFirmwareRolloutSilentCount returns the number of gateways that were
+// offered the new firmware more than silentTimeout ago and haven't sent any
+// heartbeat since then
+func (ps *PgStore) GetFirmwareRolloutSilentCount(ctx context.Context, rolloutID int64, silentTimeout time.Duration) (int, error) {
+	var count int
+	cutoff := time.Now().Add(-silentTimeout)
+	err := sqlx.GetContext(ctx, ps.db, &count, `
+		select
+			count(*)
+		from
+			gateway_firmware_rollout_gateway rg
+		inner join gateway g
+			on g.mac = rg.gateway_mac
+		where
+			rg.rollout_id = $1
+			and rg.status = $2
+			and rg.offered_at < $3
+			and g.last_heartbeat < $4`,
+		rolloutID,
+		FirmwareRolloutGatewayOffered,
+		cutoff,
+		cutoff.Unix())
+	if err != nil {
+		return 0, errors.Wrap(err, "select error")
+	}
+	return count, nil
+}
+
+// SetFirmwareRolloutGatewaysUnconfirmed marks the gateways that were offered
+// the new firmware more than timeout ago and have sent heartbeats since then
+// without reporting the firmware hash as unconfirmed. Returns the number of
+// the marked gateways.
+func (ps *PgStore) SetFirmwareRolloutGatewaysUnconfirmed(ctx context.Context, rolloutID int64, timeout time.Duration) (int, error) {
+	cutoff := time.Now().Add(-timeout)
+	res, err := ps.db.ExecContext(ctx, `
+		update gateway_firmware_rollout_gateway rg
+			set status = $1
+		from
+			gateway g
+		where
+			g.mac = rg.gateway_mac
+			and rg.rollout_id = $2
+			and rg.status = $3
+			and rg.offered_at < $4
+			and g.last_heartbeat >= $5`,
+		FirmwareRolloutGatewayUnconfirmed,
+		rolloutID,
+		FirmwareRolloutGatewayOffered,
+		cutoff,
+		cutoff.Unix())
+	if err != nil {
+		return 0, handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "get rows affected error")
+	}
+	return int(ra), nil
+}
@@ -0,0 +1,150 @@
+package pgstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/brocaar/lorawan"
+
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/gateway/data"
+	"github.com/mxc-foundation/lpwan-app-server/internal/types"
+)
+
+func TestCreateFirmwareRollout(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	ctx := context.Background()
+
+	rollout := FirmwareRollout{
+		Model:                "MX1901",
+		ResourceLink:         "new",
+		FirmwareHash:         types.MD5SUM{2},
+		PreviousResourceLink: "old",
+		PreviousFirmwareHash: types.MD5SUM{1},
+		Percentage:           10,
+		MaxSilentPercentage:  20,
+		SilentTimeout:        30 * time.Minute,
+		Status:               FirmwareRolloutRunning,
+	}
+	mock.ExpectQuery("insert into gateway_firmware_rollout.*returning id").
+		WithArgs("MX1901", "new", rollout.FirmwareHash[:], "old", rollout.PreviousFirmwareHash[:],
+			10, 20, int64(30*time.Minute), FirmwareRolloutRunning, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	if err := st.CreateFirmwareRollout(ctx, &rollout); err != nil {
+		t.Fatal(err)
+	}
+	if rollout.ID != 5 || rollout.CreatedAt.IsZero() {
+		t.Errorf("unexpected rollout: %+v", rollout)
+	}
+
+	mac := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	mock.ExpectExec("insert into gateway_firmware_rollout_gateway.*on conflict").
+		WithArgs(int64(5), mac[:], true, FirmwareRolloutGatewayPending).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := st.AddFirmwareRolloutGateways(ctx, 5, []lorawan.EUI64{mac}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFirmwareRolloutStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("select \\* from gateway_firmware_rollout where id = \\$1 for update").
+		WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "model", "status", "silent_timeout"}).
+			AddRow(5, "MX1901", FirmwareRolloutRunning, int64(time.Minute)))
+	rollout, err := st.GetFirmwareRollout(ctx, 5, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rollout.Status != FirmwareRolloutRunning || rollout.SilentTimeout != time.Minute {
+		t.Errorf("unexpected rollout: %+v", rollout)
+	}
+
+	mock.ExpectQuery("select \\* from gateway_firmware_rollout where id = \\$1$").
+		WithArgs(int64(6)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	if _, err := st.GetFirmwareRollout(ctx, 6, false); err != errHandler.ErrDoesNotExist {
+		t.Errorf("expected ErrDoesNotExist, got %v", err)
+	}
+
+	mock.ExpectExec("update gateway_firmware_rollout\\s+set status = \\$1").
+		WithArgs(FirmwareRolloutPaused, int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := st.UpdateFirmwareRolloutStatus(ctx, 5, FirmwareRolloutPaused); err != nil {
+		t.Fatal(err)
+	}
+	mock.ExpectExec("update gateway_firmware_rollout\\s+set status = \\$1").
+		WithArgs(FirmwareRolloutRunning, int64(6)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	if err := st.UpdateFirmwareRolloutStatus(ctx, 6, FirmwareRolloutRunning); err != errHandler.ErrDoesNotExist {
+		t.Errorf("expected ErrDoesNotExist, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetFirmwareRolloutSilentCount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("select\\s+count\\(\\*\\).*rg.status = \\$2\\s+and rg.offered_at < \\$3\\s+and g.last_heartbeat < \\$4").
+		WithArgs(int64(5), FirmwareRolloutGatewayOffered, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	count, err := st.GetFirmwareRolloutSilentCount(ctx, 5, 30*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("expected 3 silent gateways, got %d", count)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetFirmwareRolloutGatewaysUnconfirmed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	ctx := context.Background()
+
+	mock.ExpectExec("update gateway_firmware_rollout_gateway rg\\s+set status = \\$1.*rg.status = \\$3\\s+and rg.offered_at < \\$4\\s+and g.last_heartbeat >= \\$5").
+		WithArgs(FirmwareRolloutGatewayUnconfirmed, int64(5), FirmwareRolloutGatewayOffered, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	count, err := st.SetFirmwareRolloutGatewaysUnconfirmed(ctx, 5, 30*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 unconfirmed gateways, got %d", count)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -3,7 +3,6 @@ package pgstore
 import (
 	"context"
 	"database/sql"
-	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -17,58 +16,6 @@ import (
 	"github.com/mxc-foundation/lpwan-app-server/internal/logging"
 )
 
-func (ps *PgStore) CheckCreateUpdateDeleteGatewayProfileAccess(ctx context.Context, username string, userID int64) (bool, error) {
-	query := `
-		select
-			1
-		from
-			"user" u
-	`
-	// global admin
-	var where = [][]string{
-		{"(u.email = $1 or u.id = $2)", "u.is_active = true", "u.is_admin = true"},
-	}
-
-	var ors []string
-	for _, ands := range where {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	query = "select count(*) from (" + query + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := ps.db.QueryRowContext(ctx, query, username, userID).Scan(&count); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-func (ps *PgStore) CheckReadListGatewayProfileAccess(ctx context.Context, username string, userID int64) (bool, error) {
-	query := `
-		select
-			1
-		from
-			"user" u
-	`
-	// any active user
-	var where = [][]string{
-		{"(u.email = $1 or u.id = $2)", "u.is_active = true"},
-	}
-
-	var ors []string
-	for _, ands := range where {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	query = "select count(*) from (" + query + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := ps.db.QueryRowContext(ctx, query, username, userID).Scan(&count); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
 // CreateGatewayProfile creates the given gateway-profile.
 // This will create the gateway-profile at the network-server side and will
 // create a local reference record.
@@ -94,8 +41,9 @@ func (ps *PgStore) CreateGatewayProfile(ctx context.Context, gp *gpapi.GatewayPr
 			created_at,
 			updated_at,
 			name,
-			stats_interval
-		) values ($1, $2, $3, $4, $5, $6)`,
+			stats_interval,
+			ping_interval
+		) values ($1, $2, $3, $4, $5, $6, $7)`,
 
 		gpID,
 		gp.NetworkServerID,
@@ -103,6 +51,7 @@ func (ps *PgStore) CreateGatewayProfile(ctx context.Context, gp *gpapi.GatewayPr
 		gp.UpdatedAt,
 		gp.Name,
 		statsInterval,
+		gp.PingInterval,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -124,7 +73,8 @@ func (ps *PgStore) GetGatewayProfile(ctx context.Context, id uuid.UUID) (gpapi.G
 			network_server_id,
 			name,
 			created_at,
-			updated_at
+			updated_at,
+			ping_interval
 		from gateway_profile
 		where
 			gateway_profile_id = $1`,
@@ -181,7 +131,8 @@ func (ps *PgStore) UpdateGatewayProfile(ctx context.Context, gp *gpapi.GatewayPr
 			updated_at = $2,
 			network_server_id = $3,
 			name = $4,
-			stats_interval = $5
+			stats_interval = $5,
+			ping_interval = $6
 		where
 			gateway_profile_id = $1`,
 		gpID,
@@ -189,6 +140,7 @@ func (ps *PgStore) UpdateGatewayProfile(ctx context.Context, gp *gpapi.GatewayPr
 		gp.NetworkServerID,
 		gp.Name,
 		statsInterval,
+		gp.PingInterval,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update gateway-profile error")
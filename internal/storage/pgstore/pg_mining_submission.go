@@ -0,0 +1,162 @@
+package pgstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	mining "github.com/mxc-foundation/lpwan-app-server/internal/modules/mining/data"
+)
+
+// miningSubmission is the db representation of the mining submission
+type miningSubmission struct {
+	mining.Submission
+	Gateways []byte `db:"gateways"`
+}
+
+func (ms miningSubmission) toSubmission() (mining.Submission, error) {
+	s := ms.Submission
+	if err := json.Unmarshal(ms.Gateways, &s.Gateways); err != nil {
+		return s, errors.Wrap(err, "unmarshal gateways error")
+	}
+	return s, nil
+}
+
+func toMiningSubmissions(rows []miningSubmission) ([]mining.Submission, error) {
+	res := make([]mining.Submission, 0, len(rows))
+	for _, row := range rows {
+		s, err := row.toSubmission()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+// CreateMiningSubmission stores the mining submission unless a submission
+// with the same idempotency key exists already. It returns false if the
+// submission exists.
+func (ps *PgStore) CreateMiningSubmission(ctx context.Context, s *mining.Submission) (bool, error) {
+	gateways, err := json.Marshal(s.Gateways)
+	if err != nil {
+		return false, errors.Wrap(err, "marshal gateways error")
+	}
+	now := time.Now()
+	err = sqlx.GetContext(ctx, ps.db, &s.ID, `
+		insert into mining_submission (
+			idempotency_key, period_start, period_seconds, gateways, status,
+			next_attempt_at, created_at, updated_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $7)
+		on conflict (idempotency_key) do nothing
+		returning id`,
+		s.IdempotencyKey,
+		s.PeriodStart,
+		s.PeriodSeconds,
+		gateways,
+		s.Status,
+		s.NextAttemptAt,
+		now,
+	)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, handlePSQLError(Insert, err, "insert error")
+	}
+	s.CreatedAt = now
+	s.UpdatedAt = now
+	return true, nil
+}
+
+// GetDueMiningSubmissions returns the pending mining submissions which are
+// due to be sent, the oldest first
+func (ps *PgStore) GetDueMiningSubmissions(ctx context.Context, now time.Time, limit int) ([]mining.Submission, error) {
+	var rows []miningSubmission
+	err := sqlx.SelectContext(ctx, ps.db, &rows, `
+		select * from mining_submission
+		where status = $1 and next_attempt_at <= $2
+		order by period_start
+		limit $3`,
+		mining.SubmissionPending,
+		now,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return toMiningSubmissions(rows)
+}
+
+// UpdateMiningSubmission updates the delivery state of the mining submission
+func (ps *PgStore) UpdateMiningSubmission(ctx context.Context, s *mining.Submission) error {
+	s.UpdatedAt = time.Now()
+	res, err := ps.db.ExecContext(ctx, `
+		update mining_submission
+		set
+			status = $2,
+			attempts = $3,
+			last_error = $4,
+			next_attempt_at = $5,
+			updated_at = $6,
+			sent_at = $7
+		where id = $1`,
+		s.ID,
+		s.Status,
+		s.Attempts,
+		s.LastError,
+		s.NextAttemptAt,
+		s.UpdatedAt,
+		s.SentAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return errHandler.ErrDoesNotExist
+	}
+	return nil
+}
+
+// GetMiningSubmissionCount returns the number of mining submissions with the
+// given status, or of all the submissions if status is empty
+func (ps *PgStore) GetMiningSubmissionCount(ctx context.Context, status string) (int64, error) {
+	var count int64
+	err := sqlx.GetContext(ctx, ps.db, &count, `
+		select count(*) from mining_submission
+		where $1 = '' or status = $1`,
+		status,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetMiningSubmissions returns the mining submissions with the given status,
+// or all the submissions if status is empty, the latest first
+func (ps *PgStore) GetMiningSubmissions(ctx context.Context, status string, limit, offset int64) ([]mining.Submission, error) {
+	var rows []miningSubmission
+	err := sqlx.SelectContext(ctx, ps.db, &rows, `
+		select * from mining_submission
+		where $1 = '' or status = $1
+		order by period_start desc
+		limit $2 offset $3`,
+		status,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return toMiningSubmissions(rows)
+}
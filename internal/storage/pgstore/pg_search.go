@@ -3,132 +3,371 @@ package pgstore
 import (
 	"context"
 	"database/sql"
-	"regexp"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq/hstore"
 
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/user"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
 )
 
-// GlobalSearch performs a search on organizations, applications, gateways
-// and devices.
-func (ps *PgStore) GlobalSearch(ctx context.Context, userID int64, globalAdmin bool, search string, limit, offset int) ([]user.SearchResult, error) {
-	var result []user.SearchResult
+// searchColumns are the columns of the search result besides kind and score
+// with their types. The kinds that don't set a column return typed null, so
+// that the union of the kinds can be resolved.
+var searchColumns = []struct {
+	name string
+	typ  string
+}{
+	{"organization_id", "bigint"},
+	{"organization_name", "text"},
+	{"application_id", "bigint"},
+	{"application_name", "text"},
+	{"device_dev_eui", "bytea"},
+	{"device_name", "text"},
+	{"gateway_mac", "bytea"},
+	{"gateway_name", "text"},
+	{"multicast_group_id", "text"},
+	{"multicast_group_name", "text"},
+	{"device_profile_id", "text"},
+	{"device_profile_name", "text"},
+	{"user_id", "bigint"},
+	{"username", "text"},
+}
+
+// searchSubquery selects the records of one kind matching the query
+type searchSubquery struct {
+	kind    string
+	score   string
+	columns map[string]string
+	from    string
+	where   []string
+}
+
+func (sq searchSubquery) selectResults() string {
+	cols := []string{fmt.Sprintf("'%s' as kind", sq.kind), sq.score + " as score"}
+	for _, c := range searchColumns {
+		if expr, ok := sq.columns[c.name]; ok {
+			cols = append(cols, expr+" as "+c.name)
+		} else {
+			cols = append(cols, "null::"+c.typ+" as "+c.name)
+		}
+	}
+	return "select " + strings.Join(cols, ", ") + sq.fromWhere()
+}
+
+func (sq searchSubquery) selectCount() string {
+	return fmt.Sprintf("select '%s' as kind, count(*) as count", sq.kind) + sq.fromWhere()
+}
+
+func (sq searchSubquery) fromWhere() string {
+	query := " from " + sq.from
+	if len(sq.where) != 0 {
+		query += " where " + strings.Join(sq.where, " and ")
+	}
+	return query
+}
+
+// searchBuilder builds the subqueries of the global search, it collects the
+// query arguments so that every argument is passed once and only the
+// arguments used by the subqueries are passed
+type searchBuilder struct {
+	query       user.SearchQuery
+	userID      int64
+	globalAdmin bool
+	now         time.Time
+
+	args   []interface{}
+	params map[string]string
+}
+
+func newSearchBuilder(userID int64, globalAdmin bool, query user.SearchQuery) *searchBuilder {
+	return &searchBuilder{
+		query:       query,
+		userID:      userID,
+		globalAdmin: globalAdmin,
+		now:         time.Now(),
+		params:      make(map[string]string),
+	}
+}
+
+// param returns the placeholder of the named argument
+func (b *searchBuilder) param(name string, value interface{}) string {
+	if p, ok := b.params[name]; ok {
+		return p
+	}
+	b.args = append(b.args, value)
+	p := fmt.Sprintf("$%d", len(b.args))
+	b.params[name] = p
+	return p
+}
+
+// textScore returns the score of the best matching of the fields
+func (b *searchBuilder) textScore(fields ...string) string {
+	if b.query.Text == "" {
+		return "0::real"
+	}
+	var scores []string
+	for _, f := range fields {
+		scores = append(scores, fmt.Sprintf("similarity(%s, %s)", f, b.param("text", b.query.Text)))
+	}
+	if len(scores) == 1 {
+		return scores[0]
+	}
+	return "greatest(" + strings.Join(scores, ", ") + ")"
+}
+
+// textMatch returns the condition matching the text against any of the
+// fields
+func (b *searchBuilder) textMatch(fields ...string) []string {
+	if b.query.Text == "" {
+		return nil
+	}
+	pattern := "%" + likeEscaper.Replace(b.query.Text) + "%"
+	var conds []string
+	for _, f := range fields {
+		conds = append(conds, fmt.Sprintf("%s ilike %s", f, b.param("pattern", pattern)))
+	}
+	return []string{"(" + strings.Join(conds, " or ") + ")"}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// orgFilter returns the conditions limiting the records to the organizations
+// visible to the user and to the organization given by the query
+func (b *searchBuilder) orgFilter(orgID string) []string {
+	var conds []string
+	if !b.globalAdmin {
+		conds = append(conds, fmt.Sprintf(`exists (
+			select 1 from organization_user ou
+			where ou.organization_id = %s and ou.user_id = %s)`, orgID, b.param("user_id", b.userID)))
+	}
+	if b.query.OrganizationID != 0 {
+		conds = append(conds, fmt.Sprintf("%s = %s", orgID, b.param("org_id", b.query.OrganizationID)))
+	}
+	if b.query.OrganizationName != "" {
+		conds = append(conds, fmt.Sprintf("%s in (select id from organization where lower(name) = lower(%s))",
+			orgID, b.param("org_name", b.query.OrganizationName)))
+	}
+	return conds
+}
+
+// tagsFilter returns the condition limiting the records to those having all
+// the tags given by the query
+func (b *searchBuilder) tagsFilter(tags string) []string {
+	if len(b.query.Tags) == 0 {
+		return nil
+	}
+	h := hstore.Hstore{Map: make(map[string]sql.NullString)}
+	for k, v := range b.query.Tags {
+		h.Map[k] = sql.NullString{String: v, Valid: true}
+	}
+	return []string{fmt.Sprintf("%s @> %s", tags, b.param("tags", h))}
+}
+
+// statusFilter returns the condition limiting the records to online or
+// offline devices or gateways
+func (b *searchBuilder) statusFilter(lastSeenAt, name string, offlineAfter time.Duration) []string {
+	if b.query.Status == "" {
+		return nil
+	}
+	since := b.param(name, b.now.Add(-offlineAfter))
+	if b.query.Status == user.SearchStatusOnline {
+		return []string{fmt.Sprintf("%s > %s", lastSeenAt, since)}
+	}
+	return []string{fmt.Sprintf("(%s is null or %s <= %s)", lastSeenAt, lastSeenAt, since)}
+}
+
+// profileFilter returns the condition limiting the records to the device
+// profile given by the query
+func (b *searchBuilder) profileFilter(name string) []string {
+	if b.query.Profile == "" {
+		return nil
+	}
+	return []string{fmt.Sprintf("lower(%s) = lower(%s)", name, b.param("profile", b.query.Profile))}
+}
+
+// searchConds concatenates the lists of the conditions
+func searchConds(parts ...[]string) []string {
+	var res []string
+	for _, p := range parts {
+		res = append(res, p...)
+	}
+	return res
+}
+
+// subqueries returns the subqueries of the kinds that may match the query
+func (b *searchBuilder) subqueries() []searchSubquery {
+	q := b.query
+	var res []searchSubquery
+	if q.HasKind(user.SearchKindOrganization) {
+		res = append(res, searchSubquery{
+			kind:  user.SearchKindOrganization,
+			score: b.textScore("o.name"),
+			columns: map[string]string{
+				"organization_id":   "o.id",
+				"organization_name": "o.name",
+			},
+			from:  "organization o",
+			where: searchConds(b.orgFilter("o.id"), b.textMatch("o.name")),
+		})
+	}
+	if q.HasKind(user.SearchKindApplication) {
+		res = append(res, searchSubquery{
+			kind:  user.SearchKindApplication,
+			score: b.textScore("a.name"),
+			columns: map[string]string{
+				"organization_id":   "o.id",
+				"organization_name": "o.name",
+				"application_id":    "a.id",
+				"application_name":  "a.name",
+			},
+			from:  "application a inner join organization o on o.id = a.organization_id",
+			where: searchConds(b.orgFilter("o.id"), b.textMatch("a.name")),
+		})
+	}
+	if q.HasKind(user.SearchKindDevice) {
+		from := `device d
+			inner join application a on a.id = d.application_id
+			inner join organization o on o.id = a.organization_id`
+		if q.Profile != "" {
+			from += " inner join device_profile dp on dp.device_profile_id = d.device_profile_id"
+		}
+		res = append(res, searchSubquery{
+			kind:  user.SearchKindDevice,
+			score: b.textScore("d.name", "encode(d.dev_eui, 'hex')"),
+			columns: map[string]string{
+				"organization_id":   "o.id",
+				"organization_name": "o.name",
+				"application_id":    "a.id",
+				"application_name":  "a.name",
+				"device_dev_eui":    "d.dev_eui",
+				"device_name":       "d.name",
+			},
+			from: from,
+			where: searchConds(
+				b.orgFilter("o.id"),
+				b.textMatch("d.name", "encode(d.dev_eui, 'hex')"),
+				b.tagsFilter("d.tags"),
+				b.statusFilter("d.last_seen_at", "device_since", user.DeviceOfflineAfter),
+				b.profileFilter("dp.name"),
+			),
+		})
+	}
+	if q.HasKind(user.SearchKindGateway) {
+		res = append(res, searchSubquery{
+			kind:  user.SearchKindGateway,
+			score: b.textScore("g.name", "encode(g.mac, 'hex')"),
+			columns: map[string]string{
+				"organization_id":   "o.id",
+				"organization_name": "o.name",
+				"gateway_mac":       "g.mac",
+				"gateway_name":      "g.name",
+			},
+			from: "gateway g inner join organization o on o.id = g.organization_id",
+			where: searchConds(
+				b.orgFilter("o.id"),
+				b.textMatch("g.name", "encode(g.mac, 'hex')"),
+				b.tagsFilter("g.tags"),
+				b.statusFilter("g.last_seen_at", "gateway_since", user.GatewayOfflineAfter),
+			),
+		})
+	}
+	if q.HasKind(user.SearchKindMulticastGroup) {
+		res = append(res, searchSubquery{
+			kind:  user.SearchKindMulticastGroup,
+			score: b.textScore("mg.name"),
+			columns: map[string]string{
+				"organization_id":      "o.id",
+				"organization_name":    "o.name",
+				"multicast_group_id":   "mg.id::text",
+				"multicast_group_name": "mg.name",
+			},
+			from: `multicast_group mg
+				inner join service_profile sp on sp.service_profile_id = mg.service_profile_id
+				inner join organization o on o.id = sp.organization_id`,
+			where: searchConds(b.orgFilter("o.id"), b.textMatch("mg.name")),
+		})
+	}
+	if q.HasKind(user.SearchKindDeviceProfile) {
+		res = append(res, searchSubquery{
+			kind:  user.SearchKindDeviceProfile,
+			score: b.textScore("dp.name"),
+			columns: map[string]string{
+				"organization_id":     "o.id",
+				"organization_name":   "o.name",
+				"device_profile_id":   "dp.device_profile_id::text",
+				"device_profile_name": "dp.name",
+			},
+			from:  "device_profile dp inner join organization o on o.id = dp.organization_id",
+			where: searchConds(b.orgFilter("o.id"), b.textMatch("dp.name"), b.profileFilter("dp.name")),
+		})
+	}
+	if q.HasKind(user.SearchKindUser) {
+		res = append(res, b.userSubquery())
+	}
+	return res
+}
+
+// userSubquery selects the users. The global admin finds all the users,
+// other users find the users of the organizations they administer.
+func (b *searchBuilder) userSubquery() searchSubquery {
+	var where []string
+	if !b.globalAdmin {
+		where = append(where, fmt.Sprintf(`exists (
+			select 1 from organization_user ou
+			inner join organization_user adm on adm.organization_id = ou.organization_id
+			where ou.user_id = u.id and adm.user_id = %s and %s)`, b.param("user_id", b.userID),
+			roleFlag("adm", "is_admin", auth.PermOrganizationAdmin)))
+	}
+	var orgConds []string
+	if b.query.OrganizationID != 0 {
+		orgConds = append(orgConds, "o.id = "+b.param("org_id", b.query.OrganizationID))
+	}
+	if b.query.OrganizationName != "" {
+		orgConds = append(orgConds, "lower(o.name) = lower("+b.param("org_name", b.query.OrganizationName)+")")
+	}
+	if len(orgConds) != 0 {
+		where = append(where, `exists (
+			select 1 from organization_user ou
+			inner join organization o on o.id = ou.organization_id
+			where ou.user_id = u.id and `+strings.Join(orgConds, " and ")+")")
+	}
+	return searchSubquery{
+		kind:  user.SearchKindUser,
+		score: b.textScore("u.email", "u.display_name"),
+		columns: map[string]string{
+			"user_id":  "u.id",
+			"username": "u.email",
+		},
+		from:  `"user" u`,
+		where: append(where, b.textMatch("u.email", "u.display_name")...),
+	}
+}
+
+// GlobalSearch performs a search on organizations, applications, devices,
+// gateways, multicast groups, device profiles and users visible to the user,
+// the best matches first.
+func (ps *PgStore) GlobalSearch(ctx context.Context, userID int64, globalAdmin bool, query user.SearchQuery, limit, offset int) ([]user.SearchResult, error) {
+	b := newSearchBuilder(userID, globalAdmin, query)
+	var parts []string
+	for _, sq := range b.subqueries() {
+		parts = append(parts, sq.selectResults())
+	}
+	if len(parts) == 0 {
+		return nil, nil
+	}
 
-	query, tags := parseSearchQuery(search)
-	query = "%" + search + "%"
-
-	tagsHstore := hstore.Hstore{
-		Map: make(map[string]sql.NullString),
-	}
-	for k, v := range tags {
-		tagsHstore.Map[k] = sql.NullString{String: v, Valid: true}
-	}
-
-	err := sqlx.SelectContext(ctx, ps.db, &result, `
-		select
-			'device' as kind,
-			greatest(similarity(d.name, $1), similarity(encode(d.dev_eui, 'hex'), $1)) as score,
-			o.id as organization_id,
-			o.name as organization_name,
-			a.id as application_id,
-			a.name as application_name,
-			d.dev_eui as device_dev_eui,
-			d.name as device_name,
-			null as gateway_mac,
-			null as gateway_name
-		from device d
-		inner join application a
-			on a.id = d.application_id
-		inner join organization o
-			on o.id = a.organization_id
-		left join organization_user ou
-			on ou.organization_id = o.id
-		left join "user" u
-			on u.id = ou.user_id
-		where
-			($3 = true or u.id = $4)
-			and (d.name ilike $2 or encode(d.dev_eui, 'hex') ilike $2 or ($7 != hstore('') and d.tags @> $7))
-		union
-		select
-			'gateway' as kind,
-			greatest(similarity(g.name, $1), similarity(encode(g.mac, 'hex'), $1)) as score,
-			o.id as organization_id,
-			o.name as organization_name,
-			null as application_id,
-			null as application_name,
-			null as device_dev_eui,
-			null as device_name,
-			g.mac as gateway_mac,
-			g.name as gateway_name
-		from
-			gateway g
-		inner join organization o
-			on o.id = g.organization_id
-		left join organization_user ou
-			on ou.organization_id = o.id
-		left join "user" u
-			on u.id = ou.user_id
-		where
-			($3 = true or u.id = $4)
-			and (g.name ilike $2 or encode(g.mac, 'hex') ilike $2 or ($7 != hstore('') and g.tags @> $7))
-		union
-		select
-			'organization' as kind,
-			similarity(o.name, $1) as score,
-			o.id as organization_id,
-			o.name as organization_name,
-			null as application_id,
-			null as application_name,
-			null as device_dev_eui,
-			null as device_name,
-			null as gateway_mac,
-			null as gateway_name
-		from
-			organization o
-		left join organization_user ou
-			on ou.organization_id = o.id
-		left join "user" u
-			on u.id = ou.user_id
-		where
-			($3 = true or u.id = $4)
-			and o.name ilike $2
-		union
-		select
-			'application' as kind,
-			similarity(a.name, $1) as score,
-			o.id as organization_id,
-			o.name as organization_name,
-			a.id as application_id,
-			a.name as application_name,
-			null as device_dev_eui,
-			null as device_name,
-			null as gateway_mac,
-			null as gateway_name
-		from
-			application a
-		inner join organization o
-			on o.id = a.organization_id
-		left join organization_user ou
-			on ou.organization_id = o.id
-		left join "user" u
-			on u.id = ou.user_id
-		where
-			($3 = true or u.id = $4)
-			and a.name ilike $2
+	var result []user.SearchResult
+	err := sqlx.SelectContext(ctx, ps.db, &result, strings.Join(parts, "\nunion all\n")+fmt.Sprintf(`
 		order by
-			score desc
-		limit $5
-		offset $6`,
-		search,
-		query,
-		globalAdmin,
-		userID,
-		limit,
-		offset,
-		tagsHstore,
+			score desc,
+			kind
+		limit %s
+		offset %s`, b.param("limit", limit), b.param("offset", offset)),
+		b.args...,
 	)
 	if err != nil {
 		return nil, handlePSQLError(Select, err, "select error")
@@ -137,26 +376,27 @@ func (ps *PgStore) GlobalSearch(ctx context.Context, userID int64, globalAdmin b
 	return result, nil
 }
 
-var searchTagRegexp = regexp.MustCompile(`([^ ]+):([^ ]+)`)
-
-// parseSearchQuery returns the query and tags.
-// Example: "foo bar:test" will return the query "foo" with tags {"bar": "test"}.
-func parseSearchQuery(query string) (string, map[string]string) {
-	matches := searchTagRegexp.FindAllStringSubmatch(query, -1)
-	if len(matches) == 0 {
-		return query, nil
+// GlobalSearchFacets returns the number of the records of each kind matching
+// the query. The kinds without any matching records are omitted.
+func (ps *PgStore) GlobalSearchFacets(ctx context.Context, userID int64, globalAdmin bool, query user.SearchQuery) ([]user.SearchFacet, error) {
+	b := newSearchBuilder(userID, globalAdmin, query)
+	var parts []string
+	for _, sq := range b.subqueries() {
+		parts = append(parts, sq.selectCount())
 	}
-
-	tags := make(map[string]string)
-	for _, t := range matches {
-		if len(t) != 3 {
-			continue
-		}
-
-		tags[t[1]] = t[2]
+	if len(parts) == 0 {
+		return nil, nil
 	}
 
-	query = strings.TrimSpace(searchTagRegexp.ReplaceAllString(query, ""))
+	var facets []user.SearchFacet
+	err := sqlx.SelectContext(ctx, ps.db, &facets, `
+		select kind, count from (`+strings.Join(parts, "\nunion all\n")+`) f
+		where count > 0`,
+		b.args...,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
 
-	return query, tags
+	return facets, nil
 }
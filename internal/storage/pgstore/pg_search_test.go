@@ -0,0 +1,276 @@
+package pgstore
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/user"
+)
+
+func TestGlobalSearch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	ctx := context.Background()
+
+	query, err := user.ParseSearchQuery("foo_1 kind:device status:offline org:3 room:12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// only the devices are searched, the arguments are passed once each
+	mock.ExpectQuery(`^select 'device' as kind, greatest\(similarity\(d\.name, \$1\).*`+
+		regexp.QuoteMeta(`ou.organization_id = o.id and ou.user_id = $2)`)+`.*`+
+		regexp.QuoteMeta(`o.id = $3 and (d.name ilike $4 or encode(d.dev_eui, 'hex') ilike $4) and d.tags @> $5 `+
+			`and (d.last_seen_at is null or d.last_seen_at <= $6)`)+`.*`+
+		regexp.QuoteMeta(`limit $7`)+`\s+`+regexp.QuoteMeta(`offset $8`)).
+		WithArgs("foo_1", 7, 3, `%foo\_1%`, sqlmock.AnyArg(), sqlmock.AnyArg(), 10, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"kind", "score", "device_name"}).
+			AddRow("device", 0.5, "foo_12"))
+	res, err := st.GlobalSearch(ctx, 7, false, query, 10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].Kind != user.SearchKindDevice || *res[0].DeviceName != "foo_12" {
+		t.Errorf("unexpected result: %+v", res)
+	}
+
+	// the global admin searches everything
+	mock.ExpectQuery(`^select kind, count from \(select 'organization' as kind, count\(\*\) as count from organization o\s+` +
+		`union all.*'user' as kind.*\) f`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"kind", "count"}).
+			AddRow("organization", 4).AddRow("device", 100000))
+	facets, err := st.GlobalSearchFacets(ctx, 1, true, user.SearchQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(facets) != 2 || facets[1].Kind != user.SearchKindDevice || facets[1].Count != 100000 {
+		t.Errorf("unexpected facets: %+v", facets)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestGlobalSearchOnDatabase checks the global search queries on the real
+// database
+func TestGlobalSearchOnDatabase(t *testing.T) {
+	db := testDB(t)
+	st := &PgStore{db: db}
+	ctx := context.Background()
+
+	var orgIDs []int64
+	for _, name := range []string{"search-org-1", "search-org-2"} {
+		org := organization.Organization{Name: name, DisplayName: name, CanHaveGateways: true}
+		if err := st.CreateOrganization(ctx, &org); err != nil {
+			t.Fatal(err)
+		}
+		orgIDs = append(orgIDs, org.ID)
+	}
+	alice, err := st.CreateUser(ctx, user.User{Email: "alice@example.com", IsActive: true},
+		[]user.OrganizationUser{{OrganizationID: orgIDs[0]}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := st.CreateUser(ctx, user.User{Email: "bob@example.com", IsActive: true},
+		[]user.OrganizationUser{{OrganizationID: orgIDs[1], IsOrgAdmin: true}}); err != nil {
+		t.Fatal(err)
+	}
+	// the device of the first organization was never seen, the one of the
+	// second organization is online
+	for _, query := range []string{`
+		insert into network_server (created_at, updated_at, name, server)
+		values (now(), now(), 'search-ns', 'localhost:8000')`, `
+		insert into service_profile (service_profile_id, organization_id, network_server_id, created_at, updated_at, name)
+		select md5(o.name)::uuid, o.id, ns.id, now(), now(), o.name
+		from organization o, network_server ns
+		where o.name like 'search-org-%'`, `
+		insert into application (name, description, organization_id, service_profile_id)
+		select 'app-' || o.name, '', o.id, sp.service_profile_id
+		from organization o
+		inner join service_profile sp on sp.organization_id = o.id
+		where o.name like 'search-org-%'`, `
+		insert into device_profile (device_profile_id, network_server_id, organization_id, created_at, updated_at, name)
+		select md5('dp' || o.name)::uuid, ns.id, o.id, now(), now(), 'sensor-v1'
+		from organization o, network_server ns
+		where o.name like 'search-org-%'`, `
+		insert into device (
+			dev_eui, created_at, updated_at, application_id, device_profile_id,
+			name, description, tags, last_seen_at
+		)
+		select
+			decode(lpad(to_hex(o.id), 16, '0'), 'hex'), now(), now(), a.id, dp.device_profile_id,
+			'thermo_' || right(o.name, 1), '', hstore('room', '12'),
+			case when o.name = 'search-org-1' then null else now() end
+		from organization o
+		inner join application a on a.organization_id = o.id
+		inner join device_profile dp on dp.organization_id = o.id
+		where o.name like 'search-org-%'`,
+	} {
+		if _, err := db.ExecContext(ctx, query); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	search := func(userID int64, globalAdmin bool, text string) []user.SearchResult {
+		t.Helper()
+		query, err := user.ParseSearchQuery(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := st.GlobalSearch(ctx, userID, globalAdmin, query, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	// the user sees only the devices of own organizations
+	res := search(alice.ID, false, "thermo kind:device")
+	if len(res) != 1 || *res[0].DeviceName != "thermo_1" || *res[0].OrganizationID != orgIDs[0] {
+		t.Errorf("unexpected result: %+v", res)
+	}
+	res = search(0, true, "thermo kind:device")
+	if len(res) != 2 {
+		t.Errorf("expected the devices of both organizations, got %+v", res)
+	}
+	// the underscore is matched literally and the filters are applied
+	if res = search(0, true, "mo_ status:offline room:12 profile:sensor-v1"); len(res) != 1 ||
+		res[0].Kind != user.SearchKindDevice || *res[0].DeviceName != "thermo_1" {
+		t.Errorf("unexpected result: %+v", res)
+	}
+	if res = search(0, true, "thermo org:search-org-2 status:offline"); len(res) != 0 {
+		t.Errorf("expected no offline devices in the second organization, got %+v", res)
+	}
+	// the users are found by the email, the other members of the
+	// organization are not visible to the user who is not the admin
+	if res = search(0, true, "bob@ kind:user"); len(res) != 1 || *res[0].Username != "bob@example.com" {
+		t.Errorf("unexpected result: %+v", res)
+	}
+	if res = search(alice.ID, false, "example.com kind:user"); len(res) != 0 {
+		t.Errorf("expected no users, got %+v", res)
+	}
+
+	query, err := user.ParseSearchQuery("thermo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	facets, err := st.GlobalSearchFacets(ctx, alice.ID, false, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range facets {
+		if f.Kind == user.SearchKindDevice && f.Count != 1 {
+			t.Errorf("expected 1 device, got %d", f.Count)
+		}
+	}
+}
+
+// BenchmarkGlobalSearch runs the global search on the database seeded with
+// 100k devices. The database given by TEST_POSTGRES_DSN is reset, so it must
+// be a scratch database.
+func BenchmarkGlobalSearch(b *testing.B) {
+	db := testDB(b)
+	ctx := context.Background()
+	if err := seedSearchBenchmark(ctx, db); err != nil {
+		b.Fatal(err)
+	}
+	st := &PgStore{db: db}
+
+	for _, bc := range []struct {
+		name   string
+		search string
+	}{
+		{name: "name", search: "device-4242"},
+		{name: "eui", search: "000000000001a2"},
+		{name: "filters", search: "status:offline org:bench-org-3 room:42"},
+		{name: "profile", search: "profile:sensor-v2 device-77"},
+		{name: "kind", search: "kind:gateway gateway-12"},
+	} {
+		query, err := user.ParseSearchQuery(bc.search)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(bc.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := st.GlobalSearch(ctx, 0, true, query, 20, 0); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(bc.name+"-facets", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := st.GlobalSearchFacets(ctx, 0, true, query); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// seedSearchBenchmark creates 10 organizations with 10000 devices and 100
+// gateways each
+func seedSearchBenchmark(ctx context.Context, db *sqlx.DB) error {
+	for _, query := range []string{`
+		insert into network_server (created_at, updated_at, name, server)
+		values (now(), now(), 'bench', 'localhost:8000')`, `
+		insert into organization (created_at, updated_at, name, display_name, can_have_gateways)
+		select now(), now(), 'bench-org-' || i, 'Bench org ' || i, true
+		from generate_series(1, 10) i`, `
+		insert into service_profile (service_profile_id, organization_id, network_server_id, created_at, updated_at, name)
+		select md5(random()::text)::uuid, o.id, ns.id, now(), now(), o.name
+		from organization o, network_server ns
+		where o.name like 'bench-org-%' and ns.name = 'bench'`, `
+		insert into application (name, description, organization_id, service_profile_id)
+		select 'app-' || o.name, '', o.id, sp.service_profile_id
+		from organization o
+		inner join service_profile sp on sp.organization_id = o.id
+		where o.name like 'bench-org-%'`, `
+		insert into device_profile (device_profile_id, network_server_id, organization_id, created_at, updated_at, name)
+		select md5(random()::text)::uuid, ns.id, o.id, now(), now(), p
+		from organization o, network_server ns, unnest(array['sensor-v1', 'sensor-v2']) p
+		where o.name like 'bench-org-%' and ns.name = 'bench'`, `
+		with apps as (
+			select a.id, a.organization_id, row_number() over (order by a.id) - 1 as n
+			from application a
+			where a.name like 'app-bench-org-%'
+		)
+		insert into device (
+			dev_eui, created_at, updated_at, application_id, device_profile_id,
+			name, description, tags, last_seen_at
+		)
+		select
+			decode(lpad(to_hex(i), 16, '0'), 'hex'), now(), now(), apps.id,
+			(select dp.device_profile_id from device_profile dp
+				where dp.organization_id = apps.organization_id
+					and dp.name = 'sensor-v' || (i % 2 + 1)),
+			'device-' || i, '', hstore('room', (i % 100)::text),
+			case when i % 3 = 0 then null else now() - (i % 48) * interval '1 hour' end
+		from generate_series(1, 100000) i
+		inner join apps on apps.n = i % 10`, `
+		insert into gateway (
+			mac, created_at, updated_at, name, description, organization_id,
+			network_server_id, tags, last_seen_at
+		)
+		select
+			decode(lpad(to_hex(i), 16, 'f'), 'hex'), now(), now(), 'gateway-' || i, '',
+			o.id, ns.id, hstore('room', (i % 100)::text), now() - (i % 20) * interval '1 minute'
+		from generate_series(1, 1000) i
+		inner join organization o on o.name = 'bench-org-' || (i % 10 + 1)
+		inner join network_server ns on ns.name = 'bench'`,
+		`analyze`,
+	} {
+		if _, err := db.ExecContext(ctx, query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,7 +2,6 @@ package pgstore
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -15,135 +14,6 @@ import (
 	. "github.com/mxc-foundation/lpwan-app-server/internal/modules/service-profile/data"
 )
 
-func (ps *PgStore) CheckReadServiceProfileAccess(ctx context.Context, username string, id uuid.UUID, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join service_profile sp
-			on sp.organization_id = ou.organization_id
-	`
-	// global admin
-	// organization users to which the service-profile is linked
-	userWhere := [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "sp.service_profile_id = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, id, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-
-}
-
-func (ps *PgStore) CheckUpdateDeleteServiceProfileAccess(ctx context.Context, username string, id uuid.UUID, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join service_profile sp
-			on sp.organization_id = ou.organization_id
-	`
-	// global admin
-	userWhere := [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true", "$2 = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, id, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-
-}
-
-func (ps *PgStore) CheckCreateServiceProfilesAccess(ctx context.Context, username string, organizationID, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-	`
-
-	// global admin
-	userWhere := [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true", "$2 = $2"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, organizationID, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
-func (ps *PgStore) CheckListServiceProfilesAccess(ctx context.Context, username string, organizationID, userID int64) (bool, error) {
-	userQuery := `
-		select
-			1
-		from
-			"user" u
-		left join organization_user ou
-			on u.id = ou.user_id
-		left join organization o
-			on o.id = ou.organization_id
-	`
-	// global admin
-	// organization user (when organization id is given)
-	// any active user (filtered by user)
-	userWhere := [][]string{
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "u.is_admin = true"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "$2 > 0", "o.id = $2"},
-		{"(u.email = $1 or u.id = $3)", "u.is_active = true", "$2 = 0"},
-	}
-
-	var ors []string
-	for _, ands := range userWhere {
-		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
-	}
-	whereStr := strings.Join(ors, " or ")
-	userQuery = "select count(*) from (" + userQuery + " where " + whereStr + " limit 1) count_only"
-
-	var count int64
-	if err := sqlx.GetContext(ctx, ps.db, &count, userQuery, username, organizationID, userID); err != nil {
-		return false, errors.Wrap(err, "select error")
-	}
-	return count > 0, nil
-}
-
 // CreateServiceProfile creates the given service-profile.
 func (ps *PgStore) CreateServiceProfile(ctx context.Context, sp *ServiceProfile) (*uuid.UUID, error) {
 	if err := sp.Validate(); err != nil {
@@ -208,6 +78,26 @@ func (ps *PgStore) GetServiceProfile(ctx context.Context, id uuid.UUID) (Service
 	return sp, nil
 }
 
+// GetServiceProfileIDByName returns the id of the service-profile of the
+// organization with the given name. If there are several, the oldest one is
+// returned.
+func (ps *PgStore) GetServiceProfileIDByName(ctx context.Context, orgID int64, name string) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := sqlx.GetContext(ctx, ps.db, &id, `
+		select service_profile_id
+		from service_profile
+		where organization_id = $1 and name = $2
+		order by created_at
+		limit 1`,
+		orgID,
+		name,
+	)
+	if err != nil {
+		return id, handlePSQLError(Select, err, "select error")
+	}
+	return id, nil
+}
+
 // UpdateServiceProfile updates the given service-profile.
 func (ps *PgStore) UpdateServiceProfile(ctx context.Context, sp *ServiceProfile) error {
 	if err := sp.Validate(); err != nil {
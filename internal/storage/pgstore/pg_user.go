@@ -10,6 +10,7 @@ import (
 	"github.com/jmoiron/sqlx"
 
 	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/user"
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
 	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
 )
 
@@ -312,7 +313,10 @@ func (ps *PgStore) GetUserByToken(ctx context.Context, token string) (user.User,
 
 func (ps *PgStore) GetUserOrganizations(ctx context.Context, userID int64) ([]user.OrganizationUser, error) {
 	query := `SELECT ou.user_id, ou.organization_id, ou.created_at, ou.updated_at,
-				ou.is_admin, ou.is_device_admin, ou.is_gateway_admin, o.name, o.display_name
+				` + roleFlag("ou", "is_admin", auth.PermOrganizationAdmin) + `,
+				` + roleFlag("ou", "is_device_admin", auth.PermDeviceWrite) + `,
+				` + roleFlag("ou", "is_gateway_admin", auth.PermGatewayWrite) + `,
+				o.name, o.display_name
 			  FROM organization_user ou
 			    JOIN organization o ON (o.id = ou.organization_id)
 			  WHERE ou.user_id = $1`
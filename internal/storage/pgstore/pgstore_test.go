@@ -0,0 +1,37 @@
+package pgstore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	migrate "github.com/rubenv/sql-migrate"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/migrations"
+)
+
+// testDB connects to the database given by TEST_POSTGRES_DSN and resets it
+// to the latest schema, so it must be a scratch database. The test is
+// skipped if TEST_POSTGRES_DSN is not set.
+func testDB(tb testing.TB) *sqlx.DB {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		tb.Skip("TEST_POSTGRES_DSN is not set")
+	}
+	db, err := sqlx.Open("postgres", dsn)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { db.Close() })
+	m := &migrate.AssetMigrationSource{
+		Asset:    migrations.Asset,
+		AssetDir: migrations.AssetDir,
+	}
+	if _, err := migrate.Exec(db.DB, "postgres", m, migrate.Down); err != nil {
+		tb.Fatal(err)
+	}
+	if _, err := migrate.Exec(db.DB, "postgres", m, migrate.Up); err != nil {
+		tb.Fatal(err)
+	}
+	return db
+}
@@ -0,0 +1,63 @@
+package pgstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/price"
+)
+
+// GetClosePrice returns the recorded close price of the crypto currency on
+// the day
+func (ps *PgStore) GetClosePrice(ctx context.Context, crypto, fiat string, day time.Time) (price.ClosePrice, error) {
+	var cp price.ClosePrice
+	err := sqlx.GetContext(ctx, ps.db, &cp, `
+		select * from price_history where crypto = $1 and fiat = $2 and day = $3`,
+		crypto,
+		fiat,
+		day,
+	)
+	if err != nil {
+		return cp, handlePSQLError(Select, err, "select error")
+	}
+	return cp, nil
+}
+
+// GetLatestClosePrice returns the latest recorded close price of the crypto
+// currency
+func (ps *PgStore) GetLatestClosePrice(ctx context.Context, crypto, fiat string) (price.ClosePrice, error) {
+	var cp price.ClosePrice
+	err := sqlx.GetContext(ctx, ps.db, &cp, `
+		select * from price_history where crypto = $1 and fiat = $2
+		order by day desc
+		limit 1`,
+		crypto,
+		fiat,
+	)
+	if err != nil {
+		return cp, handlePSQLError(Select, err, "select error")
+	}
+	return cp, nil
+}
+
+// SetClosePrice records the close price of the crypto currency on the day,
+// if the price has already been recorded it is not modified
+func (ps *PgStore) SetClosePrice(ctx context.Context, cp price.ClosePrice) error {
+	_, err := ps.db.ExecContext(ctx, `
+		insert into price_history (crypto, fiat, day, price, source, created_at)
+		values ($1, $2, $3, $4, $5, $6)
+		on conflict (crypto, fiat, day) do nothing`,
+		cp.Crypto,
+		cp.Fiat,
+		cp.Day,
+		cp.Price,
+		cp.Source,
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package pgstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/auth"
+	errHandler "github.com/mxc-foundation/lpwan-app-server/internal/errors"
+	"github.com/mxc-foundation/lpwan-app-server/internal/statement"
+)
+
+// statementSubscriptionSelect selects the subscriptions of the users who are
+// still active and admins of the organization, the subscriptions of removed
+// or demoted users are kept but ignored
+var statementSubscriptionSelect = `
+	select s.*, u.email, o.display_name as organization_name
+	from statement_subscription s
+		join "user" u on u.id = s.user_id
+		join organization o on o.id = s.organization_id
+		left join organization_user ou on ou.organization_id = s.organization_id and ou.user_id = s.user_id
+	where u.is_active = true and (u.is_admin = true or ` + roleFlag("ou", "is_admin", auth.PermOrganizationAdmin) + `)`
+
+// UpsertStatementSubscription creates the subscription or updates the
+// frequency and language of the existing one
+func (ps *PgStore) UpsertStatementSubscription(ctx context.Context, s *statement.Subscription) error {
+	now := time.Now()
+	var id int64
+	err := sqlx.GetContext(ctx, ps.db, &id, `
+		insert into statement_subscription (
+			organization_id, user_id, frequency, language, last_period_end, created_at, updated_at
+		) values ($1, $2, $3, $4, $5, $6, $6)
+		on conflict (organization_id, user_id) do update set
+			frequency = excluded.frequency,
+			language = excluded.language,
+			last_period_end = case
+				when statement_subscription.frequency = excluded.frequency
+				then statement_subscription.last_period_end
+				else excluded.last_period_end end,
+			updated_at = excluded.updated_at
+		returning id`,
+		s.OrganizationID,
+		s.UserID,
+		s.Frequency,
+		s.Language,
+		s.LastPeriodEnd,
+		now,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	err = sqlx.GetContext(ctx, ps.db, s, statementSubscriptionSelect+` and s.id = $1`, id)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+	return nil
+}
+
+// DeleteStatementSubscription deletes the subscription of the user to the
+// statements of the organization
+func (ps *PgStore) DeleteStatementSubscription(ctx context.Context, organizationID, userID int64) error {
+	res, err := ps.db.ExecContext(ctx, `
+		delete from statement_subscription where organization_id = $1 and user_id = $2`,
+		organizationID,
+		userID,
+	)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return handlePSQLError(Delete, err, "get rows affected error")
+	}
+	if ra == 0 {
+		return errHandler.ErrDoesNotExist
+	}
+	return nil
+}
+
+// GetStatementSubscriptions returns the subscriptions to the statements of
+// the organization
+func (ps *PgStore) GetStatementSubscriptions(ctx context.Context, organizationID int64) ([]statement.Subscription, error) {
+	var subs []statement.Subscription
+	err := sqlx.SelectContext(ctx, ps.db, &subs, statementSubscriptionSelect+`
+		and s.organization_id = $1
+		order by s.created_at`,
+		organizationID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return subs, nil
+}
+
+// GetDueStatementSubscriptions returns the subscriptions of the organization
+// admins with the given frequency for which the statement for the period
+// ending at periodEnd hasn't been sent yet
+func (ps *PgStore) GetDueStatementSubscriptions(ctx context.Context, frequency string,
+	periodEnd time.Time) ([]statement.Subscription, error) {
+	var subs []statement.Subscription
+	err := sqlx.SelectContext(ctx, ps.db, &subs, statementSubscriptionSelect+`
+		and s.frequency = $1 and s.last_period_end < $2
+		order by s.id`,
+		frequency,
+		periodEnd,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return subs, nil
+}
+
+// SetStatementSubscriptionPeriodEnd updates the end of the last period for
+// which the statement has been sent
+func (ps *PgStore) SetStatementSubscriptionPeriodEnd(ctx context.Context, id int64, periodEnd time.Time) error {
+	_, err := ps.db.ExecContext(ctx, `
+		update statement_subscription set last_period_end = $2, updated_at = $3 where id = $1`,
+		id,
+		periodEnd,
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	return nil
+}
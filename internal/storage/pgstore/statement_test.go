@@ -0,0 +1,90 @@
+package pgstore
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/organization"
+	"github.com/mxc-foundation/lpwan-app-server/internal/api/external/user"
+	"github.com/mxc-foundation/lpwan-app-server/internal/statement"
+)
+
+func TestGetDueStatementSubscriptions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := toStore(db)
+	periodEnd := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	// only the subscriptions of the active admins are returned, the admin
+	// permission of the custom role takes precedence over the built-in flag
+	mock.ExpectQuery(regexp.QuoteMeta(`left join organization_user ou on ou.organization_id = s.organization_id and ou.user_id = s.user_id`)+
+		`\s+`+regexp.QuoteMeta(`where u.is_active = true and (u.is_admin = true or coalesce((select 'organization:admin' = any(r.permissions) from organization_role r where r.id = ou.role_id), ou.is_admin))`)+
+		`\s+`+regexp.QuoteMeta(`and s.frequency = $1 and s.last_period_end < $2`)).
+		WithArgs(statement.FrequencyMonthly, periodEnd).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "organization_id", "user_id", "email"}).
+			AddRow(1, 2, 3, "admin@example.com"))
+	subs, err := st.GetDueStatementSubscriptions(context.Background(), statement.FrequencyMonthly, periodEnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 1 || subs[0].Email != "admin@example.com" {
+		t.Errorf("unexpected subscriptions: %+v", subs)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestDueStatementSubscriptionsOfAdmins checks on the real database that the
+// users who were removed from the organization or lost the admin role don't
+// receive the statements anymore
+func TestDueStatementSubscriptionsOfAdmins(t *testing.T) {
+	st := &PgStore{db: testDB(t)}
+	ctx := context.Background()
+
+	org := organization.Organization{Name: "statement-org", DisplayName: "Statement org"}
+	if err := st.CreateOrganization(ctx, &org); err != nil {
+		t.Fatal(err)
+	}
+	users := make(map[string]int64)
+	for _, email := range []string{"admin@example.com", "demoted@example.com", "removed@example.com"} {
+		u, err := st.CreateUser(ctx, user.User{Email: email, IsActive: true}, []user.OrganizationUser{
+			{OrganizationID: org.ID, IsOrgAdmin: true},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		users[email] = u.ID
+		if err := st.UpsertStatementSubscription(ctx, &statement.Subscription{
+			OrganizationID: org.ID,
+			UserID:         u.ID,
+			Frequency:      statement.FrequencyMonthly,
+			Language:       "en",
+			LastPeriodEnd:  time.Now().Add(-60 * 24 * time.Hour),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := st.UpdateOrganizationUser(ctx, org.ID, users["demoted@example.com"], false, true, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.DeleteOrganizationUser(ctx, org.ID, users["removed@example.com"]); err != nil {
+		t.Fatal(err)
+	}
+
+	subs, err := st.GetDueStatementSubscriptions(ctx, statement.FrequencyMonthly, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 1 || subs[0].Email != "admin@example.com" || subs[0].OrganizationName != "Statement org" {
+		t.Fatalf("expected only the subscription of the admin, got %+v", subs)
+	}
+}
@@ -0,0 +1,34 @@
+-- +migrate Up
+create table gateway_firmware_rollout
+(
+    id                     bigserial primary key,
+    model                  varchar(32)              not null references gateway_firmware on delete cascade,
+    resource_link          varchar(256)             not null,
+    md5_hash               bytea                    not null,
+    previous_resource_link varchar(256)             not null default '',
+    previous_md5_hash      bytea                    not null default E'\\000',
+    percentage             integer                  not null,
+    max_silent_percentage  integer                  not null,
+    silent_timeout         bigint                   not null,
+    status                 varchar(16)              not null,
+    created_at             timestamp with time zone not null,
+    updated_at             timestamp with time zone not null
+);
+
+create index idx_gateway_firmware_rollout_model_status on gateway_firmware_rollout (model, status);
+
+create table gateway_firmware_rollout_gateway
+(
+    rollout_id   bigint                   not null references gateway_firmware_rollout on delete cascade,
+    gateway_mac  bytea                    not null references gateway on delete cascade,
+    canary       boolean                  not null default false,
+    status       varchar(16)              not null,
+    offered_at   timestamp with time zone,
+    confirmed_at timestamp with time zone,
+    primary key (rollout_id, gateway_mac)
+);
+
+-- +migrate Down
+drop table gateway_firmware_rollout_gateway;
+drop index idx_gateway_firmware_rollout_model_status;
+drop table gateway_firmware_rollout;
@@ -0,0 +1,21 @@
+-- +migrate Up
+create table gateway_config_version
+(
+    gateway_mac     bytea                    not null references gateway on delete cascade,
+    version         integer                  not null,
+    config          text                     not null,
+    config_hash     bytea                    not null,
+    author          varchar(100)             not null,
+    reason          text                     not null default '',
+    created_at      timestamp with time zone not null,
+    acknowledged_at timestamp with time zone,
+    primary key (gateway_mac, version)
+);
+
+insert into gateway_config_version (gateway_mac, version, config, config_hash, author, reason, created_at)
+select mac, 1, config, decode(md5(config), 'hex'), '', 'initial version', now()
+from gateway
+where config != '';
+
+-- +migrate Down
+drop table gateway_config_version;
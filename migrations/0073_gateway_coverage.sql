@@ -0,0 +1,15 @@
+-- +migrate Up
+create table gateway_coverage
+(
+    gateway_mac   bytea                    not null references gateway on delete cascade,
+    geohash       varchar(12)              not null,
+    best_rssi     integer                  not null,
+    best_snr      double precision         not null,
+    uplink_count  bigint                   not null,
+    first_seen_at timestamp with time zone not null,
+    last_seen_at  timestamp with time zone not null,
+    primary key (gateway_mac, geohash)
+);
+
+-- +migrate Down
+drop table gateway_coverage;
@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table gateway_profile
+    add column ping_interval bigint not null default 0;
+
+create index idx_gateway_ping_gateway_mac_created_at on gateway_ping(gateway_mac, created_at);
+
+-- +migrate Down
+drop index idx_gateway_ping_gateway_mac_created_at;
+
+alter table gateway_profile
+    drop column ping_interval;
@@ -0,0 +1,22 @@
+-- +migrate Up
+create table mining_submission
+(
+    id              bigserial primary key,
+    idempotency_key varchar(100)             not null unique,
+    period_start    timestamp with time zone not null,
+    period_seconds  bigint                   not null,
+    gateways        jsonb                    not null,
+    status          varchar(16)              not null,
+    attempts        integer                  not null default 0,
+    last_error      text                     not null default '',
+    next_attempt_at timestamp with time zone not null,
+    created_at      timestamp with time zone not null,
+    updated_at      timestamp with time zone not null,
+    sent_at         timestamp with time zone
+);
+
+create index idx_mining_submission_status_next_attempt_at on mining_submission (status, next_attempt_at);
+
+-- +migrate Down
+drop index idx_mining_submission_status_next_attempt_at;
+drop table mining_submission;
@@ -0,0 +1,23 @@
+-- +migrate Up
+create table airdrop
+(
+    airdrop_id      bigint primary key,
+    email           varchar(255)             not null,
+    token           varchar(16)              not null,
+    amount_usd      double precision         not null,
+    purpose         text                     not null,
+    organization_id bigint                   not null default 0,
+    currency        varchar(16)              not null default '',
+    status          varchar(16)              not null,
+    error           text                     not null default '',
+    attempts        integer                  not null default 0,
+    created_at      timestamp with time zone not null,
+    updated_at      timestamp with time zone not null,
+    paid_at         timestamp with time zone
+);
+
+create index idx_airdrop_status on airdrop (status);
+
+-- +migrate Down
+drop index idx_airdrop_status;
+drop table airdrop;
@@ -0,0 +1,20 @@
+-- +migrate Up
+create table statement_subscription
+(
+    id              bigserial primary key,
+    organization_id bigint                   not null references organization on delete cascade,
+    user_id         bigint                   not null references "user" on delete cascade,
+    frequency       varchar(16)              not null,
+    language        varchar(8)               not null,
+    last_period_end timestamp with time zone not null,
+    created_at      timestamp with time zone not null,
+    updated_at      timestamp with time zone not null
+);
+
+create unique index idx_statement_subscription_organization_user on statement_subscription (organization_id, user_id);
+create index idx_statement_subscription_frequency_last_period_end on statement_subscription (frequency, last_period_end);
+
+-- +migrate Down
+drop index idx_statement_subscription_frequency_last_period_end;
+drop index idx_statement_subscription_organization_user;
+drop table statement_subscription;
@@ -0,0 +1,14 @@
+-- +migrate Up
+create table price_history
+(
+    crypto     varchar(16)              not null,
+    fiat       varchar(16)              not null,
+    day        date                     not null,
+    price      double precision         not null,
+    source     varchar(32)              not null,
+    created_at timestamp with time zone not null,
+    primary key (crypto, fiat, day)
+);
+
+-- +migrate Down
+drop table price_history;
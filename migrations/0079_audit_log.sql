@@ -0,0 +1,38 @@
+-- +migrate Up
+create table audit_log
+(
+    id              bigserial primary key,
+    created_at      timestamp with time zone not null,
+    user_id         bigint                   not null,
+    username        varchar(100)             not null,
+    organization_id bigint                   not null,
+    action          varchar(200)             not null,
+    target          varchar(200)             not null,
+    before          jsonb,
+    after           jsonb,
+    source_ip       varchar(64)              not null
+);
+
+create index idx_audit_log_created_at on audit_log (created_at);
+create index idx_audit_log_organization_id_created_at on audit_log (organization_id, created_at);
+create index idx_audit_log_user_id_created_at on audit_log (user_id, created_at);
+
+-- +migrate StatementBegin
+create function audit_log_append_only() returns trigger as
+$$
+begin
+    raise exception 'audit_log is append-only';
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+create trigger audit_log_append_only
+    before update or delete or truncate
+    on audit_log
+    for each statement
+execute procedure audit_log_append_only();
+
+-- +migrate Down
+drop trigger audit_log_append_only on audit_log;
+drop function audit_log_append_only();
+drop table audit_log;
@@ -0,0 +1,24 @@
+-- +migrate Up
+create table organization_invitation
+(
+    id               bigserial primary key,
+    organization_id  bigint                   not null references organization on delete cascade,
+    email            varchar(255)             not null,
+    is_admin         boolean                  not null,
+    is_device_admin  boolean                  not null,
+    is_gateway_admin boolean                  not null,
+    language         varchar(8)               not null,
+    created_by       bigint                   not null,
+    created_at       timestamp with time zone not null,
+    expires_at       timestamp with time zone not null,
+    accepted_at      timestamp with time zone,
+    revoked_at       timestamp with time zone
+);
+
+create index idx_organization_invitation_organization_id on organization_invitation (organization_id);
+create index idx_organization_invitation_email on organization_invitation (email);
+
+-- +migrate Down
+drop index idx_organization_invitation_email;
+drop index idx_organization_invitation_organization_id;
+drop table organization_invitation;
@@ -0,0 +1,24 @@
+-- +migrate Up
+create table email_outbox
+(
+    id              bigserial primary key,
+    recipient       varchar(255)             not null,
+    kind            varchar(64)              not null,
+    message         bytea                    not null,
+    status          varchar(16)              not null,
+    attempts        integer                  not null default 0,
+    last_error      text                     not null default '',
+    created_at      timestamp with time zone not null,
+    next_attempt_at timestamp with time zone not null,
+    sent_at         timestamp with time zone
+);
+
+create index idx_email_outbox_next_attempt_at on email_outbox (next_attempt_at) where status = 'pending';
+create index idx_email_outbox_recipient_sent_at on email_outbox (recipient, sent_at);
+create index idx_email_outbox_status_created_at on email_outbox (status, created_at);
+
+-- +migrate Down
+drop index idx_email_outbox_status_created_at;
+drop index idx_email_outbox_recipient_sent_at;
+drop index idx_email_outbox_next_attempt_at;
+drop table email_outbox;
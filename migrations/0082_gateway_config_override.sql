@@ -0,0 +1,10 @@
+-- +migrate Up
+create table gateway_config_override
+(
+    gateway_mac bytea                    primary key references gateway on delete cascade,
+    overrides   jsonb                    not null,
+    updated_at  timestamp with time zone not null
+);
+
+-- +migrate Down
+drop table gateway_config_override;
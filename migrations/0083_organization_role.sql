@@ -0,0 +1,22 @@
+-- +migrate Up
+create table organization_role
+(
+    id              bigserial primary key,
+    organization_id bigint                   not null references organization on delete cascade,
+    name            varchar(100)             not null,
+    description     text                     not null,
+    permissions     text[]                   not null,
+    created_at      timestamp with time zone not null,
+    updated_at      timestamp with time zone not null,
+    unique (organization_id, name)
+);
+
+alter table organization_user
+    add column role_id bigint references organization_role on delete restrict;
+
+create index idx_organization_user_role_id on organization_user (role_id);
+
+-- +migrate Down
+drop index idx_organization_user_role_id;
+alter table organization_user drop column role_id;
+drop table organization_role;
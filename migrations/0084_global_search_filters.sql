@@ -0,0 +1,21 @@
+-- +migrate Up
+create index idx_device_profile_name_trgm on device_profile using gin (name gin_trgm_ops);
+create index idx_device_profile_name_lower on device_profile (lower(name));
+
+create index idx_device_last_seen_at on device (last_seen_at);
+create index idx_gateway_last_seen_at on gateway (last_seen_at);
+
+create index idx_user_display_name_trgm on "user" using gin (display_name gin_trgm_ops);
+
+create index idx_organization_name_lower on organization (lower(name));
+
+-- +migrate Down
+drop index idx_organization_name_lower;
+
+drop index idx_user_display_name_trgm;
+
+drop index idx_gateway_last_seen_at;
+drop index idx_device_last_seen_at;
+
+drop index idx_device_profile_name_lower;
+drop index idx_device_profile_name_trgm;
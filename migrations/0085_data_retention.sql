@@ -0,0 +1,17 @@
+-- +migrate Up
+create table organization_data_retention
+(
+    organization_id bigint                   not null references organization on delete cascade,
+    kind            varchar(32)              not null,
+    ttl_seconds     bigint                   not null check (ttl_seconds > 0),
+    updated_at      timestamp with time zone not null,
+    primary key (organization_id, kind)
+);
+
+create index idx_device_activation_dev_eui_created_at on device_activation (dev_eui, created_at);
+create index idx_fuota_deployment_updated_at on fuota_deployment (updated_at);
+
+-- +migrate Down
+drop index idx_fuota_deployment_updated_at;
+drop index idx_device_activation_dev_eui_created_at;
+drop table organization_data_retention;
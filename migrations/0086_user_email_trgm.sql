@@ -0,0 +1,5 @@
+-- +migrate Up
+create index idx_user_email_trgm on "user" using gin (email gin_trgm_ops);
+
+-- +migrate Down
+drop index idx_user_email_trgm;
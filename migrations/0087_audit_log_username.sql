@@ -0,0 +1,46 @@
+-- +migrate Up
+-- the only change allowed to the entries of the audit log is the removal of
+-- the username of the user whose data is erased, it is allowed only if the
+-- transaction sets audit_log.erase_username
+-- +migrate StatementBegin
+create function audit_log_erase_username() returns trigger as
+$$
+begin
+    if current_setting('audit_log.erase_username', true) = 'on'
+        and new.username = ''
+        and (new.id, new.created_at, new.user_id, new.organization_id, new.action,
+             new.target, new.before, new.after, new.source_ip)
+            is not distinct from
+            (old.id, old.created_at, old.user_id, old.organization_id, old.action,
+             old.target, old.before, old.after, old.source_ip) then
+        return new;
+    end if;
+    raise exception 'audit_log is append-only';
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+drop trigger audit_log_append_only on audit_log;
+
+create trigger audit_log_append_only
+    before delete or truncate
+    on audit_log
+    for each statement
+execute procedure audit_log_append_only();
+
+create trigger audit_log_erase_username
+    before update
+    on audit_log
+    for each row
+execute procedure audit_log_erase_username();
+
+-- +migrate Down
+drop trigger audit_log_erase_username on audit_log;
+drop function audit_log_erase_username();
+drop trigger audit_log_append_only on audit_log;
+
+create trigger audit_log_append_only
+    before update or delete or truncate
+    on audit_log
+    for each statement
+execute procedure audit_log_append_only();